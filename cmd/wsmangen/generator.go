@@ -0,0 +1,533 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package main
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+// ErrUnknownNamespace is returned for classes that are not AMT_, CIM_ or IPS_ classes.
+var ErrUnknownNamespace = errors.New("class must start with AMT_, CIM_ or IPS_")
+
+// Options controls where and how a class package is generated.
+type Options struct {
+	Package string // Go package name, defaults to the lower cased class name without its prefix
+	OutDir  string // root of the wsman tree, e.g. pkg/wsman
+	Year    int    // copyright year written into file headers
+	Force   bool   // overwrite existing files
+}
+
+type genPackage struct {
+	Year            int
+	Package         string
+	Namespace       string // amt, cim or ips
+	ResourceURIBase string
+	TestURIBase     string // wsmantesting constant holding ResourceURIBase
+	ClassName       string
+	ClassConst      string
+	StructName      string
+	Description     string
+	Fields          []genField
+	RequestFields   []genField
+	Enums           []*genEnum
+	Methods         []genMethod
+	NeedsModels     bool
+	NeedsRefRequest bool
+	FixtureFields   []genFixtureField
+}
+
+type genField struct {
+	Name    string
+	GoType  string
+	Tag     string
+	Comment string
+}
+
+type genFixtureField struct {
+	Name  string
+	Value string
+}
+
+type genEnum struct {
+	Name     string
+	MapName  string
+	Comment  string
+	Entries  []genEnumEntry
+	valueMap []string
+}
+
+type genEnumEntry struct {
+	Const string
+	Value string
+	Label string
+}
+
+type genMethod struct {
+	Index        int // position in the scaffolded test, used as the fixture RelatesTo
+	Name         string
+	Comment      string
+	Args         []genArg
+	InputFields  []genField
+	OutputFields []genField
+	ExpectedBody string
+}
+
+// fixtureEnvelope holds the header values of a scaffolded response fixture.
+type fixtureEnvelope struct {
+	Namespace   string
+	RelatesTo   int
+	Action      string
+	ResourceURI string
+}
+
+func newFixtureEnvelope(namespace string, relatesTo int, action, resourceURI string) fixtureEnvelope {
+	return fixtureEnvelope{Namespace: namespace, RelatesTo: relatesTo, Action: action, ResourceURI: resourceURI}
+}
+
+type genArg struct {
+	Name   string
+	Field  string
+	GoType string
+}
+
+// Generate writes the package files and the test fixtures for class into opts.OutDir.
+func Generate(class Class, opts Options) ([]string, error) {
+	pkg, err := buildPackage(class, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	pkgDir := filepath.Join(opts.OutDir, pkg.Namespace, pkg.Package)
+	fixtureDir := filepath.Join(opts.OutDir, "wsmantesting", "responses", pkg.Namespace, pkg.Package)
+
+	files := []struct {
+		template string
+		path     string
+		isGo     bool
+	}{
+		{"decoder.go.tmpl", filepath.Join(pkgDir, "decoder.go"), true},
+		{"types.go.tmpl", filepath.Join(pkgDir, "types.go"), true},
+		{"marshal.go.tmpl", filepath.Join(pkgDir, "marshal.go"), true},
+		{"service.go.tmpl", filepath.Join(pkgDir, "service.go"), true},
+		{"decoder_test.go.tmpl", filepath.Join(pkgDir, "decoder_test.go"), true},
+		{"service_test.go.tmpl", filepath.Join(pkgDir, "service_test.go"), true},
+		{"get.xml.tmpl", filepath.Join(fixtureDir, "get.xml"), false},
+		{"enumerate.xml.tmpl", filepath.Join(fixtureDir, "enumerate.xml"), false},
+		{"pull.xml.tmpl", filepath.Join(fixtureDir, "pull.xml"), false},
+	}
+
+	funcs := template.FuncMap{"lower": strings.ToLower, "envelope": newFixtureEnvelope}
+
+	tmpl, err := template.New("wsmangen").Funcs(funcs).ParseFS(templateFS, "templates/*.tmpl")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse templates: %w", err)
+	}
+
+	written := []string{}
+
+	for _, f := range files {
+		if err := render(tmpl, f.template, f.path, pkg, f.isGo, opts.Force); err != nil {
+			return written, err
+		}
+
+		written = append(written, f.path)
+	}
+
+	for _, m := range pkg.Methods {
+		path := filepath.Join(fixtureDir, strings.ToLower(m.Name)+".xml")
+
+		data := struct {
+			*genPackage
+			Method genMethod
+		}{pkg, m}
+
+		if err := render(tmpl, "method.xml.tmpl", path, data, false, opts.Force); err != nil {
+			return written, err
+		}
+
+		written = append(written, path)
+	}
+
+	return written, nil
+}
+
+func render(tmpl *template.Template, name, path string, data any, isGo, force bool) error {
+	var buf bytes.Buffer
+
+	if err := tmpl.ExecuteTemplate(&buf, name, data); err != nil {
+		return fmt.Errorf("failed to render %s: %w", name, err)
+	}
+
+	out := buf.Bytes()
+
+	if isGo {
+		formatted, err := format.Source(out)
+		if err != nil {
+			return fmt.Errorf("generated %s is not valid Go: %w", path, err)
+		}
+
+		out = formatted
+	}
+
+	if !force {
+		if _, err := os.Stat(path); err == nil {
+			return fmt.Errorf("%s already exists, use -force to overwrite: %w", path, os.ErrExist)
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, out, 0o600)
+}
+
+func buildPackage(class Class, opts Options) (*genPackage, error) {
+	pkg := &genPackage{
+		Year:        opts.Year,
+		Package:     opts.Package,
+		Namespace:   class.Namespace(),
+		ClassName:   class.Name,
+		ClassConst:  classConstName(class.Name),
+		StructName:  structName(class.Name),
+		Description: oneLine(class.Description),
+	}
+
+	switch pkg.Namespace {
+	case "amt":
+		pkg.ResourceURIBase = "http://intel.com/wbem/wscim/1/amt-schema/1/"
+		pkg.TestURIBase = "AMTResourceURIBase"
+	case "cim":
+		pkg.ResourceURIBase = "http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/"
+		pkg.TestURIBase = "CIMResourceURIBase"
+	case "ips":
+		pkg.ResourceURIBase = "http://intel.com/wbem/wscim/1/ips-schema/1/"
+		pkg.TestURIBase = "IPSResourceURIBase"
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownNamespace, class.Name)
+	}
+
+	if pkg.Package == "" {
+		pkg.Package = packageName(class.Name)
+	}
+
+	// the Service type owns the package's service name, so keep the instance struct distinct
+	if pkg.StructName == "Service" {
+		pkg.StructName = "ServiceInstance"
+	}
+
+	for _, p := range class.Properties {
+		goType := pkg.goType(p.Type, p.Reference, false)
+
+		if enum := pkg.enumFor(p.Name, "", p.ValueMap, p.Values, p.Description); enum != nil {
+			goType = enum.Name
+		}
+
+		if p.Array {
+			goType = "[]" + goType
+		}
+
+		pkg.Fields = append(pkg.Fields, genField{
+			Name:    identifier(p.Name),
+			GoType:  goType,
+			Tag:     fmt.Sprintf("`xml:\"%s,omitempty\"`", p.Name),
+			Comment: oneLine(p.Description),
+		})
+
+		if !p.Reference && !p.Array {
+			pkg.FixtureFields = append(pkg.FixtureFields, genFixtureField{Name: p.Name, Value: fixtureValue(p)})
+		}
+	}
+
+	for _, p := range class.WritableProperties() {
+		if p.Reference {
+			continue
+		}
+
+		goType := pkg.goType(p.Type, false, true)
+		if enum := pkg.findEnum(p.Name, p.ValueMap); enum != nil {
+			goType = enum.Name
+		}
+
+		tag := fmt.Sprintf("`xml:\"h:%s\"`", p.Name)
+
+		if p.Array {
+			goType = "[]" + goType
+			tag = fmt.Sprintf("`xml:\"h:%s,omitempty\"`", p.Name)
+		}
+
+		pkg.RequestFields = append(pkg.RequestFields, genField{Name: identifier(p.Name), GoType: goType, Tag: tag, Comment: oneLine(p.Description)})
+	}
+
+	returnValueTypes := map[string]bool{}
+
+	for _, m := range class.Methods {
+		returnValueTypes[strings.Join(m.ValueMap, ",")+"|"+strings.Join(m.Values, ",")] = true
+	}
+
+	for i, m := range class.Methods {
+		method := pkg.buildMethod(m, len(returnValueTypes) > 1)
+		// Get, Enumerate and Pull take the first three message IDs in the scaffolded test
+		method.Index = i + 3
+		pkg.Methods = append(pkg.Methods, method)
+	}
+
+	return pkg, nil
+}
+
+func (pkg *genPackage) buildMethod(m Method, distinctReturnValues bool) genMethod {
+	method := genMethod{Name: m.Name, Comment: docComment(m.Name, "invokes the "+pkg.ClassName+" "+m.Name+" method.", m.Description)}
+
+	var body strings.Builder
+
+	fmt.Fprintf(&body, `<h:%s_INPUT xmlns:h="%s%s">`, m.Name, pkg.ResourceURIBase, pkg.ClassName)
+
+	for _, p := range m.InParameters() {
+		field := identifier(p.Name)
+		goType := pkg.goType(p.Type, p.Reference, true)
+
+		if enum := pkg.enumFor(p.Name, m.Name, p.ValueMap, p.Values, p.Description); enum != nil {
+			goType = enum.Name
+		}
+
+		tag := fmt.Sprintf("`xml:\"h:%s\"`", p.Name)
+
+		switch {
+		case p.Reference:
+			goType = "*" + goType
+			tag = fmt.Sprintf("`xml:\"h:%s,omitempty\"`", p.Name)
+		case p.Array:
+			goType = "[]" + goType
+			tag = fmt.Sprintf("`xml:\"h:%s,omitempty\"`", p.Name)
+		default:
+			fmt.Fprintf(&body, "<h:%s>%s</h:%s>", p.Name, zeroText(p.Type), p.Name)
+		}
+
+		method.InputFields = append(method.InputFields, genField{Name: field, GoType: goType, Tag: tag, Comment: oneLine(p.Description)})
+		method.Args = append(method.Args, genArg{Name: lowerFirst(field), Field: field, GoType: goType})
+	}
+
+	fmt.Fprintf(&body, "</h:%s_INPUT>", m.Name)
+	method.ExpectedBody = body.String()
+
+	returnType := "int"
+
+	enumName := "ReturnValue"
+	if distinctReturnValues {
+		enumName = m.Name + "ReturnValue"
+	}
+
+	if enum := pkg.enumNamed(enumName, m.ValueMap, m.Values, "ReturnValue is an integer enumeration that indicates the completion status of the method."); enum != nil {
+		returnType = enum.Name
+	}
+
+	method.OutputFields = append(method.OutputFields, genField{Name: "ReturnValue", GoType: returnType, Tag: "`xml:\"ReturnValue\"`"})
+
+	for _, p := range m.OutParameters() {
+		goType := pkg.goType(p.Type, p.Reference, false)
+
+		if enum := pkg.enumFor(p.Name, m.Name, p.ValueMap, p.Values, p.Description); enum != nil {
+			goType = enum.Name
+		}
+
+		if p.Array {
+			goType = "[]" + goType
+		}
+
+		method.OutputFields = append(method.OutputFields, genField{
+			Name: identifier(p.Name), GoType: goType, Tag: fmt.Sprintf("`xml:\"%s,omitempty\"`", p.Name), Comment: oneLine(p.Description),
+		})
+	}
+
+	return method
+}
+
+// goType maps a CIM data type to the Go type the repo uses for it. References decode into models.AssociationReference
+// and are sent as the package level ReferenceInput type.
+func (pkg *genPackage) goType(cim string, reference, request bool) string {
+	if reference {
+		if request {
+			pkg.NeedsRefRequest = true
+
+			return "ReferenceInput"
+		}
+
+		pkg.NeedsModels = true
+
+		return "models.AssociationReference"
+	}
+
+	switch strings.ToLower(cim) {
+	case "boolean":
+		return "bool"
+	case "uint8", "uint16", "uint32", "uint64", "sint8", "sint16", "sint32", "sint64":
+		return "int"
+	case "real32", "real64":
+		return "float64"
+	default:
+		return "string"
+	}
+}
+
+// enumFor returns the enum describing a ValueMap, creating it on first use. Enums are named after the property or
+// parameter; when the same name is already used for a different ValueMap, the method name is prepended.
+func (pkg *genPackage) enumFor(name, scope string, valueMap, values []string, description string) *genEnum {
+	if len(valueMap) == 0 || len(valueMap) != len(values) {
+		return nil
+	}
+
+	enumName := identifier(name)
+
+	if existing := pkg.lookupEnum(enumName); existing != nil && !slices.Equal(existing.valueMap, valueMap) {
+		enumName = identifier(scope) + enumName
+	}
+
+	return pkg.enumNamed(enumName, valueMap, values, commentFor(enumName, description))
+}
+
+func (pkg *genPackage) enumNamed(enumName string, valueMap, values []string, comment string) *genEnum {
+	if len(valueMap) == 0 || len(valueMap) != len(values) {
+		return nil
+	}
+
+	if existing := pkg.lookupEnum(enumName); existing != nil {
+		return existing
+	}
+
+	enum := &genEnum{
+		Name:     enumName,
+		MapName:  lowerFirst(enumName) + "ToString",
+		Comment:  comment,
+		valueMap: valueMap,
+	}
+
+	seen := map[string]bool{}
+
+	for i, v := range valueMap {
+		// ranges such as "..", "32768..65535" or "0x8000.." describe reserved blocks, not individual values
+		if _, err := strconv.Atoi(v); err != nil {
+			continue
+		}
+
+		constName := enumName + identifier(values[i])
+		if seen[constName] {
+			constName += v
+		}
+
+		seen[constName] = true
+
+		enum.Entries = append(enum.Entries, genEnumEntry{Const: constName, Value: v, Label: identifier(values[i])})
+	}
+
+	if len(enum.Entries) == 0 {
+		return nil
+	}
+
+	pkg.Enums = append(pkg.Enums, enum)
+
+	return enum
+}
+
+func (pkg *genPackage) lookupEnum(name string) *genEnum {
+	for _, e := range pkg.Enums {
+		if e.Name == name {
+			return e
+		}
+	}
+
+	return nil
+}
+
+func (pkg *genPackage) findEnum(name string, valueMap []string) *genEnum {
+	if e := pkg.lookupEnum(identifier(name)); e != nil && slices.Equal(e.valueMap, valueMap) {
+		return e
+	}
+
+	return nil
+}
+
+func commentFor(name, description string) string {
+	sentence, _, _ := strings.Cut(oneLine(description), ". ")
+
+	return docComment(identifier(name), "is an integer enumeration.", strings.TrimSuffix(sentence, ".")+".")
+}
+
+// docComment builds a Go doc comment starting with name. Descriptions that open with a verb ("Sets the ...") are
+// folded into the sentence; other descriptions follow the fallback sentence.
+func docComment(name, fallback, description string) string {
+	description = oneLine(description)
+
+	switch {
+	case description == "" || description == ".":
+		return name + " " + fallback
+	case strings.HasPrefix(description, name+" "):
+		return description
+	}
+
+	firstWord, _, _ := strings.Cut(description, " ")
+	if len(firstWord) > 2 && strings.HasSuffix(firstWord, "s") && !strings.HasSuffix(firstWord, "ss") && firstWord != "This" {
+		return name + " " + strings.ToLower(firstWord[:1]) + description[1:]
+	}
+
+	return name + " " + fallback + " " + description
+}
+
+// oneLine collapses schema descriptions, which are often wrapped across several lines, into a single line comment.
+func oneLine(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+func zeroText(cim string) string {
+	switch strings.ToLower(cim) {
+	case "boolean":
+		return "false"
+	case "uint8", "uint16", "uint32", "uint64", "sint8", "sint16", "sint32", "sint64", "real32", "real64":
+		return "0"
+	default:
+		return ""
+	}
+}
+
+// fixtureValue returns a plausible value for a property in the scaffolded Get and Pull fixtures.
+func fixtureValue(p Property) string {
+	if len(p.ValueMap) > 0 {
+		for _, v := range p.ValueMap {
+			if _, err := strconv.Atoi(v); err == nil {
+				return v
+			}
+		}
+	}
+
+	switch strings.ToLower(p.Type) {
+	case "boolean":
+		return "true"
+	case "uint8", "uint16", "uint32", "uint64", "sint8", "sint16", "sint32", "sint64", "real32", "real64":
+		return "1"
+	case "datetime":
+		return "2026-01-01T00:00:00Z"
+	default:
+		return p.Name
+	}
+}
+
+func sortMethods(methods []Method) {
+	slices.SortFunc(methods, func(a, b Method) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+}
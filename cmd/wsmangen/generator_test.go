@@ -0,0 +1,153 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunMOF(t *testing.T) {
+	out := t.TempDir()
+
+	var stdout bytes.Buffer
+
+	err := run([]string{
+		"-schema", "testdata/CIM_EnabledLogicalElement.mof",
+		"-schema", "testdata/AMT_WebUIService.mof",
+		"-class", "AMT_WebUIService",
+		"-out", out,
+	}, &stdout)
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), "remember to wire AMT_WebUIService into the amt Messages struct")
+
+	pkgDir := filepath.Join(out, "amt", "webuiservice")
+
+	types := readFile(t, filepath.Join(pkgDir, "types.go"))
+	assert.Contains(t, types, "WebUIService struct {")
+	// inherited from CIM_Service, CIM_ManagedSystemElement and CIM_ManagedElement
+	assert.Contains(t, types, "SystemCreationClassName string         `xml:\"SystemCreationClassName,omitempty\"`")
+	assert.Contains(t, types, "OperationalStatus       []int          `xml:\"OperationalStatus,omitempty\"`")
+	assert.Contains(t, types, "ElementName             string         `xml:\"ElementName,omitempty\"`")
+	// the override narrows the inherited ValueMap
+	assert.Contains(t, types, "EnabledState            EnabledState   `xml:\"EnabledState,omitempty\"`")
+	assert.Contains(t, types, "Job         models.AssociationReference `xml:\"Job,omitempty\"`")
+	assert.Contains(t, types, "EnabledDefault          EnabledDefault `xml:\"h:EnabledDefault\"`")
+	assert.Contains(t, types, "RequestedState RequestStateChangeRequestedState `xml:\"h:RequestedState\"`")
+
+	decoder := readFile(t, filepath.Join(pkgDir, "decoder.go"))
+	assert.Contains(t, decoder, "EnabledStateEnabledButOffline EnabledState = 6")
+	assert.NotContains(t, decoder, "EnabledStateStarting")
+	assert.Contains(t, decoder, "func ParseReturnValue(s string) (ReturnValue, error)")
+	assert.Contains(t, decoder, "ReturnValueMethodParametersCheckedJobStarted ReturnValue = 4096")
+
+	service := readFile(t, filepath.Join(pkgDir, "service.go"))
+	assert.Contains(t, service, "func (s Service) RequestStateChange(requestedState RequestStateChangeRequestedState, timeoutPeriod string, opts ...base.HeaderOption) (response Response, err error)")
+
+	serviceTest := readFile(t, filepath.Join(pkgDir, "service_test.go"))
+	assert.Contains(t, serviceTest, "`<h:RequestStateChange_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_WebUIService\"><h:RequestedState>0</h:RequestedState><h:TimeoutPeriod></h:TimeoutPeriod></h:RequestStateChange_INPUT>`")
+
+	fixture := readFile(t, filepath.Join(out, "wsmantesting", "responses", "amt", "webuiservice", "requeststatechange.xml"))
+	assert.Contains(t, fixture, "<b:RelatesTo>3</b:RelatesTo>")
+	assert.Contains(t, fixture, "<g:RequestStateChange_OUTPUT>")
+
+	get := readFile(t, filepath.Join(out, "wsmantesting", "responses", "amt", "webuiservice", "get.xml"))
+	assert.Contains(t, get, "<g:EnabledState>2</g:EnabledState>")
+	assert.Contains(t, get, "<g:EnabledDefault>2</g:EnabledDefault>")
+
+	// existing files are only replaced with -force
+	err = run([]string{"-schema", "testdata/AMT_WebUIService.mof", "-out", out}, &stdout)
+	assert.ErrorIs(t, err, os.ErrExist)
+
+	err = run([]string{"-schema", "testdata/AMT_WebUIService.mof", "-out", out, "-force"}, &stdout)
+	assert.NoError(t, err)
+}
+
+func TestRunXSD(t *testing.T) {
+	out := t.TempDir()
+
+	var stdout bytes.Buffer
+
+	err := run([]string{"-schema", "testdata/IPS_ScreenConfigurationService.xsd", "-package", "screensetting", "-out", out}, &stdout)
+	require.NoError(t, err)
+
+	service := readFile(t, filepath.Join(out, "ips", "screensetting", "service.go"))
+	assert.Contains(t, service, "package screensetting")
+	assert.Contains(t, service, "func (s Service) SetSessionState(sessionState int, consecutiveRebootsNum int, opts ...base.HeaderOption) (response Response, err error)")
+	assert.Contains(t, service, `"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/methods"`)
+
+	serviceTest := readFile(t, filepath.Join(out, "ips", "screensetting", "service_test.go"))
+	assert.Contains(t, serviceTest, "wsmantesting.IPSResourceURIBase")
+}
+
+func TestRunErrors(t *testing.T) {
+	var stdout bytes.Buffer
+
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{"no schema", []string{}},
+		{"unknown flag", []string{"-nope"}},
+		{"missing file", []string{"-schema", "testdata/missing.mof"}},
+		{"several classes", []string{"-schema", "testdata/CIM_EnabledLogicalElement.mof"}},
+		{"unknown class", []string{"-schema", "testdata/AMT_WebUIService.mof", "-class", "AMT_Nope"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Error(t, run(test.args, &stdout))
+		})
+	}
+}
+
+func TestUnknownNamespace(t *testing.T) {
+	_, err := Generate(Class{Name: "Win32_Process"}, Options{OutDir: t.TempDir()})
+	assert.ErrorIs(t, err, ErrUnknownNamespace)
+}
+
+func TestNames(t *testing.T) {
+	assert.Equal(t, "EnabledButOffline", identifier("Enabled but Offline"))
+	assert.Equal(t, "MethodParametersCheckedJobStarted", identifier("Method Parameters Checked - Job Started"))
+	assert.Equal(t, "Value802Dot1x", identifier("802 dot1x"))
+	assert.Equal(t, "Value", identifier("--"))
+	assert.Equal(t, "ipAddress", lowerFirst("IPAddress"))
+	assert.Equal(t, "handle", lowerFirst("Handle"))
+	assert.Equal(t, "typeValue", lowerFirst("Type"))
+	assert.Equal(t, "AMTWebUIService", classConstName("AMT_WebUIService"))
+	assert.Equal(t, "webuiservice", packageName("AMT_WebUIService"))
+	assert.Equal(t, "ServiceInstance", mustBuild(t, Class{Name: "CIM_Service"}).StructName)
+}
+
+func TestDocComment(t *testing.T) {
+	assert.Equal(t, "Do invokes it.", docComment("Do", "invokes it.", ""))
+	assert.Equal(t, "Do sets the value.", docComment("Do", "invokes it.", "Sets the value."))
+	assert.Equal(t, "Do invokes it. This method sets the value.", docComment("Do", "invokes it.", "This method sets the value."))
+	assert.Equal(t, "Do is documented.", docComment("Do", "invokes it.", "Do is documented."))
+}
+
+func mustBuild(t *testing.T, class Class) *genPackage {
+	t.Helper()
+
+	pkg, err := buildPackage(class, Options{})
+	require.NoError(t, err)
+
+	return pkg
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	return string(data)
+}
@@ -0,0 +1,220 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Command wsmangen generates AMT, CIM and IPS class packages from DMTF/Intel MOF or XSD schema files.
+//
+// It emits types.go (response, _INPUT/_OUTPUT and Put request types), decoder.go (constants and enum String/Parse
+// tables built from ValueMap/Values qualifiers), marshal.go, service.go (base.WSManService wiring and one method per
+// extrinsic method), test scaffolding and response fixtures in the layout used by pkg/wsman:
+//
+//	go run ./cmd/wsmangen -schema cmd/wsmangen/testdata/AMT_WebUIService.mof \
+//		-schema cmd/wsmangen/testdata/CIM_EnabledLogicalElement.mof -class AMT_WebUIService -out /tmp/wsman
+//
+// Point -schema at a local copy of the schema files: the MOF files of the DMTF CIM schema and the MOF or XSD files
+// shipped with the Intel AMT SDK. testdata holds excerpts of these schemas, trimmed to the classes, properties and
+// qualifiers used by the tests; it is not a copy of the full schemas.
+// Superclasses found in the other -schema files are flattened into the generated class. The generated service must
+// still be added to the namespace Messages struct and NewMessages constructor, and the fixtures should be replaced
+// with captures from a real device.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ErrClassNotFound is returned when the requested class is not defined in the schema files.
+var ErrClassNotFound = errors.New("class not found in schema files")
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "wsmangen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdout io.Writer) error {
+	var schemas []string
+
+	fs := flag.NewFlagSet("wsmangen", flag.ContinueOnError)
+	fs.Func("schema", "MOF or XSD schema file; repeat to provide superclass definitions", func(s string) error {
+		schemas = append(schemas, s)
+
+		return nil
+	})
+
+	className := fs.String("class", "", "class to generate, required when the schema files define several classes")
+	pkgName := fs.String("package", "", "Go package name (default: class name without prefix, lower case)")
+	outDir := fs.String("out", filepath.Join("pkg", "wsman"), "root of the wsman package tree")
+	force := fs.Bool("force", false, "overwrite existing files")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if len(schemas) == 0 {
+		fs.Usage()
+
+		return errors.New("at least one -schema is required")
+	}
+
+	classes, err := loadSchemas(schemas)
+	if err != nil {
+		return err
+	}
+
+	class, err := selectClass(classes, *className)
+	if err != nil {
+		return err
+	}
+
+	written, err := Generate(flatten(class, classes), Options{
+		Package: *pkgName,
+		OutDir:  *outDir,
+		Year:    time.Now().Year(),
+		Force:   *force,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, path := range written {
+		fmt.Fprintln(stdout, "wrote", path)
+	}
+
+	fmt.Fprintf(stdout, "remember to wire %s into the %s Messages struct and NewMessages\n", class.Name, class.Namespace())
+
+	return nil
+}
+
+func loadSchemas(paths []string) ([]Class, error) {
+	classes := []Class{}
+
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".xsd":
+			class, err := ParseXSD(data)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+
+			classes = append(classes, class)
+		default:
+			parsed, err := ParseMOF(string(data))
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+
+			classes = append(classes, parsed...)
+		}
+	}
+
+	return classes, nil
+}
+
+func selectClass(classes []Class, name string) (Class, error) {
+	if name == "" {
+		if len(classes) == 1 {
+			return classes[0], nil
+		}
+
+		return Class{}, errors.New("schema files define several classes, use -class to pick one")
+	}
+
+	for _, c := range classes {
+		if strings.EqualFold(c.Name, name) {
+			return c, nil
+		}
+	}
+
+	return Class{}, fmt.Errorf("%w: %s", ErrClassNotFound, name)
+}
+
+// flatten merges the properties and methods of any known superclasses into class. Members declared on the
+// subclass override inherited members with the same name, which is how MOF overrides refine ValueMaps.
+func flatten(class Class, classes []Class) Class {
+	byName := map[string]Class{}
+	for _, c := range classes {
+		byName[strings.ToLower(c.Name)] = c
+	}
+
+	chain := []Class{class}
+	seen := map[string]bool{strings.ToLower(class.Name): true}
+
+	for super := class.SuperClass; super != ""; {
+		parent, ok := byName[strings.ToLower(super)]
+		if !ok || seen[strings.ToLower(super)] {
+			break
+		}
+
+		seen[strings.ToLower(super)] = true
+		chain = append(chain, parent)
+		super = parent.SuperClass
+	}
+
+	out := Class{Name: class.Name, SuperClass: class.SuperClass, Description: class.Description}
+	propIndex := map[string]int{}
+	methodIndex := map[string]int{}
+
+	// walk from the root class down so that overrides replace inherited definitions in place
+	for i := len(chain) - 1; i >= 0; i-- {
+		for _, p := range chain[i].Properties {
+			if idx, ok := propIndex[p.Name]; ok {
+				out.Properties[idx] = mergeProperty(out.Properties[idx], p)
+
+				continue
+			}
+
+			propIndex[p.Name] = len(out.Properties)
+			out.Properties = append(out.Properties, p)
+		}
+
+		for _, m := range chain[i].Methods {
+			if idx, ok := methodIndex[m.Name]; ok {
+				out.Methods[idx] = m
+
+				continue
+			}
+
+			methodIndex[m.Name] = len(out.Methods)
+			out.Methods = append(out.Methods, m)
+		}
+	}
+
+	sortMethods(out.Methods)
+
+	return out
+}
+
+// mergeProperty applies an overriding declaration on top of the inherited one, keeping inherited qualifiers the
+// override does not restate.
+func mergeProperty(inherited, override Property) Property {
+	merged := override
+
+	if merged.Description == "" {
+		merged.Description = inherited.Description
+	}
+
+	if len(merged.ValueMap) == 0 {
+		merged.ValueMap = inherited.ValueMap
+		merged.Values = inherited.Values
+	}
+
+	merged.Key = merged.Key || inherited.Key
+	merged.Write = merged.Write || inherited.Write
+
+	return merged
+}
@@ -0,0 +1,102 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package main
+
+import "strings"
+
+// Class is the schema-neutral description of a WS-Man class, produced by the MOF and XSD readers.
+type Class struct {
+	Name        string
+	SuperClass  string
+	Description string
+	Properties  []Property
+	Methods     []Method
+}
+
+// Property describes a single class property.
+type Property struct {
+	Name        string
+	Type        string // CIM data type, e.g. uint16, string, boolean, datetime, or the class name of a reference
+	Array       bool
+	Key         bool
+	Write       bool
+	Reference   bool
+	Description string
+	ValueMap    []string
+	Values      []string
+}
+
+// Method describes an extrinsic method and its parameters.
+type Method struct {
+	Name        string
+	ReturnType  string
+	Description string
+	ValueMap    []string
+	Values      []string
+	Parameters  []Parameter
+}
+
+// Parameter describes a single method parameter.
+type Parameter struct {
+	Name        string
+	Type        string
+	Array       bool
+	In          bool
+	Out         bool
+	Reference   bool
+	Description string
+	ValueMap    []string
+	Values      []string
+}
+
+// Namespace returns the library namespace (amt, cim or ips) the class belongs to.
+func (c Class) Namespace() string {
+	prefix, _, found := strings.Cut(c.Name, "_")
+	if !found {
+		return ""
+	}
+
+	return strings.ToLower(prefix)
+}
+
+// InParameters returns the parameters sent in the method's _INPUT body.
+func (m Method) InParameters() []Parameter {
+	params := []Parameter{}
+
+	for _, p := range m.Parameters {
+		if p.In {
+			params = append(params, p)
+		}
+	}
+
+	return params
+}
+
+// OutParameters returns the parameters returned in the method's _OUTPUT body.
+func (m Method) OutParameters() []Parameter {
+	params := []Parameter{}
+
+	for _, p := range m.Parameters {
+		if p.Out {
+			params = append(params, p)
+		}
+	}
+
+	return params
+}
+
+// WritableProperties returns the properties that may be sent in a Put request.
+func (c Class) WritableProperties() []Property {
+	props := []Property{}
+
+	for _, p := range c.Properties {
+		if p.Write || p.Key {
+			props = append(props, p)
+		}
+	}
+
+	return props
+}
@@ -0,0 +1,521 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// ErrUnexpectedToken is returned when the MOF input does not follow the expected grammar.
+var ErrUnexpectedToken = errors.New("unexpected token")
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenPunct
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	line int
+}
+
+// qualifier is a parsed MOF qualifier such as Key, Description("...") or ValueMap{"0", "1"}.
+type qualifier struct {
+	name   string
+	values []string
+}
+
+type qualifiers []qualifier
+
+func (q qualifiers) has(name string) bool {
+	_, ok := q.get(name)
+
+	return ok
+}
+
+func (q qualifiers) get(name string) ([]string, bool) {
+	for _, item := range q {
+		if strings.EqualFold(item.name, name) {
+			return item.values, true
+		}
+	}
+
+	return nil, false
+}
+
+func (q qualifiers) first(name string) string {
+	values, ok := q.get(name)
+	if !ok || len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+// isTrue reports whether a boolean qualifier is present and not explicitly set to false.
+func (q qualifiers) isTrue(name string) bool {
+	values, ok := q.get(name)
+	if !ok {
+		return false
+	}
+
+	return len(values) == 0 || !strings.EqualFold(values[0], "false")
+}
+
+// lexMOF splits MOF source into tokens, dropping comments and #pragma lines.
+func lexMOF(src string) ([]token, error) {
+	tokens := []token{}
+	line := 1
+	i := 0
+
+	for i < len(src) {
+		c := src[i]
+
+		switch {
+		case c == '\n':
+			line++
+			i++
+		case unicode.IsSpace(rune(c)):
+			i++
+		case strings.HasPrefix(src[i:], "//"), c == '#':
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("line %d: unterminated comment", line)
+			}
+
+			line += strings.Count(src[i:i+2+end], "\n")
+			i += end + 4
+		case c == '"':
+			text, n, err := lexString(src[i:])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+
+			tokens = append(tokens, token{kind: tokenString, text: text, line: line})
+			line += strings.Count(src[i:i+n], "\n")
+			i += n
+		case c == '_' || unicode.IsLetter(rune(c)):
+			start := i
+			for i < len(src) && (src[i] == '_' || unicode.IsLetter(rune(src[i])) || unicode.IsDigit(rune(src[i]))) {
+				i++
+			}
+
+			tokens = append(tokens, token{kind: tokenIdent, text: src[start:i], line: line})
+		case c == '-' || c == '+' || unicode.IsDigit(rune(c)):
+			start := i
+			i++
+
+			for i < len(src) && (unicode.IsDigit(rune(src[i])) || unicode.IsLetter(rune(src[i])) || src[i] == '.') {
+				i++
+			}
+
+			tokens = append(tokens, token{kind: tokenNumber, text: src[start:i], line: line})
+		default:
+			tokens = append(tokens, token{kind: tokenPunct, text: string(c), line: line})
+			i++
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, line: line}), nil
+}
+
+// lexString reads a double-quoted MOF string starting at src[0] and returns its unescaped text and length.
+func lexString(src string) (string, int, error) {
+	var out strings.Builder
+
+	for i := 1; i < len(src); i++ {
+		switch src[i] {
+		case '\\':
+			if i+1 >= len(src) {
+				return "", 0, errors.New("unterminated string")
+			}
+
+			i++
+
+			switch src[i] {
+			case 'n':
+				out.WriteByte('\n')
+			case 't':
+				out.WriteByte('\t')
+			default:
+				out.WriteByte(src[i])
+			}
+		case '"':
+			return out.String(), i + 1, nil
+		default:
+			out.WriteByte(src[i])
+		}
+	}
+
+	return "", 0, errors.New("unterminated string")
+}
+
+type mofParser struct {
+	tokens []token
+	pos    int
+}
+
+// ParseMOF reads all class declarations from MOF source. Qualifier declarations and instance declarations are skipped.
+func ParseMOF(src string) ([]Class, error) {
+	tokens, err := lexMOF(src)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &mofParser{tokens: tokens}
+	classes := []Class{}
+
+	for p.peek().kind != tokenEOF {
+		quals, err := p.parseQualifiers()
+		if err != nil {
+			return nil, err
+		}
+
+		keyword := p.next()
+
+		switch {
+		case keyword.kind == tokenIdent && strings.EqualFold(keyword.text, "class"):
+			class, err := p.parseClass(quals)
+			if err != nil {
+				return nil, err
+			}
+
+			classes = append(classes, class)
+		case keyword.kind == tokenPunct && keyword.text == ";":
+			// stray separator
+		default:
+			// qualifier, instance and association declarations are not needed for code generation
+			if err := p.skipStatement(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return classes, nil
+}
+
+func (p *mofParser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *mofParser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+
+	return t
+}
+
+func (p *mofParser) accept(text string) bool {
+	if t := p.peek(); t.kind == tokenPunct && t.text == text {
+		p.pos++
+
+		return true
+	}
+
+	return false
+}
+
+func (p *mofParser) expect(text string) error {
+	if p.accept(text) {
+		return nil
+	}
+
+	t := p.peek()
+
+	return fmt.Errorf("line %d: %w %q, expected %q", t.line, ErrUnexpectedToken, t.text, text)
+}
+
+func (p *mofParser) expectIdent() (string, error) {
+	t := p.next()
+	if t.kind != tokenIdent {
+		return "", fmt.Errorf("line %d: %w %q, expected identifier", t.line, ErrUnexpectedToken, t.text)
+	}
+
+	return t.text, nil
+}
+
+// skipStatement skips tokens up to and including the next top-level ';'.
+func (p *mofParser) skipStatement() error {
+	depth := 0
+
+	for {
+		t := p.next()
+
+		switch {
+		case t.kind == tokenEOF:
+			return nil
+		case t.kind != tokenPunct:
+			continue
+		case t.text == "{" || t.text == "(" || t.text == "[":
+			depth++
+		case t.text == "}" || t.text == ")" || t.text == "]":
+			depth--
+		case t.text == ";" && depth <= 0:
+			return nil
+		}
+	}
+}
+
+// parseQualifiers reads an optional "[ ... ]" qualifier list.
+func (p *mofParser) parseQualifiers() (qualifiers, error) {
+	quals := qualifiers{}
+
+	if !p.accept("[") {
+		return quals, nil
+	}
+
+	for !p.accept("]") {
+		name, err := p.expectIdent()
+		if err != nil {
+			return nil, err
+		}
+
+		q := qualifier{name: name}
+
+		switch {
+		case p.accept("("):
+			q.values, err = p.parseValues(")")
+		case p.accept("{"):
+			q.values, err = p.parseValues("}")
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		// flavor lists such as ": ToSubclass" are irrelevant here
+		if p.accept(":") {
+			for p.peek().kind == tokenIdent {
+				p.next()
+			}
+		}
+
+		quals = append(quals, q)
+
+		p.accept(",")
+	}
+
+	return quals, nil
+}
+
+// parseValues reads a comma separated list of literals up to the closing delimiter.
+// Adjacent string literals are concatenated as in the MOF grammar.
+func (p *mofParser) parseValues(closing string) ([]string, error) {
+	values := []string{}
+
+	for !p.accept(closing) {
+		t := p.next()
+
+		switch t.kind {
+		case tokenEOF:
+			return nil, fmt.Errorf("line %d: %w end of file, expected %q", t.line, ErrUnexpectedToken, closing)
+		case tokenString:
+			text := t.text
+			for p.peek().kind == tokenString {
+				text += p.next().text
+			}
+
+			values = append(values, text)
+		case tokenPunct:
+			if t.text != "," {
+				return nil, fmt.Errorf("line %d: %w %q", t.line, ErrUnexpectedToken, t.text)
+			}
+		case tokenIdent, tokenNumber:
+			values = append(values, t.text)
+		}
+	}
+
+	return values, nil
+}
+
+func (p *mofParser) parseClass(quals qualifiers) (Class, error) {
+	name, err := p.expectIdent()
+	if err != nil {
+		return Class{}, err
+	}
+
+	class := Class{Name: name, Description: quals.first("Description")}
+
+	if p.accept(":") {
+		if class.SuperClass, err = p.expectIdent(); err != nil {
+			return Class{}, err
+		}
+	}
+
+	if err := p.expect("{"); err != nil {
+		return Class{}, err
+	}
+
+	for !p.accept("}") {
+		if err := p.parseMember(&class); err != nil {
+			return Class{}, err
+		}
+	}
+
+	p.accept(";")
+
+	return class, nil
+}
+
+func (p *mofParser) parseMember(class *Class) error {
+	quals, err := p.parseQualifiers()
+	if err != nil {
+		return err
+	}
+
+	dataType, err := p.expectIdent()
+	if err != nil {
+		return err
+	}
+
+	reference := false
+
+	name, err := p.expectIdent()
+	if err != nil {
+		return err
+	}
+
+	if strings.EqualFold(name, "REF") {
+		reference = true
+
+		if name, err = p.expectIdent(); err != nil {
+			return err
+		}
+	}
+
+	if p.accept("(") {
+		method, err := p.parseMethod(quals, dataType, name)
+		if err != nil {
+			return err
+		}
+
+		class.Methods = append(class.Methods, method)
+
+		return nil
+	}
+
+	prop := Property{
+		Name:        name,
+		Type:        dataType,
+		Reference:   reference,
+		Key:         quals.isTrue("Key"),
+		Write:       quals.isTrue("Write"),
+		Description: quals.first("Description"),
+	}
+	prop.ValueMap, _ = quals.get("ValueMap")
+	prop.Values, _ = quals.get("Values")
+
+	if p.accept("[") {
+		prop.Array = true
+
+		if p.peek().kind == tokenNumber {
+			p.next()
+		}
+
+		if err := p.expect("]"); err != nil {
+			return err
+		}
+	}
+
+	class.Properties = append(class.Properties, prop)
+
+	// default values are not carried into the generated types
+	if p.accept("=") {
+		return p.skipStatement()
+	}
+
+	return p.expect(";")
+}
+
+func (p *mofParser) parseMethod(quals qualifiers, returnType, name string) (Method, error) {
+	method := Method{
+		Name:        name,
+		ReturnType:  returnType,
+		Description: quals.first("Description"),
+	}
+	method.ValueMap, _ = quals.get("ValueMap")
+	method.Values, _ = quals.get("Values")
+
+	for !p.accept(")") {
+		param, err := p.parseParameter()
+		if err != nil {
+			return Method{}, err
+		}
+
+		method.Parameters = append(method.Parameters, param)
+
+		p.accept(",")
+	}
+
+	return method, p.expect(";")
+}
+
+func (p *mofParser) parseParameter() (Parameter, error) {
+	quals, err := p.parseQualifiers()
+	if err != nil {
+		return Parameter{}, err
+	}
+
+	dataType, err := p.expectIdent()
+	if err != nil {
+		return Parameter{}, err
+	}
+
+	param := Parameter{
+		Type:        dataType,
+		In:          quals.isTrue("In"),
+		Out:         quals.isTrue("Out"),
+		Description: quals.first("Description"),
+	}
+	param.ValueMap, _ = quals.get("ValueMap")
+	param.Values, _ = quals.get("Values")
+
+	// parameters without a direction qualifier are input parameters
+	if !quals.has("In") && !quals.has("Out") {
+		param.In = true
+	}
+
+	if param.Name, err = p.expectIdent(); err != nil {
+		return Parameter{}, err
+	}
+
+	if strings.EqualFold(param.Name, "REF") {
+		param.Reference = true
+
+		if param.Name, err = p.expectIdent(); err != nil {
+			return Parameter{}, err
+		}
+	}
+
+	if p.accept("[") {
+		param.Array = true
+
+		if p.peek().kind == tokenNumber {
+			p.next()
+		}
+
+		if err := p.expect("]"); err != nil {
+			return Parameter{}, err
+		}
+	}
+
+	return param, nil
+}
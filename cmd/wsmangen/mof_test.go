@@ -0,0 +1,102 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMOF(t *testing.T) {
+	data, err := os.ReadFile("testdata/CIM_EnabledLogicalElement.mof")
+	require.NoError(t, err)
+
+	classes, err := ParseMOF(string(data))
+	require.NoError(t, err)
+	require.Len(t, classes, 5)
+
+	system := classes[1]
+	require.Len(t, system.Properties, 1)
+	assert.Equal(t, "OperationalStatus", system.Properties[0].Name)
+	assert.True(t, system.Properties[0].Array)
+	assert.Empty(t, classes[2].Properties)
+
+	element := classes[3]
+	assert.Equal(t, "CIM_EnabledLogicalElement", element.Name)
+	assert.Equal(t, "CIM_LogicalElement", element.SuperClass)
+	assert.Equal(t, "This class extends LogicalElement to abstract the concept of an element that is enabled and disabled.", element.Description)
+
+	require.Len(t, element.Properties, 3)
+	assert.Equal(t, "EnabledState", element.Properties[0].Name)
+	assert.Equal(t, "uint16", element.Properties[0].Type)
+	assert.Equal(t, []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11..32767", "32768..65535"}, element.Properties[0].ValueMap)
+	assert.Equal(t, "Enabled but Offline", element.Properties[0].Values[6])
+
+	require.Len(t, element.Methods, 1)
+	method := element.Methods[0]
+	assert.Equal(t, "RequestStateChange", method.Name)
+	assert.Equal(t, "uint32", method.ReturnType)
+	assert.Len(t, method.ValueMap, 14)
+	require.Len(t, method.Parameters, 3)
+	assert.Equal(t, Parameter{
+		Name: "Job", Type: "CIM_ConcreteJob", Out: true, Reference: true,
+		Description: "May contain a reference to the ConcreteJob created.",
+		ValueMap:    nil, Values: nil,
+	}, method.Parameters[1])
+	assert.Len(t, method.InParameters(), 2)
+	assert.Len(t, method.OutParameters(), 1)
+
+	assert.True(t, element.Properties[2].Write)
+
+	service := classes[4]
+	assert.True(t, service.Properties[0].Key)
+	assert.Len(t, service.WritableProperties(), 4)
+}
+
+func TestParseMOFSyntax(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     string
+		wantErr bool
+	}{
+		{"array property and default value", `class AMT_Test { string Names[]; uint8 Count = 3; [Write] boolean Flag; };`, false},
+		{"parameter without direction defaults to input", `class AMT_Test { uint32 Do(string Value, string Items[]); };`, false},
+		{"instance declarations are skipped", `instance of AMT_Test { Name = "x"; }; class AMT_Test { string Name; };`, false},
+		{"unterminated comment", `/* class AMT_Test {`, true},
+		{"unterminated string", `[Description("open)] class AMT_Test {};`, true},
+		{"missing semicolon", `class AMT_Test { string Name }`, true},
+		{"missing class body", `class AMT_Test ;`, true},
+		{"bad qualifier value", `[ValueMap{"0" = }] class AMT_Test {};`, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			classes, err := ParseMOF(test.src)
+			if test.wantErr {
+				assert.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Len(t, classes, 1)
+		})
+	}
+}
+
+func TestParseMOFMembers(t *testing.T) {
+	classes, err := ParseMOF(`class AMT_Test { string Names[4]; [IN(false), OUT] uint32 Do([IN] string Value, [OUT] uint16 Items[]); };`)
+	require.NoError(t, err)
+
+	assert.True(t, classes[0].Properties[0].Array)
+	assert.Equal(t, []Parameter{
+		{Name: "Value", Type: "string", In: true},
+		{Name: "Items", Type: "uint16", Out: true, Array: true},
+	}, classes[0].Methods[0].Parameters)
+}
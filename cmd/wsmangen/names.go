@@ -0,0 +1,101 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package main
+
+import (
+	"strings"
+	"unicode"
+)
+
+// identifier turns free text such as a MOF Values entry ("Enabled but Offline", "DMTF Reserved") into an exported Go identifier.
+func identifier(text string) string {
+	var out strings.Builder
+
+	upperNext := true
+
+	for _, r := range text {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if upperNext {
+				r = unicode.ToUpper(r)
+			}
+
+			out.WriteRune(r)
+
+			upperNext = false
+		default:
+			upperNext = true
+		}
+	}
+
+	id := out.String()
+	if id == "" || unicode.IsDigit(rune(id[0])) {
+		id = "Value" + id
+	}
+
+	return id
+}
+
+// lowerFirst returns s with its leading upper case run lowered, e.g. "IPAddress" -> "ipAddress", "Handle" -> "handle".
+func lowerFirst(s string) string {
+	runes := []rune(s)
+
+	for i := range runes {
+		if !unicode.IsUpper(runes[i]) {
+			break
+		}
+
+		// keep the last upper case letter of an acronym when it starts the next word
+		if i > 0 && i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+			break
+		}
+
+		runes[i] = unicode.ToLower(runes[i])
+	}
+
+	name := string(runes)
+	if isGoKeyword(name) {
+		name += "Value"
+	}
+
+	return name
+}
+
+func isGoKeyword(s string) bool {
+	switch s {
+	case "break", "case", "chan", "const", "continue", "default", "defer", "else", "fallthrough", "for", "func",
+		"go", "goto", "if", "import", "interface", "map", "package", "range", "return", "select", "struct", "switch",
+		"type", "var":
+		return true
+	}
+
+	return false
+}
+
+// classConstName returns the repo's constant name for a class, e.g. AMT_WebUIService -> AMTWebUIService.
+func classConstName(className string) string {
+	return strings.ReplaceAll(className, "_", "")
+}
+
+// packageName returns the default package name for a class, e.g. AMT_WebUIService -> webuiservice.
+func packageName(className string) string {
+	_, rest, found := strings.Cut(className, "_")
+	if !found {
+		rest = className
+	}
+
+	return strings.ToLower(strings.ReplaceAll(rest, "_", ""))
+}
+
+// structName returns the name of the Go struct describing a class instance, e.g. AMT_WebUIService -> WebUIService.
+func structName(className string) string {
+	_, rest, found := strings.Cut(className, "_")
+	if !found {
+		rest = className
+	}
+
+	return identifier(rest)
+}
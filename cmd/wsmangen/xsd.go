@@ -0,0 +1,253 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package main
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrNoClass is returned when a schema file does not describe a class.
+var ErrNoClass = errors.New("no class definition found")
+
+// xsdNode is a generic XML element used to walk XSD documents without modelling the full schema grammar.
+type xsdNode struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr `xml:",any,attr"`
+	Children []xsdNode  `xml:",any"`
+	Text     string     `xml:",chardata"`
+}
+
+func (n xsdNode) attr(name string) string {
+	for _, a := range n.Attrs {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+
+	return ""
+}
+
+type xsdSchema struct {
+	elements     map[string]xsdNode
+	complexTypes map[string]xsdNode
+}
+
+// ParseXSD reads an Intel or DMTF WS-CIM class schema. The class name is taken from the last segment of the target namespace;
+// global elements ending in _INPUT and _OUTPUT are treated as method parameters.
+func ParseXSD(data []byte) (Class, error) {
+	var root xsdNode
+
+	if err := xml.Unmarshal(data, &root); err != nil {
+		return Class{}, fmt.Errorf("failed to parse schema: %w", err)
+	}
+
+	schema := xsdSchema{elements: map[string]xsdNode{}, complexTypes: map[string]xsdNode{}}
+
+	for _, child := range root.Children {
+		switch child.XMLName.Local {
+		case "element":
+			schema.elements[child.attr("name")] = child
+		case "complexType":
+			schema.complexTypes[child.attr("name")] = child
+		}
+	}
+
+	namespace := root.attr("targetNamespace")
+	className := namespace[strings.LastIndex(namespace, "/")+1:]
+
+	classElement, ok := schema.elements[className]
+	if className == "" || !ok {
+		return Class{}, fmt.Errorf("%w in namespace %q", ErrNoClass, namespace)
+	}
+
+	class := Class{Name: className, Description: documentation(classElement)}
+
+	for _, element := range schema.members(classElement) {
+		prop := schema.property(element)
+		class.Properties = append(class.Properties, prop)
+	}
+
+	for name, element := range schema.elements {
+		methodName, found := strings.CutSuffix(name, "_INPUT")
+		if !found {
+			continue
+		}
+
+		method := Method{Name: methodName, ReturnType: "uint32", Description: documentation(element)}
+
+		for _, param := range schema.members(element) {
+			prop := schema.property(param)
+			method.Parameters = append(method.Parameters, Parameter{
+				Name: prop.Name, Type: prop.Type, Array: prop.Array, In: true, Reference: prop.Reference,
+				Description: prop.Description, ValueMap: prop.ValueMap, Values: prop.Values,
+			})
+		}
+
+		for _, param := range schema.members(schema.elements[methodName+"_OUTPUT"]) {
+			prop := schema.property(param)
+			if prop.Name == "ReturnValue" {
+				method.ValueMap = prop.ValueMap
+				method.Values = prop.Values
+
+				continue
+			}
+
+			method.Parameters = append(method.Parameters, Parameter{
+				Name: prop.Name, Type: prop.Type, Array: prop.Array, Out: true, Reference: prop.Reference,
+				Description: prop.Description, ValueMap: prop.ValueMap, Values: prop.Values,
+			})
+		}
+
+		class.Methods = append(class.Methods, method)
+	}
+
+	sortMethods(class.Methods)
+
+	return class, nil
+}
+
+// members returns the element declarations that make up the content of a global element.
+func (s xsdSchema) members(element xsdNode) []xsdNode {
+	content := element
+
+	if typeName := localName(element.attr("type")); typeName != "" {
+		content = s.complexTypes[typeName]
+	}
+
+	members := []xsdNode{}
+
+	var walk func(n xsdNode)
+
+	walk = func(n xsdNode) {
+		for _, child := range n.Children {
+			switch child.XMLName.Local {
+			case "element":
+				members = append(members, child)
+			case "extension":
+				if base, ok := s.complexTypes[localName(child.attr("base"))]; ok {
+					walk(base)
+				}
+
+				walk(child)
+			case "complexType", "complexContent", "sequence", "all", "choice":
+				walk(child)
+			}
+		}
+	}
+
+	walk(content)
+
+	return members
+}
+
+// property converts an element declaration, following ref="" to the global element, into a Property.
+func (s xsdSchema) property(element xsdNode) Property {
+	prop := Property{Name: element.attr("name")}
+
+	if element.attr("maxOccurs") == "unbounded" {
+		prop.Array = true
+	}
+
+	if ref := localName(element.attr("ref")); ref != "" {
+		prop.Name = ref
+
+		if global, ok := s.elements[ref]; ok {
+			element = global
+		}
+	}
+
+	prop.Description = documentation(element)
+	prop.Type = cimType(element.attr("type"))
+
+	var walk func(n xsdNode)
+
+	walk = func(n xsdNode) {
+		for _, child := range n.Children {
+			switch child.XMLName.Local {
+			case "restriction", "extension":
+				if prop.Type == "" {
+					prop.Type = cimType(child.attr("base"))
+				}
+
+				walk(child)
+			case "enumeration":
+				prop.ValueMap = append(prop.ValueMap, child.attr("value"))
+			case "complexType", "simpleType", "simpleContent", "complexContent":
+				walk(child)
+			}
+		}
+	}
+
+	walk(element)
+
+	if prop.Type == "" {
+		prop.Type = "string"
+	}
+
+	prop.Reference = prop.Type == "ref"
+
+	return prop
+}
+
+// documentation returns the text of an xs:annotation/xs:documentation child, if any.
+func documentation(n xsdNode) string {
+	for _, child := range n.Children {
+		if child.XMLName.Local != "annotation" {
+			continue
+		}
+
+		for _, doc := range child.Children {
+			if doc.XMLName.Local == "documentation" {
+				return strings.Join(strings.Fields(doc.Text), " ")
+			}
+		}
+	}
+
+	return ""
+}
+
+func localName(qualified string) string {
+	return qualified[strings.LastIndex(qualified, ":")+1:]
+}
+
+// cimType maps an XSD or WS-CIM common type to the equivalent CIM data type name.
+func cimType(xsdType string) string {
+	switch strings.ToLower(localName(xsdType)) {
+	case "":
+		return ""
+	case "cimboolean", "boolean":
+		return "boolean"
+	case "cimbyte", "byte":
+		return "sint8"
+	case "cimunsignedbyte", "unsignedbyte":
+		return "uint8"
+	case "cimshort", "short":
+		return "sint16"
+	case "cimunsignedshort", "unsignedshort":
+		return "uint16"
+	case "cimint", "int":
+		return "sint32"
+	case "cimunsignedint", "unsignedint":
+		return "uint32"
+	case "cimlong", "long":
+		return "sint64"
+	case "cimunsignedlong", "unsignedlong":
+		return "uint64"
+	case "cimfloat", "float":
+		return "real32"
+	case "cimdouble", "double":
+		return "real64"
+	case "cimdatetime", "datetime":
+		return "datetime"
+	case "cimreference", "endpointreferencetype":
+		return "ref"
+	default:
+		return "string"
+	}
+}
@@ -0,0 +1,70 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseXSD(t *testing.T) {
+	data, err := os.ReadFile("testdata/IPS_ScreenConfigurationService.xsd")
+	require.NoError(t, err)
+
+	class, err := ParseXSD(data)
+	require.NoError(t, err)
+
+	assert.Equal(t, "IPS_ScreenConfigurationService", class.Name)
+	assert.Equal(t, "ips", class.Namespace())
+	assert.Equal(t, []Property{
+		{Name: "Name", Type: "string"},
+		{Name: "EnabledState", Type: "uint16", Description: "Indicates whether the consent screen is enabled.", ValueMap: []string{"2", "3"}},
+		{Name: "CurrentSessionState", Type: "uint32"},
+	}, class.Properties)
+
+	require.Len(t, class.Methods, 1)
+	assert.Equal(t, "SetSessionState", class.Methods[0].Name)
+	assert.Equal(t, []Parameter{
+		{Name: "SessionState", Type: "uint32", In: true},
+		{Name: "ConsecutiveRebootsNum", Type: "uint32", In: true},
+	}, class.Methods[0].Parameters)
+}
+
+func TestParseXSDErrors(t *testing.T) {
+	_, err := ParseXSD([]byte("not xml"))
+	assert.Error(t, err)
+
+	_, err = ParseXSD([]byte(`<schema targetNamespace="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_Missing"></schema>`))
+	assert.ErrorIs(t, err, ErrNoClass)
+}
+
+func TestCIMType(t *testing.T) {
+	tests := map[string]string{
+		"":                      "",
+		"cim:cimBoolean":        "boolean",
+		"xs:byte":               "sint8",
+		"cim:cimUnsignedByte":   "uint8",
+		"xs:short":              "sint16",
+		"cim:cimUnsignedShort":  "uint16",
+		"cim:cimInt":            "sint32",
+		"cim:cimUnsignedInt":    "uint32",
+		"xs:long":               "sint64",
+		"cim:cimUnsignedLong":   "uint64",
+		"xs:float":              "real32",
+		"cim:cimDouble":         "real64",
+		"cim:cimDateTime":       "datetime",
+		"cim:cimReference":      "ref",
+		"EndpointReferenceType": "ref",
+		"xs:base64Binary":       "string",
+	}
+
+	for in, expected := range tests {
+		assert.Equal(t, expected, cimType(in), in)
+	}
+}
@@ -6,7 +6,10 @@
 package message
 
 import (
+	"bytes"
 	"fmt"
+	"io"
+	"strings"
 
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
 )
@@ -27,41 +30,73 @@ func NewBaseWithClient(wsmanMessageCreator *WSManMessageCreator, className strin
 }
 
 // Enumerate returns an enumeration context which is used in a subsequent Pull call.
-func (b *Base) Enumerate() string {
-	header := b.WSManMessageCreator.CreateHeader(BaseActionsEnumerate, b.ClassName, nil, "", "")
+func (b *Base) Enumerate(opts ...HeaderOption) string {
+	header := b.WSManMessageCreator.CreateHeader(BaseActionsEnumerate, b.ClassName, nil, "", "", opts...)
 
 	return b.WSManMessageCreator.CreateXML(header, EnumerateBody)
 }
 
+// EnumerateAssociated returns an enumeration context for the instances of this class that are associated with the
+// instance of objectClass identified by selector. associationClass narrows the traversal to a single association and
+// may be empty.
+func (b *Base) EnumerateAssociated(objectClass string, selector Selector, associationClass string, opts ...HeaderOption) string {
+	header := b.WSManMessageCreator.CreateHeader(BaseActionsEnumerate, b.ClassName, nil, "", "", opts...)
+
+	var body strings.Builder
+
+	body.WriteString(`<Body><Enumerate xmlns="http://schemas.xmlsoap.org/ws/2004/09/enumeration"><w:Filter Dialect="`)
+	body.WriteString(AssociationFilterDialect)
+	body.WriteString(`"><b:AssociatedInstances xmlns:b="http://schemas.dmtf.org/wbem/wsman/1/cimbinding.xsd"><b:Object><a:Address>`)
+	body.WriteString(b.WSManMessageCreator.AnonymousAddress)
+	body.WriteString(`</a:Address><a:ReferenceParameters><w:ResourceURI>`)
+	body.WriteString(b.WSManMessageCreator.ResourceURIBase)
+	body.WriteString(objectClass)
+	body.WriteString(`</w:ResourceURI>`)
+	writeSelectors(&body, []Selector{selector})
+	body.WriteString(`</a:ReferenceParameters></b:Object>`)
+
+	if associationClass != "" {
+		body.WriteString(`<b:AssociationClassName>`)
+		body.WriteString(associationClass)
+		body.WriteString(`</b:AssociationClassName>`)
+	}
+
+	body.WriteString(`<b:ResultClassName>`)
+	body.WriteString(b.ClassName)
+	body.WriteString(`</b:ResultClassName></b:AssociatedInstances></w:Filter></Enumerate></Body>`)
+
+	return b.WSManMessageCreator.CreateXML(header, body.String())
+}
+
 // Get retrieves the representation of the instance.
-func (b *Base) Get(selector *Selector) string {
+func (b *Base) Get(selector *Selector, opts ...HeaderOption) string {
 	selectors := []Selector{}
 	if selector != nil {
 		selectors = append(selectors, *selector)
 	}
 
-	header := b.WSManMessageCreator.CreateHeader(BaseActionsGet, b.ClassName, selectors, "", "")
+	header := b.WSManMessageCreator.CreateHeader(BaseActionsGet, b.ClassName, selectors, "", "", opts...)
 
 	return b.WSManMessageCreator.CreateXML(header, GetBody)
 }
 
 // Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
-func (b *Base) Pull(enumerationContext string) string {
-	header := b.WSManMessageCreator.CreateHeader(BaseActionsPull, b.ClassName, nil, "", "")
+func (b *Base) Pull(enumerationContext string, opts ...HeaderOption) string {
+	header := b.WSManMessageCreator.CreateHeader(BaseActionsPull, b.ClassName, nil, "", "", opts...)
 	body := createCommonBodyPull(enumerationContext, 0, 0)
 
 	return b.WSManMessageCreator.CreateXML(header, body)
 }
 
 // Delete removes a the specified instance.
-func (b *Base) Delete(selector Selector) string {
-	header := b.WSManMessageCreator.CreateHeader(BaseActionsDelete, b.ClassName, []Selector{selector}, "", "")
+func (b *Base) Delete(selector Selector, opts ...HeaderOption) string {
+	header := b.WSManMessageCreator.CreateHeader(BaseActionsDelete, b.ClassName, []Selector{selector}, "", "", opts...)
 
 	return b.WSManMessageCreator.CreateXML(header, DeleteBody)
 }
 
 // Put will change properties of the selected instance.
-func (b *Base) Put(data interface{}, useHeaderSelector bool, selectorSet []Selector) string {
+func (b *Base) Put(data interface{}, useHeaderSelector bool, selectorSet []Selector, opts ...HeaderOption) string {
 	if selectorSet == nil {
 		selectorSet = []Selector{{Name: "InstanceID", Value: fmt.Sprintf("%v", data)}}
 	}
@@ -69,9 +104,9 @@ func (b *Base) Put(data interface{}, useHeaderSelector bool, selectorSet []Selec
 	var header string
 
 	if useHeaderSelector {
-		header = b.WSManMessageCreator.CreateHeader(BaseActionsPut, b.ClassName, selectorSet, "", "")
+		header = b.WSManMessageCreator.CreateHeader(BaseActionsPut, b.ClassName, selectorSet, "", "", opts...)
 	} else {
-		header = b.WSManMessageCreator.CreateHeader(BaseActionsPut, b.ClassName, nil, "", "")
+		header = b.WSManMessageCreator.CreateHeader(BaseActionsPut, b.ClassName, nil, "", "", opts...)
 	}
 
 	body := b.WSManMessageCreator.createCommonBodyCreateOrPut(b.ClassName, data)
@@ -80,16 +115,16 @@ func (b *Base) Put(data interface{}, useHeaderSelector bool, selectorSet []Selec
 }
 
 // Creates a new instance of this class.
-func (b *Base) Create(data interface{}, selectorSet []Selector) string {
-	header := b.WSManMessageCreator.CreateHeader(BaseActionsCreate, b.ClassName, selectorSet, "", "")
+func (b *Base) Create(data interface{}, selectorSet []Selector, opts ...HeaderOption) string {
+	header := b.WSManMessageCreator.CreateHeader(BaseActionsCreate, b.ClassName, selectorSet, "", "", opts...)
 	body := b.WSManMessageCreator.createCommonBodyCreateOrPut(b.ClassName, data)
 
 	return b.WSManMessageCreator.CreateXML(header, body)
 }
 
 // RequestStateChange requests that the state of the element be changed to the value specified in the RequestedState parameter . . .
-func (b *Base) RequestStateChange(actionName string, requestedState int) string {
-	header := b.WSManMessageCreator.CreateHeader(actionName, b.ClassName, nil, "", "")
+func (b *Base) RequestStateChange(actionName string, requestedState int, opts ...HeaderOption) string {
+	header := b.WSManMessageCreator.CreateHeader(actionName, b.ClassName, nil, "", "", opts...)
 	body := createCommonBodyRequestStateChange(fmt.Sprintf("%s%s", b.WSManMessageCreator.ResourceURIBase, b.ClassName), requestedState)
 
 	return b.WSManMessageCreator.CreateXML(header, body)
@@ -108,3 +143,22 @@ func (b *Base) Execute(message *client.Message) error {
 	// potentially could return an error that says that client doesn't exist
 	return nil
 }
+
+// ExecuteReader sends xmlInput and returns the response body as a stream. Clients that implement client.Streamer hand
+// out the body as it arrives; for other clients the buffered response is wrapped in a reader.
+func (b *Base) ExecuteReader(xmlInput string) (io.ReadCloser, error) {
+	if b.client == nil {
+		return io.NopCloser(bytes.NewReader(nil)), nil
+	}
+
+	if streamer, ok := b.client.(client.Streamer); ok {
+		return streamer.PostReader(xmlInput)
+	}
+
+	response, err := b.client.Post(xmlInput)
+	if err != nil {
+		return nil, err
+	}
+
+	return io.NopCloser(bytes.NewReader(response)), nil
+}
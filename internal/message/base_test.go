@@ -9,7 +9,10 @@ import (
 	"crypto/tls"
 	"errors"
 	"fmt"
+	"io"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -74,6 +77,13 @@ func TestBaseWithClient(t *testing.T) {
 		assert.Equal(t, expected, actual)
 	})
 
+	t.Run("Get with header options", func(t *testing.T) {
+		expected := fmt.Sprintf("<?xml version=\"1.0\" encoding=\"utf-8\"?><Envelope xmlns:xsi=\"http://www.w3.org/2001/XMLSchema-instance\" xmlns:xsd=\"http://www.w3.org/2001/XMLSchema\" xmlns:a=\"http://schemas.xmlsoap.org/ws/2004/08/addressing\" xmlns:w=\"http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd\" xmlns=\"http://www.w3.org/2003/05/soap-envelope\"><Header><a:Action>http://schemas.xmlsoap.org/ws/2004/09/transfer/Get</a:Action><a:To>/wsman</a:To><w:ResourceURI>test-uriTestClass</w:ResourceURI><a:MessageID>%d</a:MessageID><a:ReplyTo><a:Address>http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous</a:Address></a:ReplyTo><w:OperationTimeout>PT120S</w:OperationTimeout><w:Locale xml:lang=\"de-DE\" /></Header><Body></Body></Envelope>", MessageID)
+		MessageID++
+		actual := base.Get(nil, WithOperationTimeout(2*time.Minute), WithLocale("de-DE"))
+		assert.Equal(t, expected, actual)
+	})
+
 	t.Run("Pull", func(t *testing.T) {
 		enumerationContext := TestContext
 		expected := fmt.Sprintf("<?xml version=\"1.0\" encoding=\"utf-8\"?><Envelope xmlns:xsi=\"http://www.w3.org/2001/XMLSchema-instance\" xmlns:xsd=\"http://www.w3.org/2001/XMLSchema\" xmlns:a=\"http://schemas.xmlsoap.org/ws/2004/08/addressing\" xmlns:w=\"http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd\" xmlns=\"http://www.w3.org/2003/05/soap-envelope\"><Header><a:Action>http://schemas.xmlsoap.org/ws/2004/09/enumeration/Pull</a:Action><a:To>/wsman</a:To><w:ResourceURI>test-uriTestClass</w:ResourceURI><a:MessageID>%d</a:MessageID><a:ReplyTo><a:Address>http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous</a:Address></a:ReplyTo><w:OperationTimeout>PT60S</w:OperationTimeout></Header><Body><Pull xmlns=\"http://schemas.xmlsoap.org/ws/2004/09/enumeration\"><EnumerationContext>test-context</EnumerationContext><MaxElements>999</MaxElements><MaxCharacters>99999</MaxCharacters></Pull></Body></Envelope>", MessageID)
@@ -139,6 +149,14 @@ func TestBase(t *testing.T) {
 		assert.Equal(t, expected, actual)
 	})
 
+	t.Run("EnumerateAssociated", func(t *testing.T) {
+		selector := Selector{Name: "InstanceID", Value: "Value"}
+		expected := fmt.Sprintf("<?xml version=\"1.0\" encoding=\"utf-8\"?><Envelope xmlns:xsi=\"http://www.w3.org/2001/XMLSchema-instance\" xmlns:xsd=\"http://www.w3.org/2001/XMLSchema\" xmlns:a=\"http://schemas.xmlsoap.org/ws/2004/08/addressing\" xmlns:w=\"http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd\" xmlns=\"http://www.w3.org/2003/05/soap-envelope\"><Header><a:Action>http://schemas.xmlsoap.org/ws/2004/09/enumeration/Enumerate</a:Action><a:To>/wsman</a:To><w:ResourceURI>test-uriTestClass</w:ResourceURI><a:MessageID>%d</a:MessageID><a:ReplyTo><a:Address>http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous</a:Address></a:ReplyTo><w:OperationTimeout>PT60S</w:OperationTimeout></Header><Body><Enumerate xmlns=\"http://schemas.xmlsoap.org/ws/2004/09/enumeration\"><w:Filter Dialect=\"http://schemas.dmtf.org/wbem/wsman/1/cimbinding/associationFilter\"><b:AssociatedInstances xmlns:b=\"http://schemas.dmtf.org/wbem/wsman/1/cimbinding.xsd\"><b:Object><a:Address>http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous</a:Address><a:ReferenceParameters><w:ResourceURI>test-uriObjectClass</w:ResourceURI><w:SelectorSet><w:Selector Name=\"InstanceID\">Value</w:Selector></w:SelectorSet></a:ReferenceParameters></b:Object><b:AssociationClassName>AssociationClass</b:AssociationClassName><b:ResultClassName>TestClass</b:ResultClassName></b:AssociatedInstances></w:Filter></Enumerate></Body></Envelope>", MessageID)
+		MessageID++
+		actual := base.EnumerateAssociated("ObjectClass", selector, "AssociationClass")
+		assert.Equal(t, expected, actual)
+	})
+
 	t.Run("Get", func(t *testing.T) {
 		selector := &Selector{Name: "Key", Value: "Value"}
 		expected := fmt.Sprintf("<?xml version=\"1.0\" encoding=\"utf-8\"?><Envelope xmlns:xsi=\"http://www.w3.org/2001/XMLSchema-instance\" xmlns:xsd=\"http://www.w3.org/2001/XMLSchema\" xmlns:a=\"http://schemas.xmlsoap.org/ws/2004/08/addressing\" xmlns:w=\"http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd\" xmlns=\"http://www.w3.org/2003/05/soap-envelope\"><Header><a:Action>http://schemas.xmlsoap.org/ws/2004/09/transfer/Get</a:Action><a:To>/wsman</a:To><w:ResourceURI>test-uriTestClass</w:ResourceURI><a:MessageID>%d</a:MessageID><a:ReplyTo><a:Address>http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous</a:Address></a:ReplyTo><w:OperationTimeout>PT60S</w:OperationTimeout><w:SelectorSet><w:Selector Name=\"Key\">Value</w:Selector></w:SelectorSet></Header><Body></Body></Envelope>", MessageID)
@@ -199,3 +217,49 @@ func TestBase(t *testing.T) {
 		assert.Equal(t, expected, actual)
 	})
 }
+
+type MockStreamer struct {
+	MockClient
+}
+
+func (c *MockStreamer) PostReader(msg string) (io.ReadCloser, error) {
+	return io.NopCloser(strings.NewReader("streamed " + msg)), c.Err
+}
+
+func TestExecuteReader(t *testing.T) {
+	creator := NewWSManMessageCreator("test-uri")
+
+	t.Run("without client", func(t *testing.T) {
+		base := NewBase(creator, "TestClass")
+		body, err := base.ExecuteReader("TestMessage")
+		assert.NoError(t, err)
+
+		response, err := io.ReadAll(body)
+		assert.NoError(t, err)
+		assert.Empty(t, response)
+	})
+
+	t.Run("buffers responses of clients without streaming support", func(t *testing.T) {
+		base := NewBaseWithClient(creator, "TestClass", &MockClient{})
+		body, err := base.ExecuteReader("TestMessage")
+		assert.NoError(t, err)
+
+		response, err := io.ReadAll(body)
+		assert.NoError(t, err)
+		assert.Empty(t, response)
+
+		base = NewBaseWithClient(creator, "TestClass", &MockClient{Err: errors.New("test error")})
+		_, err = base.ExecuteReader("TestMessage")
+		assert.Error(t, err)
+	})
+
+	t.Run("uses the streaming client", func(t *testing.T) {
+		base := NewBaseWithClient(creator, "TestClass", &MockStreamer{})
+		body, err := base.ExecuteReader("TestMessage")
+		assert.NoError(t, err)
+
+		response, err := io.ReadAll(body)
+		assert.NoError(t, err)
+		assert.Equal(t, "streamed TestMessage", string(response))
+	})
+}
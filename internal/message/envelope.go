@@ -0,0 +1,85 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package message
+
+import (
+	"bytes"
+	"encoding/xml"
+	"reflect"
+	"sync"
+)
+
+// envelopeCache holds what a WSManMessageCreator reuses between messages: XML encoders with their output buffers,
+// and the location of the H namespace field of each request type.
+type envelopeCache struct {
+	encoders   sync.Pool
+	namespaces sync.Map // reflect.Type -> []int, nil when the type has no string H field
+}
+
+// bodyEncoder is an xml.Encoder writing into its own buffer. An encoder that completed Encode is balanced and can
+// be reused for the next body.
+type bodyEncoder struct {
+	buf     bytes.Buffer
+	encoder *xml.Encoder
+	failed  bool
+}
+
+func (e *bodyEncoder) encode(data interface{}) error {
+	mark := e.buf.Len()
+
+	if err := e.encoder.Encode(data); err != nil {
+		// drop partial output to match xml.Marshal, and the encoder whose state is now unknown
+		e.buf.Truncate(mark)
+		e.failed = true
+
+		return err
+	}
+
+	return nil
+}
+
+func (c *envelopeCache) getEncoder() *bodyEncoder {
+	if c != nil {
+		if e, ok := c.encoders.Get().(*bodyEncoder); ok {
+			return e
+		}
+	}
+
+	e := &bodyEncoder{}
+	e.encoder = xml.NewEncoder(&e.buf)
+
+	return e
+}
+
+func (c *envelopeCache) putEncoder(e *bodyEncoder) {
+	if c == nil || e.failed {
+		return
+	}
+
+	e.buf.Reset()
+	c.encoders.Put(e)
+}
+
+// namespaceField returns the index of the string field H of struct type t, or nil if there is none.
+func (c *envelopeCache) namespaceField(t reflect.Type) []int {
+	if c != nil {
+		if index, ok := c.namespaces.Load(t); ok {
+			return index.([]int)
+		}
+	}
+
+	var index []int
+
+	if field, ok := t.FieldByName("H"); ok && field.Type.Kind() == reflect.String {
+		index = field.Index
+	}
+
+	if c != nil {
+		c.namespaces.Store(t, index)
+	}
+
+	return index
+}
@@ -0,0 +1,110 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package message
+
+import (
+	"encoding/xml"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HeaderOptions holds the optional WS-Management header elements that can be set on a single request.
+type HeaderOptions struct {
+	ReplyTo          string
+	OperationTimeout string
+	Locale           string
+	MaxEnvelopeSize  int
+	OptionSet        []Option
+}
+
+// Option is a single entry of the WS-Management OptionSet header.
+type Option struct {
+	Name  string
+	Value string
+}
+
+// HeaderOption configures the WS-Management header of a single request.
+type HeaderOption func(*HeaderOptions)
+
+// WithOperationTimeout sets the OperationTimeout header, overriding the default of 60 seconds.
+func WithOperationTimeout(timeout time.Duration) HeaderOption {
+	return func(o *HeaderOptions) {
+		o.OperationTimeout = FormatDuration(timeout)
+	}
+}
+
+// WithLocale sets the Locale header to the given language tag, e.g. "en-US".
+func WithLocale(locale string) HeaderOption {
+	return func(o *HeaderOptions) {
+		o.Locale = locale
+	}
+}
+
+// WithMaxEnvelopeSize sets the MaxEnvelopeSize header, in octets.
+func WithMaxEnvelopeSize(size int) HeaderOption {
+	return func(o *HeaderOptions) {
+		o.MaxEnvelopeSize = size
+	}
+}
+
+// WithOption adds a named entry to the OptionSet header. It can be repeated to add several options.
+func WithOption(name, value string) HeaderOption {
+	return func(o *HeaderOptions) {
+		o.OptionSet = append(o.OptionSet, Option{Name: name, Value: value})
+	}
+}
+
+// WithReplyTo sets the ReplyTo address, overriding the anonymous address.
+func WithReplyTo(address string) HeaderOption {
+	return func(o *HeaderOptions) {
+		o.ReplyTo = address
+	}
+}
+
+// FormatDuration formats d as an xs:duration in seconds, e.g. 90s becomes "PT90S" and 1.5s becomes "PT1.5S".
+func FormatDuration(d time.Duration) string {
+	return "PT" + strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "S"
+}
+
+func applyHeaderOptions(address, timeout string, opts []HeaderOption) HeaderOptions {
+	options := HeaderOptions{ReplyTo: address, OperationTimeout: timeout}
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return options
+}
+
+// writeOptionalHeaders writes the MaxEnvelopeSize, Locale and OptionSet header elements, if set.
+func writeOptionalHeaders(headers *strings.Builder, options HeaderOptions) {
+	if options.MaxEnvelopeSize > 0 {
+		headers.WriteString("<w:MaxEnvelopeSize>" + strconv.Itoa(options.MaxEnvelopeSize) + "</w:MaxEnvelopeSize>")
+	}
+
+	if options.Locale != "" {
+		headers.WriteString(`<w:Locale xml:lang="` + escape(options.Locale) + `" />`)
+	}
+
+	if len(options.OptionSet) > 0 {
+		headers.WriteString("<w:OptionSet>")
+
+		for _, option := range options.OptionSet {
+			headers.WriteString(`<w:Option Name="` + escape(option.Name) + `">` + escape(option.Value) + "</w:Option>")
+		}
+
+		headers.WriteString("</w:OptionSet>")
+	}
+}
+
+func escape(s string) string {
+	var out strings.Builder
+
+	_ = xml.EscapeText(&out, []byte(s))
+
+	return out.String()
+}
@@ -59,4 +59,5 @@ type WSManMessageCreator struct {
 	AnonymousAddress string
 	DefaultTimeout   string
 	ResourceURIBase  string
+	cache            *envelopeCache
 }
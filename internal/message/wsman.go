@@ -6,15 +6,17 @@
 package message
 
 import (
-	"encoding/xml"
-	"fmt"
 	"log"
 	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/sirupsen/logrus"
 )
 
+// headerSizeHint is large enough for the header of most requests, so that building it needs a single allocation.
+const headerSizeHint = 512
+
 func NewWSManMessageCreator(resourceURIBase string) *WSManMessageCreator {
 	return &WSManMessageCreator{
 		MessageID:        0,
@@ -23,6 +25,7 @@ func NewWSManMessageCreator(resourceURIBase string) *WSManMessageCreator {
 		AnonymousAddress: "http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous",
 		DefaultTimeout:   "PT60S",
 		ResourceURIBase:  resourceURIBase,
+		cache:            &envelopeCache{},
 	}
 }
 
@@ -30,101 +33,119 @@ func (w WSManMessageCreator) CreateXML(header, body string) string {
 	return w.XMLCommonPrefix + header + body + w.XMLCommonEnd
 }
 
-func (w *WSManMessageCreator) CreateHeader(action, wsmanClass string, selectorSet []Selector, address, timeout string) string {
-	header := "<Header>"
-	header += fmt.Sprintf(`<a:Action>%s</a:Action><a:To>/wsman</a:To><w:ResourceURI>%s%s</w:ResourceURI><a:MessageID>%d</a:MessageID><a:ReplyTo>`, action, w.ResourceURIBase, wsmanClass, w.MessageID)
+// CreateHeader builds the WS-Management header for a request. The address and timeout arguments set the ReplyTo address
+// and OperationTimeout when not empty; opts are applied on top of them.
+func (w *WSManMessageCreator) CreateHeader(action, wsmanClass string, selectorSet []Selector, address, timeout string, opts ...HeaderOption) string {
+	options := applyHeaderOptions(address, timeout, opts)
+
+	var header strings.Builder
+
+	header.Grow(headerSizeHint)
+	header.WriteString("<Header><a:Action>")
+	header.WriteString(action)
+	header.WriteString("</a:Action><a:To>/wsman</a:To><w:ResourceURI>")
+	header.WriteString(w.ResourceURIBase)
+	header.WriteString(wsmanClass)
+	header.WriteString("</w:ResourceURI><a:MessageID>")
+	header.WriteString(strconv.Itoa(w.MessageID))
+	header.WriteString("</a:MessageID><a:ReplyTo><a:Address>")
 
 	w.MessageID++
 
-	if address != "" {
-		header += fmt.Sprintf(`<a:Address>%s</a:Address>`, address)
+	if options.ReplyTo != "" {
+		header.WriteString(options.ReplyTo)
 	} else {
-		header += fmt.Sprintf(`<a:Address>%s</a:Address>`, w.AnonymousAddress)
+		header.WriteString(w.AnonymousAddress)
 	}
 
-	header += "</a:ReplyTo>"
+	header.WriteString("</a:Address></a:ReplyTo><w:OperationTimeout>")
 
-	if timeout != "" {
-		header += fmt.Sprintf(`<w:OperationTimeout>%s</w:OperationTimeout>`, timeout)
+	if options.OperationTimeout != "" {
+		header.WriteString(options.OperationTimeout)
 	} else {
-		header += fmt.Sprintf(`<w:OperationTimeout>%s</w:OperationTimeout>`, w.DefaultTimeout)
+		header.WriteString(w.DefaultTimeout)
 	}
 
+	header.WriteString("</w:OperationTimeout>")
+
+	writeOptionalHeaders(&header, options)
+
 	if selectorSet != nil {
-		header += w.createSelector(selectorSet)
+		writeSelectors(&header, selectorSet)
 	}
 
-	header += "</Header>"
+	header.WriteString("</Header>")
 
-	return header
+	return header.String()
 }
 
 func IsSlice(v interface{}) bool {
 	return reflect.TypeOf(v).Kind() == reflect.Slice
 }
 
+// namespaceMe sets the H field of the struct subj points to, which holds the xmlns:h attribute, to the class namespace.
 func (w WSManMessageCreator) namespaceMe(subj interface{}, wsmanClass string) {
 	ifaceValue := reflect.ValueOf(subj)
 	// Check if the interface value is a pointer
-	if ifaceValue.Kind() == reflect.Ptr {
-		// Get the underlying value of the interface
-		stype := ifaceValue.Elem()
-		if stype.Kind() == reflect.Struct {
-			field := stype.FieldByName("H")
-
-			fieldPtr, ok := field.Addr().Interface().(*string)
-			if !ok {
-				logrus.Error("Failed to convert H to string")
-			}
-
-			*fieldPtr = fmt.Sprintf("%s%s", w.ResourceURIBase, wsmanClass)
-		}
+	if ifaceValue.Kind() != reflect.Ptr {
+		return
+	}
+
+	// Get the underlying value of the interface
+	stype := ifaceValue.Elem()
+	if stype.Kind() != reflect.Struct {
+		return
 	}
+
+	index := w.cache.namespaceField(stype.Type())
+	if index == nil {
+		logrus.Error("Failed to convert H to string")
+
+		return
+	}
+
+	stype.FieldByIndex(index).SetString(w.ResourceURIBase + wsmanClass)
 }
 
 func (w WSManMessageCreator) CreateBody(method, wsmanClass string, data interface{}) string {
-	var str strings.Builder
+	if data == nil {
+		return `<Body><h:` + method + ` xmlns:h="` + w.ResourceURIBase + wsmanClass + `"></h:` + method + `></Body>`
+	}
 
-	str.WriteString("<Body>")
+	w.namespaceMe(data, wsmanClass)
 
-	if data != nil {
-		w.namespaceMe(data, wsmanClass)
+	encoder := w.cache.getEncoder()
+	defer w.cache.putEncoder(encoder)
 
-		xmlString, err := xml.Marshal(data)
-		if err != nil {
-			log.Println(err)
-		}
+	encoder.buf.WriteString("<Body>")
 
-		str.WriteString(string(xmlString))
-	} else {
-		fmt.Fprintf(&str, `<h:%s xmlns:h="%s%s">`, method, w.ResourceURIBase, wsmanClass)
-		fmt.Fprintf(&str, `</h:%s>`, method)
+	if err := encoder.encode(data); err != nil {
+		log.Println(err)
 	}
 
-	str.WriteString("</Body>")
+	encoder.buf.WriteString("</Body>")
 
-	return str.String()
+	return encoder.buf.String()
 }
 
-// createSelector creates a WSMAN string based on Selector Set information provided.
-// It can be used in the header or body.
+// writeSelectors writes a WSMAN SelectorSet based on the Selector Set information provided.
 // selectorSet is the selector data being passed in. It could take many forms depending on the WSMAN call.
-func (w *WSManMessageCreator) createSelector(selectorSet []Selector) string {
-	var selectors strings.Builder
-
+func writeSelectors(selectors *strings.Builder, selectorSet []Selector) {
 	if len(selectorSet) == 0 {
-		return ""
+		return
 	}
 
 	selectors.WriteString("<w:SelectorSet>")
 
 	for _, selector := range selectorSet {
-		fmt.Fprintf(&selectors, `<w:Selector Name=%q>%s</w:Selector>`, selector.Name, selector.Value)
+		selectors.WriteString("<w:Selector Name=")
+		selectors.WriteString(strconv.Quote(selector.Name))
+		selectors.WriteString(">")
+		selectors.WriteString(selector.Value)
+		selectors.WriteString("</w:Selector>")
 	}
 
 	selectors.WriteString("</w:SelectorSet>")
-
-	return selectors.String()
 }
 
 // createSelectorObjectForBody creates an object for the body using the given selector.
@@ -152,7 +173,9 @@ func createCommonBodyPull(enumerationContext string, maxElements, maxCharacters
 		maxCharacters = 99999
 	}
 
-	return fmt.Sprintf(`<Body><Pull xmlns="http://schemas.xmlsoap.org/ws/2004/09/enumeration"><EnumerationContext>%s</EnumerationContext><MaxElements>%d</MaxElements><MaxCharacters>%d</MaxCharacters></Pull></Body>`, enumerationContext, maxElements, maxCharacters)
+	return `<Body><Pull xmlns="http://schemas.xmlsoap.org/ws/2004/09/enumeration"><EnumerationContext>` + enumerationContext +
+		`</EnumerationContext><MaxElements>` + strconv.Itoa(maxElements) +
+		`</MaxElements><MaxCharacters>` + strconv.Itoa(maxCharacters) + `</MaxCharacters></Pull></Body>`
 }
 
 func (w WSManMessageCreator) createCommonBodyCreateOrPut(wsmanClass string, data interface{}) string {
@@ -160,5 +183,6 @@ func (w WSManMessageCreator) createCommonBodyCreateOrPut(wsmanClass string, data
 }
 
 func createCommonBodyRequestStateChange(input string, requestedState int) string {
-	return fmt.Sprintf(`<Body><h:RequestStateChange_INPUT xmlns:h=%q><h:RequestedState>%d</h:RequestedState></h:RequestStateChange_INPUT></Body>`, input, requestedState)
+	return `<Body><h:RequestStateChange_INPUT xmlns:h=` + strconv.Quote(input) + `><h:RequestedState>` + strconv.Itoa(requestedState) +
+		`</h:RequestedState></h:RequestStateChange_INPUT></Body>`
 }
@@ -9,6 +9,7 @@ import (
 	"encoding/xml"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -74,6 +75,27 @@ func TestCreateHeader(t *testing.T) {
 
 		assert.Equal(t, correctHeader, header)
 	})
+
+	t.Run("applies header options in createHeader", func(t *testing.T) {
+		correctHeader := fmt.Sprintf(`<Header><a:Action>http://schemas.xmlsoap.org/ws/2004/09/enumeration/Enumerate</a:Action><a:To>/wsman</a:To><w:ResourceURI>http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_ServiceAvailableToElement</w:ResourceURI><a:MessageID>%d</a:MessageID><a:ReplyTo><a:Address>optionAddress</a:Address></a:ReplyTo><w:OperationTimeout>PT1.5S</w:OperationTimeout><w:MaxEnvelopeSize>153600</w:MaxEnvelopeSize><w:Locale xml:lang="en-US" /><w:OptionSet><w:Option Name="IncludeInherited">true</w:Option><w:Option Name="Filter">a&lt;b</w:Option></w:OptionSet><w:SelectorSet><w:Selector Name="InstanceID">Intel(r) AMT Device 0</w:Selector></w:SelectorSet></Header>`, messageID)
+		header := wsmanMessageCreator.CreateHeader(BaseActionsEnumerate, "CIM_ServiceAvailableToElement", selector, "customAddress", "PT30S",
+			WithReplyTo("optionAddress"),
+			WithOperationTimeout(1500*time.Millisecond),
+			WithMaxEnvelopeSize(153600),
+			WithLocale("en-US"),
+			WithOption("IncludeInherited", "true"),
+			WithOption("Filter", "a<b"),
+		)
+		messageID++
+
+		assert.Equal(t, correctHeader, header)
+	})
+}
+
+func TestFormatDuration(t *testing.T) {
+	assert.Equal(t, "PT60S", FormatDuration(time.Minute))
+	assert.Equal(t, "PT0.25S", FormatDuration(250*time.Millisecond))
+	assert.Equal(t, "PT0S", FormatDuration(0))
 }
 
 type TestStruct struct {
@@ -128,3 +150,35 @@ func TestIsSlice(t *testing.T) {
 		assert.False(t, result)
 	})
 }
+
+func BenchmarkGet(b *testing.B) {
+	base := NewBase(NewWSManMessageCreator(AMTSchema), "AMT_GeneralSettings")
+	selector := &Selector{Name: "InstanceID", Value: "Intel(r) AMT: General Settings"}
+
+	b.ReportAllocs()
+
+	for b.Loop() {
+		_ = base.Get(selector)
+	}
+}
+
+func BenchmarkPull(b *testing.B) {
+	base := NewBase(NewWSManMessageCreator(CIMSchema), "CIM_SoftwareIdentity")
+
+	b.ReportAllocs()
+
+	for b.Loop() {
+		_ = base.Pull("A4070000-0000-0000-0000-000000000000")
+	}
+}
+
+func BenchmarkCreateBody(b *testing.B) {
+	creator := NewWSManMessageCreator(AMTSchema)
+	data := &TestStruct{TestXmlns: "test"}
+
+	b.ReportAllocs()
+
+	for b.Loop() {
+		_ = creator.CreateBody("testMethod", "AMT_TestClass", data)
+	}
+}
@@ -47,6 +47,16 @@ type Wired struct {
 	SecondaryDNS   string     `yaml:"secondaryDNS"`
 	Authentication string     `yaml:"authentication"`
 	IEEE8021x      *IEEE8021x `yaml:"ieee8021x"`
+	IPv6           *IPv6      `yaml:"ipv6"`
+}
+
+type IPv6 struct {
+	InterfaceIDType   int    `yaml:"interfaceIDType"` // 0 Randomized, 1 Intel ID, 2 Manual; Randomized when omitted
+	ManualInterfaceID string `yaml:"manualInterfaceID"`
+	IPAddress         string `yaml:"ipAddress"`
+	DefaultRouter     string `yaml:"defaultRouter"`
+	PrimaryDNS        string `yaml:"primaryDNS"`
+	SecondaryDNS      string `yaml:"secondaryDNS"`
 }
 
 type Wireless struct {
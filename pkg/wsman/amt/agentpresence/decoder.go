@@ -0,0 +1,93 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package agentpresence
+
+import "strings"
+
+// INPUTS Constants.
+const (
+	AMTAgentPresenceService        string = "AMT_AgentPresenceService"
+	AMTAgentPresenceWatchdog       string = "AMT_AgentPresenceWatchdog"
+	AMTAgentPresenceWatchdogAction string = "AMT_AgentPresenceWatchdogAction"
+	AMTAgentPresenceWatchdogVA     string = "AMT_AgentPresenceWatchdogVA"
+	RegisterAgent                  string = "RegisterAgent"
+	AssertPresence                 string = "AssertPresence"
+	AssertShutdown                 string = "AssertShutdown"
+	AddAction                      string = "AddAction"
+	DeleteAllActions               string = "DeleteAllActions"
+	ValueNotFound                  string = "Value not found in map"
+)
+
+// Watchdog states are bit flags, so that the OldState and NewState of an action can match several states at once.
+const (
+	WatchdogStateNotStarted WatchdogState = 1 << iota // The agent has not registered since the watchdog was created or AMT started
+	WatchdogStateStopped                              // The agent asserted that it shut down
+	WatchdogStateRunning                              // The agent heartbeats within the timeout
+	WatchdogStateExpired                              // The agent missed the heartbeat timeout
+	WatchdogStateSuspended                            // The host is in a sleep state, so heartbeats aren't expected
+
+	// WatchdogStateAny matches every state in the OldState or NewState of an action.
+	WatchdogStateAny = WatchdogStateNotStarted | WatchdogStateStopped | WatchdogStateRunning | WatchdogStateExpired | WatchdogStateSuspended
+)
+
+// watchdogStateToString is a map of WatchdogState values to their string representation.
+var watchdogStateToString = map[WatchdogState]string{
+	WatchdogStateNotStarted: "NotStarted",
+	WatchdogStateStopped:    "Stopped",
+	WatchdogStateRunning:    "Running",
+	WatchdogStateExpired:    "Expired",
+	WatchdogStateSuspended:  "Suspended",
+}
+
+// String returns the string representation of the WatchdogState value, with the names of combined states separated by
+// "|".
+func (s WatchdogState) String() string {
+	if value, exists := watchdogStateToString[s]; exists {
+		return value
+	}
+
+	if s == 0 || s&^WatchdogStateAny != 0 {
+		return ValueNotFound
+	}
+
+	var names []string
+
+	for state := WatchdogStateNotStarted; state <= WatchdogStateSuspended; state <<= 1 {
+		if s&state != 0 {
+			names = append(names, watchdogStateToString[state])
+		}
+	}
+
+	return strings.Join(names, "|")
+}
+
+const (
+	ReturnValueSuccess          ReturnValue = 0
+	ReturnValueInternalError    ReturnValue = 1
+	ReturnValueNotPermitted     ReturnValue = 16
+	ReturnValueMaxLimitReached  ReturnValue = 23
+	ReturnValueInvalidParameter ReturnValue = 36
+	ReturnValueDuplicate        ReturnValue = 2058
+)
+
+// returnValueToString is a map of ReturnValue values to their string representation.
+var returnValueToString = map[ReturnValue]string{
+	ReturnValueSuccess:          "Success",
+	ReturnValueInternalError:    "InternalError",
+	ReturnValueNotPermitted:     "NotPermitted",
+	ReturnValueMaxLimitReached:  "MaxLimitReached",
+	ReturnValueInvalidParameter: "InvalidParameter",
+	ReturnValueDuplicate:        "Duplicate",
+}
+
+// String returns the string representation of the ReturnValue value.
+func (r ReturnValue) String() string {
+	if value, exists := returnValueToString[r]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
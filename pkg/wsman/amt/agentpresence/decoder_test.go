@@ -0,0 +1,59 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package agentpresence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatchdogState_String(t *testing.T) {
+	tests := []struct {
+		state    WatchdogState
+		expected string
+	}{
+		{WatchdogStateNotStarted, "NotStarted"},
+		{WatchdogStateStopped, "Stopped"},
+		{WatchdogStateRunning, "Running"},
+		{WatchdogStateExpired, "Expired"},
+		{WatchdogStateSuspended, "Suspended"},
+		{WatchdogStateStopped | WatchdogStateExpired, "Stopped|Expired"},
+		{WatchdogStateAny, "NotStarted|Stopped|Running|Expired|Suspended"},
+		{WatchdogState(0), ValueNotFound},
+		{WatchdogState(64), ValueNotFound},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, test.state.String())
+	}
+}
+
+func TestReturnValue_String(t *testing.T) {
+	tests := []struct {
+		returnValue ReturnValue
+		expected    string
+	}{
+		{ReturnValueSuccess, "Success"},
+		{ReturnValueInternalError, "InternalError"},
+		{ReturnValueNotPermitted, "NotPermitted"},
+		{ReturnValueMaxLimitReached, "MaxLimitReached"},
+		{ReturnValueInvalidParameter, "InvalidParameter"},
+		{ReturnValueDuplicate, "Duplicate"},
+		{ReturnValue(999), ValueNotFound},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, test.returnValue.String())
+	}
+}
+
+func TestResponseMarshaling(t *testing.T) {
+	response := Response{Body: Body{WatchdogGetResponse: endpointAgent}}
+
+	assert.Contains(t, response.JSON(), `"DeviceID":"`+agentID+`"`)
+	assert.Contains(t, response.YAML(), "deviceid: "+agentID)
+}
@@ -0,0 +1,42 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package agentpresence facilitates communication with Intel® AMT devices to monitor host software agents.
+//
+// A watchdog is registered for every monitored agent, identified by its GUID. The agent registers with the watchdog
+// and then asserts its presence within the heartbeat timeout. When the agent stops heartbeating, the watchdog
+// transitions to the Expired state and AMT carries out the actions added for that transition, such as raising an event
+// or applying a System Defense policy that isolates the host.
+//
+// Registering an agent and asserting its presence are only permitted through the local realms, remote management
+// consoles create watchdogs and add actions.
+package agentpresence
+
+import (
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/base"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewServiceWithClient instantiates a new Agent Presence service.
+func NewServiceWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) Service {
+	return Service{
+		base.NewService[Response](wsmanMessageCreator, AMTAgentPresenceService, client),
+	}
+}
+
+// NewWatchdogActionWithClient instantiates a new Agent Presence Watchdog Action service.
+func NewWatchdogActionWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) WatchdogAction {
+	return WatchdogAction{
+		base.NewService[Response](wsmanMessageCreator, AMTAgentPresenceWatchdogAction, client),
+	}
+}
+
+// NewWatchdogVAWithClient instantiates a new service for the watchdogs of agents running in a virtual appliance.
+func NewWatchdogVAWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) WatchdogVA {
+	return WatchdogVA{
+		base.NewService[Response](wsmanMessageCreator, AMTAgentPresenceWatchdogVA, client),
+	}
+}
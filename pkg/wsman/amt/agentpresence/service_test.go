@@ -0,0 +1,115 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package agentpresence
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/systemdefense"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+func TestPositiveAMT_AgentPresenceService(t *testing.T) {
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/agentpresence/service",
+	}
+	elementUnderTest := NewServiceWithClient(wsmanMessageCreator, &client)
+
+	expected := ServiceResponse{
+		XMLName:                 xml.Name{Space: message.AMTSchema + AMTAgentPresenceService, Local: AMTAgentPresenceService},
+		CreationClassName:       AMTAgentPresenceService,
+		ElementName:             "Intel(r) AMT Agent Presence Service",
+		Name:                    "Intel(r) AMT Agent Presence Service",
+		SystemCreationClassName: "CIM_ComputerSystem",
+		SystemName:              "Intel(r) AMT",
+		EnabledState:            5,
+		RequestedState:          12,
+	}
+
+	client.CurrentMessage = wsmantesting.CurrentMessageGet
+	response, err := elementUnderTest.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, wsmantesting.ExpectedResponse(0, resourceURIBase, AMTAgentPresenceService, wsmantesting.Get, "", ""), response.XMLInput)
+	assert.Equal(t, expected, response.Body.ServiceGetResponse)
+
+	client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+	response, err = elementUnderTest.Enumerate()
+	assert.NoError(t, err)
+	assert.Equal(t, "D6000000-0000-0000-0000-000000000000", response.Body.EnumerateResponse.EnumerationContext)
+
+	client.CurrentMessage = wsmantesting.CurrentMessagePull
+	response, err = elementUnderTest.Pull(wsmantesting.EnumerationContext)
+	assert.NoError(t, err)
+	assert.Equal(t, []ServiceResponse{expected}, response.Body.PullResponse.ServiceItems)
+}
+
+func TestPositiveAMT_AgentPresenceWatchdogAction(t *testing.T) {
+	wsmanMessageCreator := message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/agentpresence/watchdogaction",
+	}
+	elementUnderTest := NewWatchdogActionWithClient(wsmanMessageCreator, &client)
+
+	client.CurrentMessage = wsmantesting.CurrentMessageGet
+	response, err := elementUnderTest.Get()
+	assert.NoError(t, err)
+
+	action := response.Body.WatchdogActionGetResponse
+	assert.Equal(t, "Intel(r) AMT:Agent Presence Watchdog Action 1", action.Name)
+	assert.Equal(t, WatchdogStateAny, action.OldState)
+	assert.Equal(t, WatchdogStateExpired, action.NewState)
+	assert.True(t, action.EventOnTransition)
+	assert.Equal(t, message.AMTSchema+systemdefense.AMTSystemDefensePolicy, action.ActionSd.ReferenceParameters.ResourceURI)
+
+	client.CurrentMessage = wsmantesting.CurrentMessagePull
+	response, err = elementUnderTest.Pull(wsmantesting.EnumerationContext)
+	assert.NoError(t, err)
+	assert.Equal(t, []WatchdogActionResponse{action}, response.Body.PullResponse.WatchdogActionItems)
+}
+
+func TestPositiveAMT_AgentPresenceWatchdogVA(t *testing.T) {
+	wsmanMessageCreator := message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/agentpresence/watchdogva",
+	}
+	elementUnderTest := NewWatchdogVAWithClient(wsmanMessageCreator, &client)
+
+	client.CurrentMessage = wsmantesting.CurrentMessageGet
+	response, err := elementUnderTest.Get()
+	assert.NoError(t, err)
+	assert.Contains(t, response.XMLInput, AMTAgentPresenceWatchdogVA)
+	assert.Equal(t, WatchdogStateNotStarted, response.Body.WatchdogVAGetResponse.CurrentState)
+	assert.Equal(t, 60, response.Body.WatchdogVAGetResponse.TimeoutInterval)
+
+	client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+	_, err = elementUnderTest.Enumerate()
+	assert.NoError(t, err)
+
+	client.CurrentMessage = wsmantesting.CurrentMessagePull
+	response, err = elementUnderTest.Pull(wsmantesting.EnumerationContext)
+	assert.NoError(t, err)
+	assert.Len(t, response.Body.PullResponse.WatchdogVAItems, 1)
+}
+
+func TestNegativeAMT_AgentPresenceReadOnly(t *testing.T) {
+	wsmanMessageCreator := message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase)
+	client := wsmantesting.MockClient{CurrentMessage: wsmantesting.CurrentMessageError}
+
+	_, err := NewServiceWithClient(wsmanMessageCreator, &client).Get()
+	assert.Error(t, err)
+
+	_, err = NewWatchdogActionWithClient(wsmanMessageCreator, &client).Enumerate()
+	assert.Error(t, err)
+
+	_, err = NewWatchdogVAWithClient(wsmanMessageCreator, &client).Pull(wsmantesting.EnumerationContext)
+	assert.Error(t, err)
+}
@@ -0,0 +1,86 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package agentpresence
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/base"
+)
+
+var ErrMethodFailed = errors.New("agent presence method failed")
+
+// Session is the registration of an agent with its watchdog. It keeps track of the sequence numbers of the heartbeats.
+// Unlike the Watchdog methods it wraps, its methods fail with ErrMethodFailed when AMT returns a non-success
+// ReturnValue.
+type Session struct {
+	watchdog        Watchdog
+	deviceID        string
+	sequenceNumber  int
+	timeoutInterval time.Duration
+	opts            []base.HeaderOption
+}
+
+// Register registers the agent with the given GUID and returns its session. The agent must then call Heartbeat at
+// least once per TimeoutInterval.
+func (watchdog Watchdog) Register(deviceID string, opts ...base.HeaderOption) (*Session, error) {
+	response, err := watchdog.RegisterAgent(deviceID, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	output := response.Body.RegisterAgentOutput
+	if err := checkReturnValue(RegisterAgent, output.ReturnValue); err != nil {
+		return nil, err
+	}
+
+	return &Session{
+		watchdog:        watchdog,
+		deviceID:        deviceID,
+		sequenceNumber:  output.SessionSequenceNumber,
+		timeoutInterval: time.Duration(output.TimeoutInterval) * time.Second,
+		opts:            opts,
+	}, nil
+}
+
+// TimeoutInterval is the time within which the agent must heartbeat before the watchdog expires.
+func (session *Session) TimeoutInterval() time.Duration {
+	return session.timeoutInterval
+}
+
+// Heartbeat asserts the presence of the agent.
+func (session *Session) Heartbeat() error {
+	session.sequenceNumber++
+
+	response, err := session.watchdog.AssertPresence(session.deviceID, session.sequenceNumber, session.opts...)
+	if err != nil {
+		return err
+	}
+
+	return checkReturnValue(AssertPresence, response.Body.AssertPresenceOutput.ReturnValue)
+}
+
+// Shutdown ends the session, so that the watchdog stops rather than expires.
+func (session *Session) Shutdown() error {
+	session.sequenceNumber++
+
+	response, err := session.watchdog.AssertShutdown(session.deviceID, session.sequenceNumber, session.opts...)
+	if err != nil {
+		return err
+	}
+
+	return checkReturnValue(AssertShutdown, response.Body.AssertShutdownOutput.ReturnValue)
+}
+
+func checkReturnValue(method string, returnValue ReturnValue) error {
+	if returnValue != ReturnValueSuccess {
+		return fmt.Errorf("%w: %s returned %d (%s)", ErrMethodFailed, method, returnValue, returnValue)
+	}
+
+	return nil
+}
@@ -0,0 +1,162 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package agentpresence
+
+import (
+	"encoding/xml"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/systemdefense"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/base"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+)
+
+type Service struct {
+	base.WSManService[Response]
+}
+
+type Watchdog struct {
+	base.WSManService[Response]
+}
+
+type WatchdogAction struct {
+	base.WSManService[Response]
+}
+
+type WatchdogVA struct {
+	base.WSManService[Response]
+}
+
+// OUTPUTS
+// Response Types.
+type (
+	Response struct {
+		*client.Message
+		XMLName xml.Name       `xml:"Envelope"`
+		Header  message.Header `xml:"Header"`
+		Body    Body           `xml:"Body"`
+	}
+	Body struct {
+		XMLName                   xml.Name `xml:"Body"`
+		ServiceGetResponse        ServiceResponse
+		WatchdogGetResponse       WatchdogResponse `xml:"AMT_AgentPresenceWatchdog"`
+		WatchdogActionGetResponse WatchdogActionResponse
+		WatchdogVAGetResponse     WatchdogResponse `xml:"AMT_AgentPresenceWatchdogVA"`
+		CreateResponse            systemdefense.ResourceCreated
+		RegisterAgentOutput       RegisterAgent_OUTPUT    `xml:"RegisterAgent_OUTPUT"`
+		AssertPresenceOutput      AssertPresence_OUTPUT   `xml:"AssertPresence_OUTPUT"`
+		AssertShutdownOutput      AssertShutdown_OUTPUT   `xml:"AssertShutdown_OUTPUT"`
+		AddActionOutput           AddAction_OUTPUT        `xml:"AddAction_OUTPUT"`
+		DeleteAllActionsOutput    DeleteAllActions_OUTPUT `xml:"DeleteAllActions_OUTPUT"`
+		EnumerateResponse         common.EnumerateResponse
+		PullResponse              PullResponse
+	}
+	PullResponse struct {
+		XMLName             xml.Name                 `xml:"PullResponse"`
+		ServiceItems        []ServiceResponse        `xml:"Items>AMT_AgentPresenceService"`
+		WatchdogItems       []WatchdogResponse       `xml:"Items>AMT_AgentPresenceWatchdog"`
+		WatchdogActionItems []WatchdogActionResponse `xml:"Items>AMT_AgentPresenceWatchdogAction"`
+		WatchdogVAItems     []WatchdogResponse       `xml:"Items>AMT_AgentPresenceWatchdogVA"`
+	}
+	ServiceResponse struct {
+		XMLName                 xml.Name `xml:"AMT_AgentPresenceService"`
+		CreationClassName       string   `xml:"CreationClassName,omitempty"`       // CreationClassName indicates the name of the class or the subclass used in the creation of an instance.
+		ElementName             string   `xml:"ElementName,omitempty"`             // A user-friendly name for the object.
+		Name                    string   `xml:"Name,omitempty"`                    // The Name property uniquely identifies the Service.
+		SystemCreationClassName string   `xml:"SystemCreationClassName,omitempty"` // The scoping System's CreationClassName.
+		SystemName              string   `xml:"SystemName,omitempty"`              // The scoping System's Name.
+		EnabledState            int      `xml:"EnabledState"`                      // The enabled and disabled states of the element.
+		RequestedState          int      `xml:"RequestedState"`                    // The last requested or desired state for the element.
+	}
+	// WatchdogResponse is an AMT_AgentPresenceWatchdog or AMT_AgentPresenceWatchdogVA instance.
+	WatchdogResponse struct {
+		CreationClassName       string        `xml:"CreationClassName,omitempty"`       // CreationClassName indicates the name of the class or the subclass used in the creation of an instance.
+		DeviceID                string        `xml:"DeviceID,omitempty"`                // The GUID of the monitored agent.
+		ElementName             string        `xml:"ElementName,omitempty"`             // A user-friendly name for the object.
+		SystemCreationClassName string        `xml:"SystemCreationClassName,omitempty"` // The scoping System's CreationClassName.
+		SystemName              string        `xml:"SystemName,omitempty"`              // The scoping System's Name.
+		TimeoutInterval         int           `xml:"TimeoutInterval"`                   // The number of seconds within which the agent must heartbeat.
+		StartupInterval         int           `xml:"StartupInterval"`                   // The number of seconds the agent has to register after the host starts.
+		CurrentState            WatchdogState `xml:"CurrentState"`                      // The state of the watchdog.
+	}
+	WatchdogActionResponse struct {
+		XMLName                 xml.Name                                `xml:"AMT_AgentPresenceWatchdogAction"`
+		CreationClassName       string                                  `xml:"CreationClassName,omitempty"`       // CreationClassName indicates the name of the class or the subclass used in the creation of an instance.
+		ElementName             string                                  `xml:"ElementName,omitempty"`             // A user-friendly name for the object.
+		Name                    string                                  `xml:"Name,omitempty"`                    // Uniquely identifies the action.
+		SystemCreationClassName string                                  `xml:"SystemCreationClassName,omitempty"` // The scoping System's CreationClassName.
+		SystemName              string                                  `xml:"SystemName,omitempty"`              // The scoping System's Name.
+		OldState                WatchdogState                           `xml:"OldState"`                          // The states the watchdog transitions from.
+		NewState                WatchdogState                           `xml:"NewState"`                          // The states the watchdog transitions to.
+		EventOnTransition       bool                                    `xml:"EventOnTransition"`                 // Whether an event is raised on the transition.
+		ActionSd                systemdefense.EndpointReferenceResponse `xml:"ActionSd"`                          // The System Defense policy applied on the transition.
+		ActionEac               systemdefense.EndpointReferenceResponse `xml:"ActionEac"`                         // The Endpoint Access Control posture applied on the transition.
+	}
+	RegisterAgent_OUTPUT struct {
+		XMLName               xml.Name    `xml:"RegisterAgent_OUTPUT"`
+		SessionSequenceNumber int         `xml:"SessionSequenceNumber"` // The sequence number of the session; later assertions use greater numbers.
+		TimeoutInterval       int         `xml:"TimeoutInterval"`       // The number of seconds within which the agent must heartbeat.
+		ReturnValue           ReturnValue `xml:"ReturnValue"`
+	}
+	AssertPresence_OUTPUT struct {
+		XMLName     xml.Name    `xml:"AssertPresence_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	AssertShutdown_OUTPUT struct {
+		XMLName     xml.Name    `xml:"AssertShutdown_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	AddAction_OUTPUT struct {
+		XMLName     xml.Name                                `xml:"AddAction_OUTPUT"`
+		Action      systemdefense.EndpointReferenceResponse `xml:"Action"` // The created AMT_AgentPresenceWatchdogAction.
+		ReturnValue ReturnValue                             `xml:"ReturnValue"`
+	}
+	DeleteAllActions_OUTPUT struct {
+		XMLName     xml.Name    `xml:"DeleteAllActions_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+)
+
+// INPUTS
+// Request Types.
+type (
+	WatchdogRequest struct {
+		XMLName         xml.Name `xml:"h:AMT_AgentPresenceWatchdog"`
+		H               string   `xml:"xmlns:h,attr"`
+		DeviceID        string   `xml:"h:DeviceID"`                  // The GUID of the monitored agent.
+		ElementName     string   `xml:"h:ElementName,omitempty"`     // A user-friendly name for the watchdog.
+		TimeoutInterval int      `xml:"h:TimeoutInterval"`           // The number of seconds within which the agent must heartbeat.
+		StartupInterval int      `xml:"h:StartupInterval,omitempty"` // The number of seconds the agent has to register after the host starts.
+	}
+	AssertPresence_INPUT struct {
+		XMLName        xml.Name `xml:"h:AssertPresence_INPUT"`
+		H              string   `xml:"xmlns:h,attr"`
+		SequenceNumber int      `xml:"h:SequenceNumber"`
+	}
+	AssertShutdown_INPUT struct {
+		XMLName        xml.Name `xml:"h:AssertShutdown_INPUT"`
+		H              string   `xml:"xmlns:h,attr"`
+		SequenceNumber int      `xml:"h:SequenceNumber"`
+	}
+	// AddAction_INPUT describes what AMT does when the watchdog transitions from one of the OldState states to one of the
+	// NewState states.
+	AddAction_INPUT struct {
+		XMLName           xml.Name                                `xml:"h:AddAction_INPUT"`
+		H                 string                                  `xml:"xmlns:h,attr"`
+		OldState          WatchdogState                           `xml:"h:OldState"`
+		NewState          WatchdogState                           `xml:"h:NewState"`
+		EventOnTransition bool                                    `xml:"h:EventOnTransition"`
+		ActionSd          *systemdefense.EndpointReferenceRequest `xml:"h:ActionSd,omitempty"` // The System Defense policy to apply, see systemdefense.PolicyReference.
+	}
+)
+
+type (
+	// WatchdogState is the state of an Agent Presence watchdog.
+	WatchdogState int
+	// ReturnValue is the completion status of an Agent Presence method.
+	ReturnValue int
+)
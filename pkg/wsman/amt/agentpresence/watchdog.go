@@ -0,0 +1,109 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package agentpresence
+
+import (
+	"encoding/xml"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/methods"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/base"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewWatchdogWithClient instantiates a new Agent Presence Watchdog service.
+func NewWatchdogWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) Watchdog {
+	return Watchdog{
+		base.NewService[Response](wsmanMessageCreator, AMTAgentPresenceWatchdog, client),
+	}
+}
+
+// GetByDeviceID retrieves the watchdog of the agent with the given GUID.
+func (watchdog Watchdog) GetByDeviceID(deviceID string, opts ...base.HeaderOption) (response Response, err error) {
+	return watchdog.execute(watchdog.Base.Get(&message.Selector{Name: "DeviceID", Value: deviceID}, opts...))
+}
+
+// Watchdogs returns every registered watchdog with its current state.
+func (watchdog Watchdog) Watchdogs(opts ...base.HeaderOption) ([]WatchdogResponse, error) {
+	var watchdogs []WatchdogResponse
+
+	err := base.EachItem(watchdog.WSManService, AMTAgentPresenceWatchdog, func(item WatchdogResponse) error {
+		watchdogs = append(watchdogs, item)
+
+		return nil
+	}, opts...)
+
+	return watchdogs, err
+}
+
+// Create registers a watchdog for an agent. The watchdog is in the NotStarted state until the agent registers.
+func (watchdog Watchdog) Create(request WatchdogRequest, opts ...base.HeaderOption) (response Response, err error) {
+	return watchdog.execute(watchdog.Base.Create(&request, nil, opts...))
+}
+
+// Delete removes the watchdog of the agent with the given GUID together with its actions.
+func (watchdog Watchdog) Delete(deviceID string, opts ...base.HeaderOption) (response Response, err error) {
+	return watchdog.execute(watchdog.Base.Delete(message.Selector{Name: "DeviceID", Value: deviceID}, opts...))
+}
+
+// RegisterAgent starts a session of the agent, which moves the watchdog to the Running state. The returned
+// SessionSequenceNumber is the base of the sequence numbers of the following AssertPresence and AssertShutdown calls.
+// As with the other methods, the caller checks the ReturnValue of the output; Register does so for a Session.
+func (watchdog Watchdog) RegisterAgent(deviceID string, opts ...base.HeaderOption) (response Response, err error) {
+	return watchdog.invoke(RegisterAgent, deviceID, nil, opts)
+}
+
+// AssertPresence is the heartbeat of the agent. It must be called within the timeout interval of the watchdog, with
+// a sequence number greater than the previous one of the session.
+func (watchdog Watchdog) AssertPresence(deviceID string, sequenceNumber int, opts ...base.HeaderOption) (response Response, err error) {
+	return watchdog.invoke(AssertPresence, deviceID, &AssertPresence_INPUT{SequenceNumber: sequenceNumber}, opts)
+}
+
+// AssertShutdown ends the session of the agent, which moves the watchdog to the Stopped state instead of letting it
+// expire.
+func (watchdog Watchdog) AssertShutdown(deviceID string, sequenceNumber int, opts ...base.HeaderOption) (response Response, err error) {
+	return watchdog.invoke(AssertShutdown, deviceID, &AssertShutdown_INPUT{SequenceNumber: sequenceNumber}, opts)
+}
+
+// AddAction adds an action to the watchdog of the agent with the given GUID, which AMT carries out when the watchdog
+// state changes as described by action. For example, an action from WatchdogStateAny to WatchdogStateExpired with
+// the reference of a System Defense policy isolates the host when the agent stops heartbeating.
+func (watchdog Watchdog) AddAction(deviceID string, action AddAction_INPUT, opts ...base.HeaderOption) (response Response, err error) {
+	return watchdog.invoke(AddAction, deviceID, &action, opts)
+}
+
+// DeleteAllActions removes the actions of the watchdog of the agent with the given GUID.
+func (watchdog Watchdog) DeleteAllActions(deviceID string, opts ...base.HeaderOption) (response Response, err error) {
+	return watchdog.invoke(DeleteAllActions, deviceID, nil, opts)
+}
+
+func (watchdog Watchdog) invoke(method, deviceID string, input interface{}, opts []base.HeaderOption) (response Response, err error) {
+	selectors := []message.Selector{{Name: "DeviceID", Value: deviceID}}
+	header := watchdog.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAgentPresenceWatchdog, method), AMTAgentPresenceWatchdog, selectors, "", "", opts...)
+	body := watchdog.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(method), AMTAgentPresenceWatchdog, input)
+
+	return watchdog.execute(watchdog.Base.WSManMessageCreator.CreateXML(header, body))
+}
+
+func (watchdog Watchdog) execute(xmlInput string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: xmlInput,
+		},
+	}
+
+	err = watchdog.Base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
@@ -0,0 +1,327 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package agentpresence
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/systemdefense"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+const (
+	agentID          = "88bb0c25-8a44-4c0a-9e4d-2e8b1d8b5a6f"
+	deviceIDSelector = `<w:SelectorSet><w:Selector Name="DeviceID">` + agentID + `</w:Selector></w:SelectorSet>`
+)
+
+var endpointAgent = WatchdogResponse{
+	CreationClassName:       AMTAgentPresenceWatchdog,
+	DeviceID:                agentID,
+	ElementName:             "Endpoint Agent",
+	SystemCreationClassName: "CIM_ComputerSystem",
+	SystemName:              "Intel(r) AMT",
+	TimeoutInterval:         30,
+	StartupInterval:         120,
+	CurrentState:            WatchdogStateRunning,
+}
+
+func TestPositiveAMT_AgentPresenceWatchdog(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/agentpresence/watchdog",
+	}
+	elementUnderTest := NewWatchdogWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_AgentPresenceWatchdog Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			action       string
+			extraHeader  string
+			body         string
+			responseFunc func() (Response, error)
+			check        func(t *testing.T, body Body)
+		}{
+			{
+				"should create a valid AMT_AgentPresenceWatchdog Get wsman message",
+				wsmantesting.Get,
+				deviceIDSelector,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.GetByDeviceID(agentID)
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, endpointAgent, body.WatchdogGetResponse)
+				},
+			},
+			{
+				"should create a valid AMT_AgentPresenceWatchdog Enumerate wsman message",
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, "D6000000-0000-0000-0000-000000000000", body.EnumerateResponse.EnumerationContext)
+				},
+			},
+			{
+				"should create a valid AMT_AgentPresenceWatchdog Pull wsman message",
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, []WatchdogResponse{endpointAgent}, body.PullResponse.WatchdogItems)
+				},
+			},
+			{
+				"should create a valid AMT_AgentPresenceWatchdog Create wsman message",
+				wsmantesting.Create,
+				"",
+				`<h:AMT_AgentPresenceWatchdog xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog"><h:DeviceID>` + agentID + `</h:DeviceID><h:ElementName>Endpoint Agent</h:ElementName><h:TimeoutInterval>30</h:TimeoutInterval><h:StartupInterval>120</h:StartupInterval></h:AMT_AgentPresenceWatchdog>`,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageCreate
+
+					return elementUnderTest.Create(WatchdogRequest{DeviceID: agentID, ElementName: "Endpoint Agent", TimeoutInterval: 30, StartupInterval: 120})
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, agentID, body.CreateResponse.Selector("DeviceID"))
+				},
+			},
+			{
+				"should create a valid AMT_AgentPresenceWatchdog Delete wsman message",
+				wsmantesting.Delete,
+				deviceIDSelector,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageDelete
+
+					return elementUnderTest.Delete(agentID)
+				},
+				func(t *testing.T, _ Body) { t.Helper() },
+			},
+			{
+				"should create a valid AMT_AgentPresenceWatchdog RegisterAgent wsman message",
+				"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog/RegisterAgent",
+				deviceIDSelector,
+				`<h:RegisterAgent_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog"></h:RegisterAgent_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = RegisterAgent
+
+					return elementUnderTest.RegisterAgent(agentID)
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, 1000, body.RegisterAgentOutput.SessionSequenceNumber)
+					assert.Equal(t, 30, body.RegisterAgentOutput.TimeoutInterval)
+				},
+			},
+			{
+				"should create a valid AMT_AgentPresenceWatchdog AssertPresence wsman message",
+				"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog/AssertPresence",
+				deviceIDSelector,
+				`<h:AssertPresence_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog"><h:SequenceNumber>1001</h:SequenceNumber></h:AssertPresence_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = AssertPresence
+
+					return elementUnderTest.AssertPresence(agentID, 1001)
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, ReturnValueSuccess, body.AssertPresenceOutput.ReturnValue)
+				},
+			},
+			{
+				"should create a valid AMT_AgentPresenceWatchdog AssertShutdown wsman message",
+				"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog/AssertShutdown",
+				deviceIDSelector,
+				`<h:AssertShutdown_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog"><h:SequenceNumber>1002</h:SequenceNumber></h:AssertShutdown_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = AssertShutdown
+
+					return elementUnderTest.AssertShutdown(agentID, 1002)
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, ReturnValueSuccess, body.AssertShutdownOutput.ReturnValue)
+				},
+			},
+			{
+				"should create a valid AMT_AgentPresenceWatchdog AddAction wsman message",
+				"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog/AddAction",
+				deviceIDSelector,
+				`<h:AddAction_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog"><h:OldState>31</h:OldState><h:NewState>8</h:NewState><h:EventOnTransition>true</h:EventOnTransition><h:ActionSd><a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>http://intel.com/wbem/wscim/1/amt-schema/1/AMT_SystemDefensePolicy</w:ResourceURI><w:SelectorSet><w:Selector Name="CreationClassName">AMT_SystemDefensePolicy</w:Selector><w:Selector Name="PolicyRuleName">Quarantine</w:Selector><w:Selector Name="SystemCreationClassName">CIM_ComputerSystem</w:Selector><w:Selector Name="SystemName">Intel(r) AMT</w:Selector></w:SelectorSet></a:ReferenceParameters></h:ActionSd></h:AddAction_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = AddAction
+					policy := systemdefense.PolicyReference("Quarantine")
+
+					return elementUnderTest.AddAction(agentID, AddAction_INPUT{
+						OldState:          WatchdogStateAny,
+						NewState:          WatchdogStateExpired,
+						EventOnTransition: true,
+						ActionSd:          &policy,
+					})
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, "Intel(r) AMT:Agent Presence Watchdog Action 1", body.AddActionOutput.Action.ReferenceParameters.SelectorSet.Selectors[1].Text)
+				},
+			},
+			{
+				"should create a valid AMT_AgentPresenceWatchdog DeleteAllActions wsman message",
+				"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog/DeleteAllActions",
+				deviceIDSelector,
+				`<h:DeleteAllActions_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog"></h:DeleteAllActions_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = DeleteAllActions
+
+					return elementUnderTest.DeleteAllActions(agentID)
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, ReturnValueSuccess, body.DeleteAllActionsOutput.ReturnValue)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, AMTAgentPresenceWatchdog, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				test.check(t, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeAMT_AgentPresenceWatchdog(t *testing.T) {
+	wsmanMessageCreator := message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/agentpresence/watchdog",
+		CurrentMessage:   wsmantesting.CurrentMessageError,
+	}
+	elementUnderTest := NewWatchdogWithClient(wsmanMessageCreator, &client)
+
+	calls := map[string]func() (Response, error){
+		"GetByDeviceID":    func() (Response, error) { return elementUnderTest.GetByDeviceID(agentID) },
+		"Create":           func() (Response, error) { return elementUnderTest.Create(WatchdogRequest{DeviceID: agentID}) },
+		"Delete":           func() (Response, error) { return elementUnderTest.Delete(agentID) },
+		"RegisterAgent":    func() (Response, error) { return elementUnderTest.RegisterAgent(agentID) },
+		"AssertPresence":   func() (Response, error) { return elementUnderTest.AssertPresence(agentID, 1) },
+		"AssertShutdown":   func() (Response, error) { return elementUnderTest.AssertShutdown(agentID, 1) },
+		"AddAction":        func() (Response, error) { return elementUnderTest.AddAction(agentID, AddAction_INPUT{}) },
+		"DeleteAllActions": func() (Response, error) { return elementUnderTest.DeleteAllActions(agentID) },
+	}
+
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			_, err := call()
+			assert.Error(t, err)
+		})
+	}
+}
+
+var (
+	methodPattern         = regexp.MustCompile(`<a:Action>[^<]*/([A-Za-z]+)</a:Action>`)
+	sequenceNumberPattern = regexp.MustCompile(`<h:SequenceNumber>(\d+)</h:SequenceNumber>`)
+)
+
+// watchdogClient answers the Agent Presence watchdog methods like AMT would and records the sequence numbers of the
+// assertions.
+type watchdogClient struct {
+	client.WSMan
+
+	sequenceNumbers []string
+	returnValue     ReturnValue
+}
+
+func (c *watchdogClient) Post(msg string) ([]byte, error) {
+	method := methodPattern.FindStringSubmatch(msg)[1]
+
+	switch method {
+	case "Enumerate":
+		return []byte(`<Envelope><Header></Header><Body><EnumerateResponse><EnumerationContext>1</EnumerationContext></EnumerateResponse></Body></Envelope>`), nil
+	case "Pull":
+		return []byte(`<Envelope><Header></Header><Body><PullResponse><Items>` +
+			`<AMT_AgentPresenceWatchdog><DeviceID>` + agentID + `</DeviceID><CurrentState>8</CurrentState></AMT_AgentPresenceWatchdog>` +
+			`<AMT_AgentPresenceWatchdog><DeviceID>other</DeviceID><CurrentState>4</CurrentState></AMT_AgentPresenceWatchdog>` +
+			`</Items><EndOfSequence/></PullResponse></Body></Envelope>`), nil
+	case RegisterAgent:
+		return fmt.Appendf(nil, `<Envelope><Header></Header><Body><RegisterAgent_OUTPUT><SessionSequenceNumber>7</SessionSequenceNumber><TimeoutInterval>30</TimeoutInterval><ReturnValue>%d</ReturnValue></RegisterAgent_OUTPUT></Body></Envelope>`, c.returnValue), nil
+	default:
+		c.sequenceNumbers = append(c.sequenceNumbers, method+" "+sequenceNumberPattern.FindStringSubmatch(msg)[1])
+
+		return fmt.Appendf(nil, `<Envelope><Header></Header><Body><%s_OUTPUT><ReturnValue>%d</ReturnValue></%s_OUTPUT></Body></Envelope>`, method, c.returnValue, method), nil
+	}
+}
+
+func TestWatchdog_Watchdogs(t *testing.T) {
+	elementUnderTest := NewWatchdogWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), &watchdogClient{})
+
+	watchdogs, err := elementUnderTest.Watchdogs()
+	require.NoError(t, err)
+	assert.Equal(t, []WatchdogResponse{
+		{DeviceID: agentID, CurrentState: WatchdogStateExpired},
+		{DeviceID: "other", CurrentState: WatchdogStateRunning},
+	}, watchdogs)
+}
+
+func TestWatchdog_Register(t *testing.T) {
+	wsmanClient := &watchdogClient{}
+	elementUnderTest := NewWatchdogWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), wsmanClient)
+
+	session, err := elementUnderTest.Register(agentID)
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Second, session.TimeoutInterval())
+
+	require.NoError(t, session.Heartbeat())
+	require.NoError(t, session.Heartbeat())
+	require.NoError(t, session.Shutdown())
+	assert.Equal(t, []string{"AssertPresence 8", "AssertPresence 9", "AssertShutdown 10"}, wsmanClient.sequenceNumbers)
+
+	t.Run("fails when AMT rejects the call", func(t *testing.T) {
+		wsmanClient.returnValue = ReturnValueInvalidParameter
+
+		assert.ErrorIs(t, session.Heartbeat(), ErrMethodFailed)
+
+		_, err := elementUnderTest.Register(agentID)
+		assert.ErrorIs(t, err, ErrMethodFailed)
+	})
+
+	t.Run("leaves the ReturnValue of the service methods to the caller", func(t *testing.T) {
+		wsmanClient.returnValue = ReturnValueInvalidParameter
+
+		response, err := elementUnderTest.AssertPresence(agentID, 20)
+		require.NoError(t, err)
+		assert.Equal(t, ReturnValueInvalidParameter, response.Body.AssertPresenceOutput.ReturnValue)
+	})
+}
@@ -30,8 +30,8 @@ func NewServiceWithClient(wsmanMessageCreator *message.WSManMessageCreator, clie
 }
 
 // AddAlarm creates an alarm that would wake the system at a given time. The method receives as input an embedded instance of type IPS_AlarmClockOccurrence, with the following fields set: StartTime, Interval, InstanceID, DeleteOnCompletion. Upon success, the method creates an instance of IPS_AlarmClockOccurrence which is associated with AlarmClockService. The method would fail if 5 instances or more of IPS_AlarmClockOccurrence already exist in the system.
-func (acs Service) AddAlarm(alarmClockOccurrence AlarmClockOccurrence) (response Response, err error) {
-	header := acs.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAlarmClockService, AddAlarm), AMTAlarmClockService, nil, "", "")
+func (acs Service) AddAlarm(alarmClockOccurrence AlarmClockOccurrence, opts ...base.HeaderOption) (response Response, err error) {
+	header := acs.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAlarmClockService, AddAlarm), AMTAlarmClockService, nil, "", "", opts...)
 	startTime := alarmClockOccurrence.StartTime.UTC().Format(time.RFC3339Nano)
 	startTime = strings.Split(startTime, ".")[0]
 
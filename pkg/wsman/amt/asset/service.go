@@ -26,8 +26,8 @@ func NewServiceWithClient(wsmanMessageCreator *message.WSManMessageCreator, clie
 }
 
 // GetAssetTableData retrieves asset table data for a specified table ID.
-func (s Service) GetAssetTableData(tableID int) (response Response, err error) {
-	header := s.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAssetTableService, GetAssetTableData), AMTAssetTableService, nil, "", "")
+func (s Service) GetAssetTableData(tableID int, opts ...base.HeaderOption) (response Response, err error) {
+	header := s.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAssetTableService, GetAssetTableData), AMTAssetTableService, nil, "", "", opts...)
 	body := s.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(GetAssetTableData), AMTAssetTableService, &GetAssetTableData_INPUT{TableID: tableID})
 
 	response = Response{
@@ -51,8 +51,8 @@ func (s Service) GetAssetTableData(tableID int) (response Response, err error) {
 }
 
 // GetAssetTableSize retrieves the size of a specified asset table.
-func (s Service) GetAssetTableSize(tableID int) (response Response, err error) {
-	header := s.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAssetTableService, GetAssetTableSize), AMTAssetTableService, nil, "", "")
+func (s Service) GetAssetTableSize(tableID int, opts ...base.HeaderOption) (response Response, err error) {
+	header := s.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAssetTableService, GetAssetTableSize), AMTAssetTableService, nil, "", "", opts...)
 	body := s.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(GetAssetTableSize), AMTAssetTableService, &GetAssetTableSize_INPUT{TableID: tableID})
 
 	response = Response{
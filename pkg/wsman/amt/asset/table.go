@@ -29,13 +29,13 @@ func NewTableWithClient(wsmanMessageCreator *message.WSManMessageCreator, client
 }
 
 // GetByAssetTableIndex retrieves a specific asset table instance by its unique index.
-func (t Table) GetByAssetTableIndex(index int) (response Response, err error) {
+func (t Table) GetByAssetTableIndex(index int, opts ...base.HeaderOption) (response Response, err error) {
 	selector := &message.Selector{
 		Name:  "AssetTableIndex",
 		Value: strconv.Itoa(index),
 	}
 
-	msg := &client.Message{XMLInput: t.Base.Get(selector)}
+	msg := &client.Message{XMLInput: t.Base.Get(selector, opts...)}
 	response.Message = msg
 
 	if err = t.Base.Execute(msg); err != nil {
@@ -53,7 +53,7 @@ func (t Table) GetByAssetTableIndex(index int) (response Response, err error) {
 
 // GetByInstanceIDAndTableType retrieves a specific asset table instance using the
 // selector combination observed from firmware enumeration output.
-func (t Table) GetByInstanceIDAndTableType(instanceID string, tableType int) (response Response, err error) {
+func (t Table) GetByInstanceIDAndTableType(instanceID string, tableType int, opts ...base.HeaderOption) (response Response, err error) {
 	selectors := []message.Selector{
 		{
 			Name:  "InstanceID",
@@ -65,7 +65,7 @@ func (t Table) GetByInstanceIDAndTableType(instanceID string, tableType int) (re
 		},
 	}
 
-	header := t.Base.WSManMessageCreator.CreateHeader(message.BaseActionsGet, t.Base.ClassName, selectors, "", "")
+	header := t.Base.WSManMessageCreator.CreateHeader(message.BaseActionsGet, t.Base.ClassName, selectors, "", "", opts...)
 	msg := &client.Message{XMLInput: t.Base.WSManMessageCreator.CreateXML(header, message.GetBody)}
 	response.Message = msg
 
@@ -82,6 +82,12 @@ func (t Table) GetByInstanceIDAndTableType(instanceID string, tableType int) (re
 	return response, nil
 }
 
+// EachAssetTable enumerates the asset tables and streams them to fn one at a time, so that the table data of every
+// table is never held in memory at once. Iteration stops at the first error returned by fn.
+func (t Table) EachAssetTable(fn func(AssetTable) error, opts ...base.HeaderOption) error {
+	return base.EachItem(t.WSManService, AMTAssetTable, fn, opts...)
+}
+
 // DecodeAssetTable decodes the asset table response into a structured format.
 func DecodeAssetTable(response Response) []AssetTableEntry {
 	var entries []AssetTableEntry
@@ -6,9 +6,12 @@
 package asset
 
 import (
+	"errors"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
@@ -196,3 +199,49 @@ func TestDecodeAssetTable(t *testing.T) {
 		})
 	}
 }
+
+const (
+	tableEnumerateResponse = `<a:Envelope xmlns:a="http://www.w3.org/2003/05/soap-envelope" xmlns:g="http://schemas.xmlsoap.org/ws/2004/09/enumeration"><a:Body><g:EnumerateResponse><g:EnumerationContext>ctx-1</g:EnumerationContext></g:EnumerateResponse></a:Body></a:Envelope>`
+	tablePullResponse      = `<a:Envelope xmlns:a="http://www.w3.org/2003/05/soap-envelope" xmlns:g="http://schemas.xmlsoap.org/ws/2004/09/enumeration" xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AssetTable"><a:Body><g:PullResponse><g:Items><h:AMT_AssetTable><h:InstanceID>Intel(r) AMT Asset Table: 1</h:InstanceID><h:TableType>1</h:TableType><h:TableData>AQID</h:TableData></h:AMT_AssetTable><h:AMT_AssetTable><h:InstanceID>Intel(r) AMT Asset Table: 2</h:InstanceID><h:TableType>4</h:TableType><h:TableData>BAUG</h:TableData></h:AMT_AssetTable></g:Items><g:EndOfSequence></g:EndOfSequence></g:PullResponse></a:Body></a:Envelope>`
+)
+
+// tableClient answers each request with the canned response of its action.
+type tableClient struct {
+	wsmantesting.MockClient
+	requests []string
+}
+
+func (c *tableClient) Post(msg string) ([]byte, error) {
+	c.requests = append(c.requests, msg)
+
+	if strings.Contains(msg, message.BaseActionsEnumerate) {
+		return []byte(tableEnumerateResponse), nil
+	}
+
+	return []byte(tablePullResponse), nil
+}
+
+func TestEachAssetTable(t *testing.T) {
+	client := &tableClient{}
+	elementUnderTest := NewTableWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), client)
+
+	var tables []AssetTable
+
+	err := elementUnderTest.EachAssetTable(func(table AssetTable) error {
+		tables = append(tables, table)
+
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, tables, 2)
+	assert.Equal(t, "Intel(r) AMT Asset Table: 1", tables[0].InstanceID)
+	assert.Equal(t, "BAUG", tables[1].TableData)
+	assert.Equal(t, 4, tables[1].TableType)
+	require.Len(t, client.requests, 2)
+	assert.Contains(t, client.requests[1], "<EnumerationContext>ctx-1</EnumerationContext>")
+
+	errStop := errors.New("stop")
+
+	err = elementUnderTest.EachAssetTable(func(AssetTable) error { return errStop })
+	assert.ErrorIs(t, err, errStop)
+}
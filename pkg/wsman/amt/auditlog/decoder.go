@@ -19,11 +19,19 @@ import (
 )
 
 const (
-	AMTAuditLog   string = "AMT_AuditLog"
-	ReadRecords   string = "ReadRecords"
-	ValueNotFound string = "Value not found in map"
+	AMTAuditLog             string = "AMT_AuditLog"
+	ReadRecords             string = "ReadRecords"
+	ClearLog                string = "ClearLog"
+	SetAuditLock            string = "SetAuditLock"
+	SetStoragePolicy        string = "SetStoragePolicy"
+	SetSigningKeyMaterial   string = "SetSigningKeyMaterial"
+	ExportAuditLogSignature string = "ExportAuditLogSignature"
+	ValueNotFound           string = "Value not found in map"
 )
 
+// RecordsPerRead is the largest number of records returned by a single ReadRecords call.
+const RecordsPerRead = 10
+
 const (
 	OverwritePolicyUnknown                   OverwritePolicy = 0
 	OverwritePolicyWrapsWhenFull             OverwritePolicy = 2
@@ -146,6 +154,89 @@ func (r StoragePolicy) String() string {
 	return ValueNotFound
 }
 
+const (
+	// AuditStateLocked is set in AuditState while an auditor holds the audit lock.
+	AuditStateLocked = 1 << 0
+	// AuditStateUnprovisioningLocked is set in AuditState while the audit lock is held for unprovisioning.
+	AuditStateUnprovisioningLocked = 1 << 1
+)
+
+// LockState returns the state of the audit lock reported in AuditState.
+func (log AuditLog) LockState() AuditLockState {
+	return AuditLockState{
+		Locked:             log.AuditState&(AuditStateLocked|AuditStateUnprovisioningLocked) != 0,
+		UnprovisioningLock: log.AuditState&AuditStateUnprovisioningLocked != 0,
+	}
+}
+
+const (
+	AuditLockFlagLock AuditLockFlag = iota
+	AuditLockFlagUnlock
+	AuditLockFlagUnprovisioningLock
+)
+
+// auditLockFlagToString is a map of AuditLockFlag values to their string representations.
+var auditLockFlagToString = map[AuditLockFlag]string{
+	AuditLockFlagLock:               "Lock",
+	AuditLockFlagUnlock:             "Unlock",
+	AuditLockFlagUnprovisioningLock: "UnprovisioningLock",
+}
+
+// String returns a string representation of an AuditLockFlag.
+func (f AuditLockFlag) String() string {
+	if value, exists := auditLockFlagToString[f]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+const (
+	SigningMechanismRSASHA256 SigningMechanism = 0
+)
+
+// signingMechanismToString is a map of SigningMechanism values to their string representations.
+var signingMechanismToString = map[SigningMechanism]string{
+	SigningMechanismRSASHA256: "RSA-SHA256",
+}
+
+// String returns a string representation of a SigningMechanism.
+func (m SigningMechanism) String() string {
+	if value, exists := signingMechanismToString[m]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+const (
+	PTStatusSuccess       ReturnValue = 0
+	PTStatusInternalError ReturnValue = 1
+	PTStatusNotReady      ReturnValue = 2
+	PTStatusNotPermitted  ReturnValue = 16
+	PTStatusInvalidIndex  ReturnValue = 35
+	PTStatusAuditFail     ReturnValue = 2075
+)
+
+// returnValueToString is a map of ReturnValue values to their string representations.
+var returnValueToString = map[ReturnValue]string{
+	PTStatusSuccess:       "Success",
+	PTStatusInternalError: "InternalError",
+	PTStatusNotReady:      "NotReady",
+	PTStatusNotPermitted:  "NotPermitted",
+	PTStatusInvalidIndex:  "InvalidIndex",
+	PTStatusAuditFail:     "AuditFail",
+}
+
+// String returns a string representation of a ReturnValue.
+func (r ReturnValue) String() string {
+	if value, exists := returnValueToString[r]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
 var provisioningMethodToString = map[int]string{
 	2: "Remote Configuration",
 	3: "Manual Provisioning via MEBx",
@@ -200,6 +291,30 @@ const (
 	Watchdog              = 33
 )
 
+// Typed application IDs, for use with the AMT_AuditPolicyRule methods.
+const (
+	AppIDSecurityAdmin         AppID = SecurityAdmin
+	AppIDRemoteControl         AppID = RemoteControl
+	AppIDRedirectionManager    AppID = RedirectionManager
+	AppIDFirmwareUpdateManager AppID = FirmwareUpdateManager
+	AppIDSecurityAuditLog      AppID = SecurityAuditLog
+	AppIDNetworkTime           AppID = NetworkTime
+	AppIDNetworkAdministration AppID = NetworkAdministration
+	AppIDStorageAdministration AppID = StorageAdministration
+	AppIDEventManager          AppID = EventManager
+	AppIDSystemDefenseManager  AppID = SystemDefenseManager
+	AppIDAgentPresenceManager  AppID = AgentPresenceManager
+	AppIDWirelessConfiguration AppID = WirelessConfiguration
+	AppIDEndpointAccessControl AppID = EndpointAccessControl
+	AppIDKeyboardVideoMouse    AppID = KeyboardVideoMouse
+	AppIDUserOptIn             AppID = UserOptIn
+	AppIDScreenBlanking        AppID = ScreenBlanking
+	AppIDWatchdog              AppID = Watchdog
+)
+
+// eventsPerApp is the factor between an application ID and the key of its first event in AMTAuditLogEventToString.
+const eventsPerApp = 100
+
 var AMTAppIDToString = map[int]string{
 	16: "Security Admin Events",
 	17: "Remote Control Events",
@@ -320,6 +435,49 @@ var AMTAuditLogEventToString = map[int]string{
 	3302: "Watchdog Action Pairing Changed",
 }
 
+// String returns the name of the application, e.g. "Security Admin Events".
+func (a AppID) String() string {
+	if value, exists := AMTAppIDToString[int(a)]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+// String returns the name of the event, e.g. "ACL Entry Added".
+func (e Event) String() string {
+	if value, exists := AMTAuditLogEventToString[int(e.AppID)*eventsPerApp+int(e.EventID)]; exists {
+		return value
+	}
+
+	return UnknownEventID
+}
+
+// LookupEvent returns the event with the given name, as listed in AMTAuditLogEventToString.
+func LookupEvent(name string) (Event, bool) {
+	for key, value := range AMTAuditLogEventToString {
+		if value == name {
+			return Event{AppID: AppID(key / eventsPerApp), EventID: EventID(key % eventsPerApp)}, true
+		}
+	}
+
+	return Event{}, false
+}
+
+// Events returns all the events of the application, ordered by event ID.
+func (a AppID) Events() []Event {
+	events := []Event{}
+
+	for eventID := range EventID(eventsPerApp) {
+		event := Event{AppID: a, EventID: eventID}
+		if _, exists := AMTAuditLogEventToString[int(a)*eventsPerApp+int(eventID)]; exists {
+			events = append(events, event)
+		}
+	}
+
+	return events
+}
+
 var RealmNames = []string{
 	"Redirection",
 	"PT Administration",
@@ -264,3 +264,145 @@ func TestGetAuditLogExtendedDataString(t *testing.T) {
 		})
 	}
 }
+
+func TestAuditLockFlag_String(t *testing.T) {
+	tests := []struct {
+		flag     AuditLockFlag
+		expected string
+	}{
+		{AuditLockFlagLock, "Lock"},
+		{AuditLockFlagUnlock, "Unlock"},
+		{AuditLockFlagUnprovisioningLock, "UnprovisioningLock"},
+		{AuditLockFlag(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.flag.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestAuditLog_LockState(t *testing.T) {
+	tests := []struct {
+		auditState int
+		expected   AuditLockState
+	}{
+		{16, AuditLockState{}},
+		{17, AuditLockState{Locked: true}},
+		{18, AuditLockState{Locked: true, UnprovisioningLock: true}},
+		{20, AuditLockState{}},
+	}
+
+	for _, test := range tests {
+		result := AuditLog{AuditState: test.auditState}.LockState()
+		if result != test.expected {
+			t.Errorf("Expected %+v for AuditState %d, but got %+v", test.expected, test.auditState, result)
+		}
+	}
+}
+
+func TestSigningMechanism_String(t *testing.T) {
+	tests := []struct {
+		mechanism SigningMechanism
+		expected  string
+	}{
+		{SigningMechanismRSASHA256, "RSA-SHA256"},
+		{SigningMechanism(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.mechanism.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestReturnValue_String(t *testing.T) {
+	tests := []struct {
+		value    ReturnValue
+		expected string
+	}{
+		{PTStatusSuccess, "Success"},
+		{PTStatusNotPermitted, "NotPermitted"},
+		{PTStatusAuditFail, "AuditFail"},
+		{ReturnValue(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.value.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestAppID_String(t *testing.T) {
+	tests := []struct {
+		appID    AppID
+		expected string
+	}{
+		{AppIDSecurityAdmin, "Security Admin Events"},
+		{AppIDWatchdog, "Watchdog Events"},
+		{AppID(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.appID.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestEvent_String(t *testing.T) {
+	tests := []struct {
+		event    Event
+		expected string
+	}{
+		{Event{AppID: AppIDSecurityAdmin, EventID: 2}, "ACL Entry Added"},
+		{Event{AppID: AppIDSecurityAuditLog, EventID: 0}, "Security Audit Log Cleared"},
+		{Event{AppID: AppIDSecurityAdmin, EventID: 99}, UnknownEventID},
+	}
+
+	for _, test := range tests {
+		result := test.event.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestLookupEvent(t *testing.T) {
+	event, ok := LookupEvent("Security Audit Policy Modified")
+	if !ok || event != (Event{AppID: AppIDSecurityAuditLog, EventID: 1}) {
+		t.Errorf("Expected event 20/1, but got %v (found: %v)", event, ok)
+	}
+
+	if _, ok := LookupEvent("No Such Event"); ok {
+		t.Errorf("Expected no event to be found")
+	}
+}
+
+func TestAppID_Events(t *testing.T) {
+	events := AppIDSecurityAuditLog.Events()
+	if len(events) == 0 {
+		t.Fatalf("Expected events for %s", AppIDSecurityAuditLog)
+	}
+
+	for i, event := range events {
+		if event.AppID != AppIDSecurityAuditLog || event.String() == UnknownEventID {
+			t.Errorf("Unexpected event %v", event)
+		}
+
+		if i > 0 && events[i-1].EventID >= event.EventID {
+			t.Errorf("Expected events to be ordered by event ID")
+		}
+	}
+
+	if events := AppID(999).Events(); len(events) != 0 {
+		t.Errorf("Expected no events, but got %v", events)
+	}
+}
@@ -3,11 +3,13 @@
  * SPDX-License-Identifier: Apache-2.0
  **********************************************************************/
 
-// Package auditlog facilitates communication with Intel® AMT devices to read the audit log records
+// Package auditlog facilitates communication with Intel® AMT devices to read and manage the audit log
 package auditlog
 
 import (
+	"encoding/base64"
 	"encoding/xml"
+	"fmt"
 
 	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/methods"
@@ -29,14 +31,152 @@ func NewAuditLogWithClient(wsmanMessageCreator *message.WSManMessageCreator, cli
 // ReadRecords returns a list of consecutive audit log records in chronological order:
 // The first record in the returned array is the oldest record stored in the log.
 // startIndex Identifies the position of the first record to retrieve. An index of 1 indicates the first record in the log.
-func (service Service) ReadRecords(startIndex int) (response Response, err error) {
+// The whole response is kept in XMLOutput; RecordsSince decodes each page as it is read instead.
+func (service Service) ReadRecords(startIndex int, opts ...base.HeaderOption) (response Response, err error) {
 	if startIndex < 1 {
 		startIndex = 0
 	}
 
-	header := service.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuditLog, ReadRecords), AMTAuditLog, nil, "", "")
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.readRecordsXML(startIndex, opts),
+		},
+	}
+
+	err = service.Base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	response.Body.DecodedRecordsResponse = convertToAuditLogResult(response.Body.ReadRecordsResponse.EventRecords)
+	response.Body.RecordsPage = newRecordsPage(startIndex, response.Body.ReadRecordsResponse)
+
+	return response, err
+}
+
+// readRecords sends ReadRecords like ReadRecords does, but decodes its output as the response is read instead of
+// keeping the response text and a parsed copy of the whole envelope.
+func (service Service) readRecords(startIndex int, opts ...base.HeaderOption) (output ReadRecords_OUTPUT, page RecordsPage, err error) {
+	if startIndex < 1 {
+		startIndex = 0
+	}
+
+	body, err := service.Base.ExecuteReader(service.readRecordsXML(startIndex, opts))
+	if err != nil {
+		return output, page, err
+	}
+
+	defer body.Close()
+
+	result, err := base.DecodeItems(body, "ReadRecords_OUTPUT", func(item ReadRecords_OUTPUT) error {
+		output = item
+
+		return nil
+	})
+	if err != nil {
+		return output, page, err
+	}
+
+	if result.Items == 0 {
+		return output, page, fmt.Errorf("%w: response has no ReadRecords_OUTPUT", ErrReadRecordsFailed)
+	}
+
+	return output, newRecordsPage(startIndex, output), nil
+}
+
+func (service Service) readRecordsXML(startIndex int, opts []base.HeaderOption) string {
+	header := service.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuditLog, ReadRecords), AMTAuditLog, nil, "", "", opts...)
 	body := service.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(ReadRecords), AMTAuditLog, &ReadRecordsInput{StartIndex: startIndex})
 
+	return service.Base.WSManMessageCreator.CreateXML(header, body)
+}
+
+// ClearLog deletes all the records of the audit log. It fails with PTStatusAuditFail while another auditor holds the audit lock.
+func (service Service) ClearLog(opts ...base.HeaderOption) (response Response, err error) {
+	header := service.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuditLog, ClearLog), AMTAuditLog, nil, "", "", opts...)
+	body := service.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(ClearLog), AMTAuditLog, nil)
+
+	return service.execute(header, body)
+}
+
+// GetAuditLock reads the audit log and reports the state of the audit lock, derived from AuditState, in Body.AuditLock.
+// AMT doesn't report the handle of the lock holder; it is only returned by SetAuditLock to the auditor taking the lock.
+func (service Service) GetAuditLock(opts ...base.HeaderOption) (response Response, err error) {
+	response, err = service.Get(opts...)
+	if err != nil {
+		return response, err
+	}
+
+	response.Body.AuditLock = response.Body.GetResponse.LockState()
+
+	return response, nil
+}
+
+// SetAuditLock takes or releases the audit lock, which keeps other auditors from clearing the log or changing the audit policy.
+// Taking the lock returns the handle of the lock holder in SetAuditLock_OUTPUT; releasing it requires that handle.
+// The lock is released by the firmware after lockTimeoutInSeconds.
+func (service Service) SetAuditLock(lockTimeoutInSeconds int, flag AuditLockFlag, handle int, opts ...base.HeaderOption) (response Response, err error) {
+	header := service.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuditLog, SetAuditLock), AMTAuditLog, nil, "", "", opts...)
+	body := service.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(SetAuditLock), AMTAuditLog, &SetAuditLock_INPUT{
+		LockTimeoutInSeconds: lockTimeoutInSeconds,
+		Flag:                 flag,
+		Handle:               handle,
+	})
+
+	return service.execute(header, body)
+}
+
+// SetStoragePolicy sets what happens when the audit log is full. minDaysToKeep is only used by StoragePolicyRestrictedRollOver,
+// which overwrites records that are older than that number of days.
+func (service Service) SetStoragePolicy(storagePolicy StoragePolicy, minDaysToKeep int, opts ...base.HeaderOption) (response Response, err error) {
+	header := service.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuditLog, SetStoragePolicy), AMTAuditLog, nil, "", "", opts...)
+	body := service.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(SetStoragePolicy), AMTAuditLog, &SetStoragePolicy_INPUT{
+		StoragePolicy: storagePolicy,
+		MinDaysToKeep: minDaysToKeep,
+	})
+
+	return service.execute(header, body)
+}
+
+// SetSigningKeyMaterial sets the key and certificate chain used to sign the audit log. signingKey and certificates are
+// DER encoded; certificates are ordered leaf first.
+func (service Service) SetSigningKeyMaterial(signingMechanism SigningMechanism, signingKey []byte, certificates [][]byte, opts ...base.HeaderOption) (response Response, err error) {
+	lengths := make([]int, 0, len(certificates))
+
+	var chain []byte
+
+	for _, certificate := range certificates {
+		lengths = append(lengths, len(certificate))
+		chain = append(chain, certificate...)
+	}
+
+	header := service.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuditLog, SetSigningKeyMaterial), AMTAuditLog, nil, "", "", opts...)
+	body := service.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(SetSigningKeyMaterial), AMTAuditLog, &SetSigningKeyMaterial_INPUT{
+		SigningMechanismType: signingMechanism,
+		SigningKey:           base64.StdEncoding.EncodeToString(signingKey),
+		LengthOfCertificates: lengths,
+		Certificates:         base64.StdEncoding.EncodeToString(chain),
+	})
+
+	return service.execute(header, body)
+}
+
+// ExportAuditLogSignature returns a signature over the records of the audit log, made with the key set by SetSigningKeyMaterial.
+func (service Service) ExportAuditLogSignature(signingMechanism SigningMechanism, opts ...base.HeaderOption) (response Response, err error) {
+	header := service.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuditLog, ExportAuditLogSignature), AMTAuditLog, nil, "", "", opts...)
+	body := service.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(ExportAuditLogSignature), AMTAuditLog, &ExportAuditLogSignature_INPUT{
+		SigningMechanism: signingMechanism,
+	})
+
+	return service.execute(header, body)
+}
+
+func (service Service) execute(header, body string) (response Response, err error) {
 	response = Response{
 		Message: &client.Message{
 			XMLInput: service.Base.WSManMessageCreator.CreateXML(header, body),
@@ -53,7 +193,18 @@ func (service Service) ReadRecords(startIndex int) (response Response, err error
 		return response, err
 	}
 
-	response.Body.DecodedRecordsResponse = convertToAuditLogResult(response.Body.ReadRecordsResponse.EventRecords)
+	return response, nil
+}
 
-	return response, err
+func newRecordsPage(startIndex int, output ReadRecords_OUTPUT) RecordsPage {
+	// the first record has index 1
+	startIndex = max(startIndex, 1)
+	nextIndex := startIndex + output.RecordsReturned
+
+	return RecordsPage{
+		StartIndex:       startIndex,
+		NextIndex:        nextIndex,
+		TotalRecordCount: output.TotalRecordCount,
+		HasMore:          output.RecordsReturned > 0 && nextIndex <= output.TotalRecordCount,
+	}
 }
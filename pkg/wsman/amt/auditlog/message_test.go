@@ -23,7 +23,7 @@ func TestJson(t *testing.T) {
 			GetResponse: AuditLog{},
 		},
 	}
-	expectedResult := "{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"EnumerateResponse\":{\"EnumerationContext\":\"\"},\"GetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"OverwritePolicy\":0,\"CurrentNumberOfRecords\":0,\"MaxNumberOfRecords\":0,\"ElementName\":\"\",\"EnabledState\":0,\"RequestedState\":0,\"PercentageFree\":0,\"Name\":\"\",\"TimeOfLastRecord\":{\"Datetime\":\"\"},\"AuditState\":0,\"MaxAllowedAuditors\":0,\"StoragePolicy\":0,\"MinDaysToKeep\":0},\"PullResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"AuditLogItems\":null},\"ReadRecordsResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"TotalRecordCount\":0,\"RecordsReturned\":0,\"EventRecords\":null,\"ReturnValue\":0},\"DecodedRecordsResponse\":null}"
+	expectedResult := "{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"EnumerateResponse\":{\"EnumerationContext\":\"\"},\"GetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"OverwritePolicy\":0,\"CurrentNumberOfRecords\":0,\"MaxNumberOfRecords\":0,\"ElementName\":\"\",\"EnabledState\":0,\"RequestedState\":0,\"PercentageFree\":0,\"Name\":\"\",\"TimeOfLastRecord\":{\"Datetime\":\"\"},\"AuditState\":0,\"MaxAllowedAuditors\":0,\"StoragePolicy\":0,\"MinDaysToKeep\":0},\"PullResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"AuditLogItems\":null},\"ReadRecordsResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"TotalRecordCount\":0,\"RecordsReturned\":0,\"EventRecords\":null,\"ReturnValue\":0},\"DecodedRecordsResponse\":null,\"RecordsPage\":{\"StartIndex\":0,\"NextIndex\":0,\"TotalRecordCount\":0,\"HasMore\":false},\"AuditLock\":{\"Locked\":false,\"UnprovisioningLock\":false},\"ClearLog_OUTPUT\":{\"ReturnValue\":0},\"SetAuditLock_OUTPUT\":{\"Handle\":0,\"ReturnValue\":0},\"SetStoragePolicy_OUTPUT\":{\"ReturnValue\":0},\"SetSigningKeyMaterial_OUTPUT\":{\"ReturnValue\":0},\"ExportAuditLogSignature_OUTPUT\":{\"TotalRecordCount\":0,\"StartLogTime\":{\"Datetime\":\"\"},\"EndLogTime\":{\"Datetime\":\"\"},\"GenerationTime\":{\"Datetime\":\"\"},\"UUID\":\"\",\"FQDN\":\"\",\"SignatureMechanism\":0,\"Signature\":\"\",\"LengthOfCertificates\":null,\"Certificates\":\"\",\"ReturnValue\":0}}"
 	result := response.JSON()
 	assert.Equal(t, expectedResult, result)
 }
@@ -34,7 +34,7 @@ func TestYaml(t *testing.T) {
 			GetResponse: AuditLog{},
 		},
 	}
-	expectedResult := "xmlname:\n    space: \"\"\n    local: \"\"\nenumerateresponse:\n    enumerationcontext: \"\"\ngetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    overwritepolicy: 0\n    currentnumberofrecords: 0\n    maxnumberofrecords: 0\n    elementname: \"\"\n    enabledstate: 0\n    requestedstate: 0\n    percentagefree: 0\n    name: \"\"\n    timeoflastrecord:\n        datetime: \"\"\n    auditstate: 0\n    maxallowedauditors: 0\n    storagepolicy: 0\n    mindaystokeep: 0\npullresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    auditlogitems: []\nreadrecordsresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    totalrecordcount: 0\n    recordsreturned: 0\n    eventrecords: []\n    returnvalue: 0\ndecodedrecordsresponse: []\n"
+	expectedResult := "xmlname:\n    space: \"\"\n    local: \"\"\nenumerateresponse:\n    enumerationcontext: \"\"\ngetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    overwritepolicy: 0\n    currentnumberofrecords: 0\n    maxnumberofrecords: 0\n    elementname: \"\"\n    enabledstate: 0\n    requestedstate: 0\n    percentagefree: 0\n    name: \"\"\n    timeoflastrecord:\n        datetime: \"\"\n    auditstate: 0\n    maxallowedauditors: 0\n    storagepolicy: 0\n    mindaystokeep: 0\npullresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    auditlogitems: []\nreadrecordsresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    totalrecordcount: 0\n    recordsreturned: 0\n    eventrecords: []\n    returnvalue: 0\ndecodedrecordsresponse: []\nrecordspage:\n    startindex: 0\n    nextindex: 0\n    totalrecordcount: 0\n    hasmore: false\nauditlock:\n    locked: false\n    unprovisioninglock: false\nclearlog_output:\n    returnvalue: 0\nsetauditlock_output:\n    handle: 0\n    returnvalue: 0\nsetstoragepolicy_output:\n    returnvalue: 0\nsetsigningkeymaterial_output:\n    returnvalue: 0\nexportauditlogsignature_output:\n    totalrecordcount: 0\n    startlogtime:\n        datetime: \"\"\n    endlogtime:\n        datetime: \"\"\n    generationtime:\n        datetime: \"\"\n    uuid: \"\"\n    fqdn: \"\"\n    signaturemechanism: 0\n    signature: \"\"\n    lengthofcertificates: []\n    certificates: \"\"\n    returnvalue: 0\n"
 	result := response.YAML()
 	assert.Equal(t, expectedResult, result)
 }
@@ -192,6 +192,99 @@ func TestPositiveAMT_AuditLog(t *testing.T) {
 							ExStr:          "Firmware update was started.\nOld version: 3072.0.10240.39173\nNew version: 3072.0.9216.37893",
 						},
 					},
+					RecordsPage: RecordsPage{StartIndex: 1, NextIndex: 3, TotalRecordCount: 2},
+				},
+			},
+			// CLEAR LOG
+			{
+				"should create a valid AMT_AuditLog ClearLog wsman message",
+				AMTAuditLog,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog/ClearLog`,
+				`<h:ClearLog_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog"></h:ClearLog_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = "ClearLog"
+
+					return elementUnderTest.ClearLog()
+				},
+				Body{
+					XMLName:         xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					ClearLog_OUTPUT: ClearLog_OUTPUT{ReturnValue: PTStatusSuccess},
+				},
+			},
+			// SET AUDIT LOCK
+			{
+				"should create a valid AMT_AuditLog SetAuditLock wsman message",
+				AMTAuditLog,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog/SetAuditLock`,
+				`<h:SetAuditLock_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog"><h:LockTimeoutInSeconds>60</h:LockTimeoutInSeconds><h:Flag>0</h:Flag><h:Handle>0</h:Handle></h:SetAuditLock_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = "SetAuditLock"
+
+					return elementUnderTest.SetAuditLock(60, AuditLockFlagLock, 0)
+				},
+				Body{
+					XMLName:             xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					SetAuditLock_OUTPUT: SetAuditLock_OUTPUT{Handle: 1, ReturnValue: PTStatusSuccess},
+				},
+			},
+			// SET STORAGE POLICY
+			{
+				"should create a valid AMT_AuditLog SetStoragePolicy wsman message",
+				AMTAuditLog,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog/SetStoragePolicy`,
+				`<h:SetStoragePolicy_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog"><h:StoragePolicy>2</h:StoragePolicy><h:MinDaysToKeep>30</h:MinDaysToKeep></h:SetStoragePolicy_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = "SetStoragePolicy"
+
+					return elementUnderTest.SetStoragePolicy(StoragePolicyRestrictedRollOver, 30)
+				},
+				Body{
+					XMLName:                 xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					SetStoragePolicy_OUTPUT: SetStoragePolicy_OUTPUT{ReturnValue: PTStatusSuccess},
+				},
+			},
+			// SET SIGNING KEY MATERIAL
+			{
+				"should create a valid AMT_AuditLog SetSigningKeyMaterial wsman message",
+				AMTAuditLog,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog/SetSigningKeyMaterial`,
+				`<h:SetSigningKeyMaterial_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog"><h:SigningMechanismType>0</h:SigningMechanismType><h:SigningKey>a2V5</h:SigningKey><h:LengthOfCertificates>4</h:LengthOfCertificates><h:LengthOfCertificates>2</h:LengthOfCertificates><h:Certificates>Y2VydGNh</h:Certificates></h:SetSigningKeyMaterial_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = "SetSigningKeyMaterial"
+
+					return elementUnderTest.SetSigningKeyMaterial(SigningMechanismRSASHA256, []byte("key"), [][]byte{[]byte("cert"), []byte("ca")})
+				},
+				Body{
+					XMLName:                      xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					SetSigningKeyMaterial_OUTPUT: SetSigningKeyMaterial_OUTPUT{ReturnValue: PTStatusSuccess},
+				},
+			},
+			// EXPORT AUDIT LOG SIGNATURE
+			{
+				"should create a valid AMT_AuditLog ExportAuditLogSignature wsman message",
+				AMTAuditLog,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog/ExportAuditLogSignature`,
+				`<h:ExportAuditLogSignature_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog"><h:SigningMechanism>0</h:SigningMechanism></h:ExportAuditLogSignature_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = "ExportAuditLogSignature"
+
+					return elementUnderTest.ExportAuditLogSignature(SigningMechanismRSASHA256)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					ExportAuditLogSignature_OUTPUT: ExportAuditLogSignature_OUTPUT{
+						TotalRecordCount:     2,
+						StartLogTime:         Datetime{Datetime: "2024-01-02T03:04:05Z"},
+						EndLogTime:           Datetime{Datetime: "2024-01-03T03:04:05Z"},
+						GenerationTime:       Datetime{Datetime: "2024-01-04T03:04:05Z"},
+						UUID:                 "AAECAwQFBgcICQoLDA0ODw==",
+						FQDN:                 "host.example.com",
+						SignatureMechanism:   SigningMechanismRSASHA256,
+						Signature:            "c2lnbmF0dXJl",
+						LengthOfCertificates: []int{4},
+						Certificates:         "Y2VydA==",
+						ReturnValue:          PTStatusSuccess,
+					},
 				},
 			},
 		}
@@ -350,3 +443,19 @@ func TestNegativeAMT_AuditLog(t *testing.T) {
 		}
 	})
 }
+
+func TestGetAuditLock(t *testing.T) {
+	client := wsmantesting.MockClient{PackageUnderTest: "amt/auditlog", CurrentMessage: wsmantesting.CurrentMessageGet}
+	elementUnderTest := NewAuditLogWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), &client)
+
+	response, err := elementUnderTest.GetAuditLock()
+	assert.NoError(t, err)
+	assert.Contains(t, response.XMLInput, wsmantesting.Get)
+	assert.Equal(t, 16, response.Body.GetResponse.AuditState)
+	assert.Equal(t, AuditLockState{}, response.Body.AuditLock)
+
+	client.CurrentMessage = wsmantesting.CurrentMessageError
+
+	_, err = elementUnderTest.GetAuditLock()
+	assert.Error(t, err)
+}
@@ -0,0 +1,294 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package auditlog
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"iter"
+	"time"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/base"
+)
+
+var ErrReadRecordsFailed = errors.New("failed to read audit log records")
+
+// Cursor marks the last record returned by RecordsSince. It can be persisted, e.g. as JSON, to resume reading later.
+type Cursor struct {
+	Index int       // Index of the record when it was read; only a hint, as indexes shift when the log wraps
+	Hash  string    // Hash of the time, initiator and event of the record
+	Time  time.Time // Time stamp of the record
+}
+
+// IsZero reports whether the cursor is unset, in which case RecordsSince returns the whole log.
+func (c Cursor) IsZero() bool {
+	return c.Hash == ""
+}
+
+// Entry is a record returned by RecordsSince.
+type Entry struct {
+	Index  int            // Index of the record in the log
+	Raw    string         // Base64 encoded record, as returned by ReadRecords
+	Record AuditLogRecord // Decoded record
+	Cursor Cursor         // Cursor to persist once the record has been handled
+	// Wrapped is set on the first entry when the log wrapped since the cursor was taken, so that the cursor record
+	// moved to a lower index. No records were lost.
+	Wrapped bool
+	// Gap is set on the first entry when the cursor record is no longer in the log, because it was overwritten or the
+	// log was cleared. Records between the cursor and this entry may have been lost.
+	Gap bool
+}
+
+// RecordsSince returns the records that are newer than cursor, oldest first, reading as many pages as needed. The
+// record at the cursor index is checked first; when it is not the cursor record, the whole log is searched for it.
+// When the cursor record is gone, the records that are not older than the cursor are returned and the first one is
+// marked as a Gap. Iteration stops at the first error.
+func (service Service) RecordsSince(cursor Cursor, opts ...base.HeaderOption) iter.Seq2[Entry, error] {
+	return func(yield func(Entry, error) bool) {
+		reader := recordReader{service: service, opts: opts}
+
+		start, wrapped, gap, err := reader.seek(cursor)
+		if err != nil {
+			yield(Entry{}, err)
+
+			return
+		}
+
+		first := true
+
+		for index := start; ; index++ {
+			raw, ok, err := reader.record(index)
+			if err != nil {
+				yield(Entry{}, err)
+
+				return
+			}
+
+			if !ok {
+				return
+			}
+
+			entry, err := newEntry(index, raw)
+			if err != nil {
+				yield(Entry{}, err)
+
+				return
+			}
+
+			if gap && entry.Cursor.Time.Before(cursor.Time) {
+				continue
+			}
+
+			if first {
+				entry.Wrapped, entry.Gap = wrapped, gap
+				first = false
+			}
+
+			if !yield(entry, nil) {
+				return
+			}
+		}
+	}
+}
+
+// recordReader reads the log one page at a time, keeping the last page read.
+type recordReader struct {
+	service Service
+	opts    []base.HeaderOption
+	start   int
+	records []string
+}
+
+// seek returns the index of the first record after cursor.
+func (r *recordReader) seek(cursor Cursor) (start int, wrapped, gap bool, err error) {
+	if cursor.IsZero() {
+		return 1, false, false, nil
+	}
+
+	if cursor.Index > 0 {
+		raw, ok, err := r.record(cursor.Index)
+		if err != nil {
+			return 0, false, false, err
+		}
+
+		if ok {
+			hash, _, err := recordHash(raw)
+			if err != nil {
+				return 0, false, false, err
+			}
+
+			if hash == cursor.Hash {
+				return cursor.Index + 1, false, false, nil
+			}
+		}
+	}
+
+	// the log wrapped or was cleared, so the cursor record has moved or is gone: search the whole log for its first
+	// match, so that a duplicate record is returned again rather than lost
+	for index := 1; ; index += RecordsPerRead {
+		found, ok, err := r.find(cursor, index)
+		if err != nil {
+			return 0, false, false, err
+		}
+
+		if ok {
+			return found + 1, found != cursor.Index, false, nil
+		}
+
+		if len(r.records) < RecordsPerRead {
+			break
+		}
+	}
+
+	return 1, false, true, nil
+}
+
+// find reads the page starting at index and returns the index of the first record in it that matches cursor.
+func (r *recordReader) find(cursor Cursor, index int) (int, bool, error) {
+	if err := r.read(index); err != nil {
+		return 0, false, err
+	}
+
+	for i, raw := range r.records {
+		hash, _, err := recordHash(raw)
+		if err != nil {
+			return 0, false, err
+		}
+
+		if hash == cursor.Hash {
+			return r.start + i, true, nil
+		}
+	}
+
+	return 0, false, nil
+}
+
+// record returns the record at index, reading its page when needed, and false past the end of the log.
+func (r *recordReader) record(index int) (string, bool, error) {
+	end := r.start + len(r.records)
+
+	if index < r.start || index >= end {
+		// a short page is the last one
+		if r.start != 0 && index == end && len(r.records) < RecordsPerRead {
+			return "", false, nil
+		}
+
+		if err := r.read(index); err != nil {
+			return "", false, err
+		}
+
+		if index < r.start || index >= r.start+len(r.records) {
+			return "", false, nil
+		}
+	}
+
+	return r.records[index-r.start], true, nil
+}
+
+func (r *recordReader) read(index int) error {
+	output, page, err := r.service.readRecords(index, r.opts...)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case output.ReturnValue == int(PTStatusInvalidIndex):
+		// the index is past the end of the log
+		r.start, r.records = index, nil
+	case output.ReturnValue != int(PTStatusSuccess):
+		return fmt.Errorf("%w: %s", ErrReadRecordsFailed, ReturnValue(output.ReturnValue))
+	default:
+		r.start, r.records = page.StartIndex, output.EventRecords
+	}
+
+	return nil
+}
+
+func newEntry(index int, raw string) (Entry, error) {
+	hash, timeStamp, err := recordHash(raw)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	return Entry{
+		Index:  index,
+		Raw:    raw,
+		Record: convertToAuditLogResult([]string{raw})[0],
+		Cursor: Cursor{Index: index, Hash: hash, Time: timeStamp},
+	}, nil
+}
+
+// recordHash hashes the application, event, initiator and time stamp at the start of a record, which together identify
+// it independently of its index, and returns the time stamp. It checks that the whole record, up to the end of its
+// extended data, is present, so that it can be decoded.
+func recordHash(raw string) (string, time.Time, error) {
+	record, err := base64.StdEncoding.DecodeString(raw)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("%w: %w", ErrInvalidRecord, err)
+	}
+
+	end, err := recordLength(record)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	hash := sha256.Sum256(record[:end+4])
+	timeStamp := time.Unix(int64(binary.BigEndian.Uint32(record[end:end+4])), 0)
+
+	return hex.EncodeToString(hash[:]), timeStamp, nil
+}
+
+// recordLength checks the length of each field of record and returns the offset of its time stamp.
+func recordLength(record []byte) (int, error) {
+	truncated := fmt.Errorf("%w: %d bytes", ErrInvalidRecord, len(record))
+
+	// application ID, event ID and initiator type
+	if len(record) < 5 {
+		return 0, truncated
+	}
+
+	var end int
+
+	switch record[4] {
+	case HTTPDigest:
+		if len(record) < 6 {
+			return 0, truncated
+		}
+
+		end = 6 + int(record[5])
+	case Kerberos:
+		if len(record) < 10 {
+			return 0, truncated
+		}
+
+		end = 10 + int(record[9])
+	case Local, KvmDefaultPort:
+		end = 5
+	default:
+		return 0, fmt.Errorf("%w: initiator type %d", ErrInvalidRecord, record[4])
+	}
+
+	// time stamp and MC location type, then the lengths of the network address and the extended data, each followed
+	// by its data
+	next := end + 5
+	for range 2 {
+		if len(record) < next+1 {
+			return 0, truncated
+		}
+
+		next += 1 + int(record[next])
+	}
+
+	if len(record) < next {
+		return 0, truncated
+	}
+
+	return end, nil
+}
@@ -0,0 +1,265 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package auditlog
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+var startIndexPattern = regexp.MustCompile(`<h:StartIndex>(\d+)</h:StartIndex>`)
+
+// pagingClient answers ReadRecords requests from an in-memory log.
+type pagingClient struct {
+	client.WSMan
+
+	records     []string
+	returnValue int
+	reads       []int
+}
+
+func (c *pagingClient) Post(msg string) ([]byte, error) {
+	match := startIndexPattern.FindStringSubmatch(msg)
+	startIndex, _ := strconv.Atoi(match[1])
+	c.reads = append(c.reads, startIndex)
+
+	returnValue := c.returnValue
+	if startIndex > len(c.records) {
+		returnValue = int(PTStatusInvalidIndex)
+	}
+
+	var records strings.Builder
+
+	page := c.records[min(startIndex-1, len(c.records)):min(startIndex-1+RecordsPerRead, len(c.records))]
+	for _, record := range page {
+		records.WriteString("<EventRecords>" + record + "</EventRecords>")
+	}
+
+	return fmt.Appendf(nil, "<Envelope><Header></Header><Body><ReadRecords_OUTPUT><TotalRecordCount>%d</TotalRecordCount><RecordsReturned>%d</RecordsReturned>%s<ReturnValue>%d</ReturnValue></ReadRecords_OUTPUT></Body></Envelope>",
+		len(c.records), len(page), records.String(), returnValue), nil
+}
+
+// streamingPagingClient is a pagingClient that also implements client.Streamer.
+type streamingPagingClient struct {
+	*pagingClient
+
+	streams int
+}
+
+func (c *streamingPagingClient) PostReader(msg string) (io.ReadCloser, error) {
+	c.streams++
+
+	response, err := c.Post(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return io.NopCloser(bytes.NewReader(response)), nil
+}
+
+// localRecord returns a record of a local event at the given time.
+func localRecord(eventID int, timeStamp int64) string {
+	record := []byte{0, SecurityAdmin, 0, byte(eventID), Local}
+	record = binary.BigEndian.AppendUint32(record, uint32(timeStamp))
+	record = append(record, 0, 0, 0)
+
+	return base64.StdEncoding.EncodeToString(record)
+}
+
+func newPagingClient(count int) *pagingClient {
+	c := &pagingClient{}
+	for i := range count {
+		c.records = append(c.records, localRecord(i%12, 1700000000+int64(i)))
+	}
+
+	return c
+}
+
+func readAll(t *testing.T, service Service, cursor Cursor) []Entry {
+	t.Helper()
+
+	var entries []Entry
+
+	for entry, err := range service.RecordsSince(cursor) {
+		require.NoError(t, err)
+
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+func indexes(entries []Entry) []int {
+	result := make([]int, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, entry.Index)
+	}
+
+	return result
+}
+
+func lastError(service Service) error {
+	var last error
+
+	for _, err := range service.RecordsSince(Cursor{}) {
+		last = err
+	}
+
+	return last
+}
+
+func TestRecordsSince(t *testing.T) {
+	log := newPagingClient(25)
+	service := NewAuditLogWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), log)
+
+	entries := readAll(t, service, Cursor{})
+	require.Len(t, entries, 25)
+	assert.Equal(t, 1, entries[0].Index)
+	assert.Equal(t, log.records[24], entries[24].Raw)
+	assert.Equal(t, time.Unix(1700000024, 0), entries[24].Cursor.Time)
+	assert.Equal(t, "Security Admin Events", entries[0].Record.AuditApp)
+	assert.False(t, entries[0].Wrapped || entries[0].Gap)
+	assert.Equal(t, []int{1, 11, 21}, log.reads)
+
+	cursor := entries[11].Cursor
+
+	t.Run("resumes after the cursor", func(t *testing.T) {
+		log.reads = nil
+
+		entries := readAll(t, service, cursor)
+		assert.Equal(t, []int{13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25}, indexes(entries))
+		assert.False(t, entries[0].Wrapped || entries[0].Gap)
+		assert.Equal(t, []int{12, 22}, log.reads)
+	})
+
+	t.Run("nothing new", func(t *testing.T) {
+		assert.Empty(t, readAll(t, service, entries[24].Cursor))
+	})
+
+	t.Run("detects wrap-around", func(t *testing.T) {
+		wrapped := &pagingClient{records: append(append([]string{}, log.records[5:]...), localRecord(1, 1800000000))}
+		service := NewAuditLogWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), wrapped)
+
+		entries := readAll(t, service, cursor)
+		require.Len(t, entries, 14)
+		assert.Equal(t, 8, entries[0].Index)
+		assert.Equal(t, log.records[12], entries[0].Raw)
+		assert.True(t, entries[0].Wrapped)
+		assert.False(t, entries[0].Gap)
+		assert.False(t, entries[1].Wrapped)
+	})
+
+	t.Run("detects gaps", func(t *testing.T) {
+		overwritten := &pagingClient{records: append([]string{}, log.records[15:]...)}
+		service := NewAuditLogWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), overwritten)
+
+		entries := readAll(t, service, cursor)
+		require.Len(t, entries, 10)
+		assert.Equal(t, log.records[15], entries[0].Raw)
+		assert.True(t, entries[0].Gap)
+		assert.False(t, entries[1].Gap)
+	})
+
+	t.Run("gap skips records older than the cursor", func(t *testing.T) {
+		cleared := &pagingClient{records: []string{localRecord(1, 1600000000), localRecord(2, 1800000000)}}
+		service := NewAuditLogWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), cleared)
+
+		entries := readAll(t, service, cursor)
+		require.Len(t, entries, 1)
+		assert.Equal(t, cleared.records[1], entries[0].Raw)
+		assert.True(t, entries[0].Gap)
+	})
+
+	t.Run("duplicate records", func(t *testing.T) {
+		duplicate := localRecord(3, 1800000000)
+		duplicates := &pagingClient{records: append(append([]string{}, log.records[:14]...), duplicate, duplicate, duplicate)}
+		service := NewAuditLogWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), duplicates)
+
+		entries := readAll(t, service, Cursor{})
+		require.Len(t, entries, 17)
+
+		resumed := readAll(t, service, entries[14].Cursor)
+		assert.Equal(t, []int{16, 17}, indexes(resumed))
+		assert.False(t, resumed[0].Wrapped || resumed[0].Gap)
+
+		// after a wrap, the first match is taken so that no duplicate is lost
+		wrapped := &pagingClient{records: append(append([]string{}, duplicates.records[3:]...), localRecord(4, 1800000001))}
+		service = NewAuditLogWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), wrapped)
+
+		resumed = readAll(t, service, entries[14].Cursor)
+		assert.Equal(t, []int{13, 14, 15}, indexes(resumed))
+		assert.True(t, resumed[0].Wrapped)
+	})
+
+	t.Run("stops when the caller does", func(t *testing.T) {
+		log.reads = nil
+
+		for range service.RecordsSince(Cursor{}) {
+			break
+		}
+
+		assert.Equal(t, []int{1}, log.reads)
+	})
+}
+
+func TestRecordsSinceStreams(t *testing.T) {
+	log := &streamingPagingClient{pagingClient: newPagingClient(15)}
+	service := NewAuditLogWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), log)
+
+	entries := readAll(t, service, Cursor{})
+	require.Len(t, entries, 15)
+	assert.Equal(t, log.records[14], entries[14].Raw)
+	assert.Equal(t, []int{1, 11}, log.reads)
+	assert.Equal(t, 2, log.streams)
+}
+
+func TestRecordsSinceErrors(t *testing.T) {
+	failing := newPagingClient(3)
+	failing.returnValue = int(PTStatusNotReady)
+	service := NewAuditLogWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), failing)
+
+	assert.ErrorIs(t, lastError(service), ErrReadRecordsFailed)
+
+	invalid := &pagingClient{records: []string{"!"}}
+	service = NewAuditLogWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), invalid)
+
+	assert.ErrorIs(t, lastError(service), ErrInvalidRecord)
+
+	truncated := map[string][]byte{
+		"time stamp":      {0, SecurityAdmin, 0, 1, Local, 0x65, 0x53},
+		"location type":   {0, SecurityAdmin, 0, 1, Local, 0x65, 0x53, 0xf1, 0x00},
+		"network address": {0, SecurityAdmin, 0, 1, Local, 0x65, 0x53, 0xf1, 0x00, 0, 4, 127, 0},
+		"extended data":   {0, SecurityAdmin, 0, 1, Local, 0x65, 0x53, 0xf1, 0x00, 0, 0, 2, 1},
+		"user name":       {0, SecurityAdmin, 0, 1, HTTPDigest, 5, 'a', 'd'},
+		"initiator type":  {0, SecurityAdmin, 0, 1, 4, 0x65, 0x53, 0xf1, 0x00, 0, 0, 0},
+	}
+	for name, record := range truncated {
+		log := &pagingClient{records: []string{base64.StdEncoding.EncodeToString(record)}}
+		service = NewAuditLogWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), log)
+
+		assert.ErrorIs(t, lastError(service), ErrInvalidRecord, name)
+	}
+
+	empty := wsmantesting.MockClient{PackageUnderTest: "amt/auditlog", CurrentMessage: wsmantesting.CurrentMessageError}
+	service = NewAuditLogWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), &empty)
+
+	assert.ErrorIs(t, lastError(service), ErrReadRecordsFailed)
+}
@@ -0,0 +1,249 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package auditlog
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrInvalidRecord           = errors.New("invalid audit log record")
+	ErrInvalidSignatureExport  = errors.New("invalid audit log signature export")
+	ErrInvalidCertificateChain = errors.New("invalid audit log signing certificate chain")
+	ErrRecordCountMismatch     = errors.New("number of records does not match the signed record count")
+	ErrUntrustedSigner         = errors.New("audit log signing certificate is not trusted")
+	ErrUnsupportedSigningKey   = errors.New("unsupported audit log signing key")
+	ErrUnsupportedMechanism    = errors.New("unsupported audit log signature mechanism")
+	ErrSignatureMismatch       = errors.New("audit log signature does not match the records")
+	ErrPlatformMismatch        = errors.New("audit log signature was exported by another platform")
+)
+
+// SignatureVerifier checks an audit log signature exported by ExportAuditLogSignature against the records it covers,
+// without access to the device.
+type SignatureVerifier struct {
+	// Roots are the trusted root certificates of the signing certificate chain.
+	Roots *x509.CertPool
+	// UUID is the base64 encoded UUID of the platform expected to have exported the signature, as in
+	// ExportAuditLogSignature_OUTPUT. The UUID of the export must match it.
+	UUID string
+	// CurrentTime is the time at which the certificate chain is validated. The current time is used when it is zero.
+	CurrentTime time.Time
+	// AtGenerationTime validates the certificate chain at the GenerationTime of the export instead, so that an
+	// archived log still verifies after its signing certificate expired. GenerationTime is not covered by the
+	// signature, so only set it for exports that were stored where they cannot be altered.
+	AtGenerationTime bool
+}
+
+// SignedRange describes the part of the audit log covered by a verified signature. Only the records are authenticated
+// by the signature; the record range follows from their number. The times, UUID and FQDN are copied from the export as
+// reported by the device and are not authenticated, although the UUID was checked against SignatureVerifier.UUID.
+type SignedRange struct {
+	FirstRecord    int               // Index of the oldest signed record; 1 is the first record in the log
+	LastRecord     int               // Index of the newest signed record
+	StartLogTime   time.Time         // Time stamp of the oldest record, as reported; not authenticated
+	EndLogTime     time.Time         // Time stamp of the newest record, as reported; not authenticated
+	GenerationTime time.Time         // Time the signature was generated, as reported; not authenticated
+	UUID           string            // Base64 encoded UUID of the platform, as reported; not authenticated
+	FQDN           string            // FQDN of the platform, as reported; not authenticated
+	Signer         *x509.Certificate // Certificate of the signing key
+}
+
+// SignedData rebuilds the data signed by ExportAuditLogSignature: the raw records, oldest first, concatenated.
+// records are base64 encoded, as returned in EventRecords by ReadRecords.
+func SignedData(records []string) ([]byte, error) {
+	var data []byte
+
+	for i, record := range records {
+		raw, err := base64.StdEncoding.DecodeString(record)
+		if err != nil {
+			return nil, fmt.Errorf("%w: record %d: %w", ErrInvalidRecord, i+1, err)
+		}
+
+		data = append(data, raw...)
+	}
+
+	return data, nil
+}
+
+// Verify checks that export is a valid signature over records and that its signing certificate chains up to one of
+// the Roots. records must be all the records of the log, oldest first, as read with ReadRecords before the signature
+// was exported. The SignatureMechanism of export must match the key of the signing certificate. Only the records are
+// checked against the signature; see SignedRange for the fields that are not authenticated.
+func (v SignatureVerifier) Verify(records []string, export ExportAuditLogSignature_OUTPUT) (SignedRange, error) {
+	signedRange, err := newSignedRange(export)
+	if err != nil {
+		return SignedRange{}, err
+	}
+
+	if export.UUID != v.UUID {
+		return SignedRange{}, fmt.Errorf("%w: got UUID %q, expected %q", ErrPlatformMismatch, export.UUID, v.UUID)
+	}
+
+	if len(records) != export.TotalRecordCount {
+		return SignedRange{}, fmt.Errorf("%w: got %d records, signature covers %d", ErrRecordCountMismatch, len(records), export.TotalRecordCount)
+	}
+
+	data, err := SignedData(records)
+	if err != nil {
+		return SignedRange{}, err
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(export.Signature)
+	if err != nil {
+		return SignedRange{}, fmt.Errorf("%w: signature: %w", ErrInvalidSignatureExport, err)
+	}
+
+	chain, err := certificateChain(export)
+	if err != nil {
+		return SignedRange{}, err
+	}
+
+	currentTime := v.CurrentTime
+
+	switch {
+	case v.AtGenerationTime:
+		if signedRange.GenerationTime.IsZero() {
+			return SignedRange{}, fmt.Errorf("%w: no GenerationTime", ErrInvalidSignatureExport)
+		}
+
+		currentTime = signedRange.GenerationTime
+	case currentTime.IsZero():
+		currentTime = time.Now()
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, certificate := range chain[1:] {
+		intermediates.AddCert(certificate)
+	}
+
+	_, err = chain[0].Verify(x509.VerifyOptions{
+		Roots:         v.Roots,
+		Intermediates: intermediates,
+		CurrentTime:   currentTime,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return SignedRange{}, fmt.Errorf("%w: %w", ErrUntrustedSigner, err)
+	}
+
+	algorithm, err := signatureAlgorithm(export.SignatureMechanism, chain[0])
+	if err != nil {
+		return SignedRange{}, err
+	}
+
+	if err := chain[0].CheckSignature(algorithm, data, signature); err != nil {
+		return SignedRange{}, fmt.Errorf("%w: %w", ErrSignatureMismatch, err)
+	}
+
+	signedRange.Signer = chain[0]
+
+	return signedRange, nil
+}
+
+func newSignedRange(export ExportAuditLogSignature_OUTPUT) (SignedRange, error) {
+	signedRange := SignedRange{
+		LastRecord: export.TotalRecordCount,
+		UUID:       export.UUID,
+		FQDN:       export.FQDN,
+	}
+
+	if export.TotalRecordCount > 0 {
+		signedRange.FirstRecord = 1
+	}
+
+	for _, field := range []struct {
+		name  string
+		value Datetime
+		time  *time.Time
+	}{
+		{"StartLogTime", export.StartLogTime, &signedRange.StartLogTime},
+		{"EndLogTime", export.EndLogTime, &signedRange.EndLogTime},
+		{"GenerationTime", export.GenerationTime, &signedRange.GenerationTime},
+	} {
+		if field.value.Datetime == "" {
+			continue
+		}
+
+		parsed, err := time.Parse(time.RFC3339, field.value.Datetime)
+		if err != nil {
+			return SignedRange{}, fmt.Errorf("%w: %s: %w", ErrInvalidSignatureExport, field.name, err)
+		}
+
+		*field.time = parsed
+	}
+
+	return signedRange, nil
+}
+
+// certificateChain splits the certificates of export into the signing certificate chain, leaf first.
+func certificateChain(export ExportAuditLogSignature_OUTPUT) ([]*x509.Certificate, error) {
+	raw, err := base64.StdEncoding.DecodeString(export.Certificates)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCertificateChain, err)
+	}
+
+	if len(export.LengthOfCertificates) == 0 {
+		return nil, fmt.Errorf("%w: no certificates", ErrInvalidCertificateChain)
+	}
+
+	chain := make([]*x509.Certificate, 0, len(export.LengthOfCertificates))
+
+	for i, length := range export.LengthOfCertificates {
+		if length <= 0 || length > len(raw) {
+			return nil, fmt.Errorf("%w: certificate %d has length %d, %d bytes left", ErrInvalidCertificateChain, i+1, length, len(raw))
+		}
+
+		certificate, err := x509.ParseCertificate(raw[:length])
+		if err != nil {
+			return nil, fmt.Errorf("%w: certificate %d: %w", ErrInvalidCertificateChain, i+1, err)
+		}
+
+		chain = append(chain, certificate)
+		raw = raw[length:]
+	}
+
+	if len(raw) != 0 {
+		return nil, fmt.Errorf("%w: %d bytes after the last certificate", ErrInvalidCertificateChain, len(raw))
+	}
+
+	return chain, nil
+}
+
+// signatureAlgorithm returns the algorithm of mechanism after checking that it matches the key of certificate. RSA keys
+// sign with SigningMechanismRSASHA256. ECDSA keys sign with the hash matching the size of their curve, under any other
+// mechanism.
+func signatureAlgorithm(mechanism SigningMechanism, certificate *x509.Certificate) (x509.SignatureAlgorithm, error) {
+	switch key := certificate.PublicKey.(type) {
+	case *rsa.PublicKey:
+		if mechanism != SigningMechanismRSASHA256 {
+			return x509.UnknownSignatureAlgorithm, fmt.Errorf("%w: %d with an RSA key", ErrUnsupportedMechanism, mechanism)
+		}
+
+		return x509.SHA256WithRSA, nil
+	case *ecdsa.PublicKey:
+		if mechanism == SigningMechanismRSASHA256 {
+			return x509.UnknownSignatureAlgorithm, fmt.Errorf("%w: %s signature with an ECDSA key", ErrUnsupportedSigningKey, mechanism)
+		}
+
+		switch key.Curve {
+		case elliptic.P256():
+			return x509.ECDSAWithSHA256, nil
+		case elliptic.P384():
+			return x509.ECDSAWithSHA384, nil
+		case elliptic.P521():
+			return x509.ECDSAWithSHA512, nil
+		}
+	}
+
+	return x509.UnknownSignatureAlgorithm, fmt.Errorf("%w: %T", ErrUnsupportedSigningKey, certificate.PublicKey)
+}
@@ -0,0 +1,267 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package auditlog
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const signatureTestUUID = "AAECAwQFBgcICQoLDA0ODw=="
+
+var signatureTestRecords = []string{
+	"ABMAAAI/9M1uAgAQAAwAAAAoBZkADAAAACQFlA==",
+	"ABMAAAI/9M1vAgAQAAwAAAAoBZkADAAAACQFlA==",
+}
+
+// signingChain is a locally generated root and signing certificate.
+type signingChain struct {
+	roots *x509.CertPool
+	leaf  []byte
+	root  []byte
+	key   crypto.Signer
+}
+
+func newSigningChain(t *testing.T, key crypto.Signer, notAfter time.Time) signingChain {
+	t.Helper()
+
+	rootKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	notBefore := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	rootTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Audit Root"},
+		NotBefore:             notBefore,
+		NotAfter:              notBefore.AddDate(10, 0, 0),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	root, err := x509.CreateCertificate(rand.Reader, rootTemplate, rootTemplate, rootKey.Public(), rootKey)
+	require.NoError(t, err)
+
+	rootCertificate, err := x509.ParseCertificate(root)
+	require.NoError(t, err)
+
+	leaf, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "Audit Signer"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}, rootCertificate, key.Public(), rootKey)
+	require.NoError(t, err)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(rootCertificate)
+
+	return signingChain{roots: roots, leaf: leaf, root: root, key: key}
+}
+
+func (c signingChain) export(t *testing.T, records []string) ExportAuditLogSignature_OUTPUT {
+	t.Helper()
+
+	data, err := SignedData(records)
+	require.NoError(t, err)
+
+	hash, mechanism := crypto.SHA256, SigningMechanismRSASHA256
+	if key, ok := c.key.Public().(*ecdsa.PublicKey); ok {
+		// ECDSA mechanisms are not named; any other than RSA-SHA256 is accepted
+		mechanism = 1
+
+		if key.Curve == elliptic.P384() {
+			hash = crypto.SHA384
+		}
+	}
+
+	digest := hash.New()
+	digest.Write(data)
+
+	signature, err := c.key.Sign(rand.Reader, digest.Sum(nil), hash)
+	require.NoError(t, err)
+
+	return ExportAuditLogSignature_OUTPUT{
+		TotalRecordCount:     len(records),
+		StartLogTime:         Datetime{Datetime: "2024-01-02T03:04:05Z"},
+		EndLogTime:           Datetime{Datetime: "2024-01-03T03:04:05Z"},
+		GenerationTime:       Datetime{Datetime: "2024-01-04T03:04:05Z"},
+		UUID:                 signatureTestUUID,
+		FQDN:                 "host.example.com",
+		SignatureMechanism:   mechanism,
+		Signature:            base64.StdEncoding.EncodeToString(signature),
+		LengthOfCertificates: []int{len(c.leaf), len(c.root)},
+		Certificates:         base64.StdEncoding.EncodeToString(append(append([]byte{}, c.leaf...), c.root...)),
+	}
+}
+
+func TestSignatureVerifier_Verify(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	p256Key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	p384Key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+
+	notAfter := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for name, key := range map[string]crypto.Signer{"RSA": rsaKey, "ECDSA P-256": p256Key, "ECDSA P-384": p384Key} {
+		t.Run(name, func(t *testing.T) {
+			chain := newSigningChain(t, key, notAfter)
+			export := chain.export(t, signatureTestRecords)
+
+			signedRange, err := SignatureVerifier{
+				Roots:       chain.roots,
+				UUID:        signatureTestUUID,
+				CurrentTime: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+			}.Verify(signatureTestRecords, export)
+			require.NoError(t, err)
+
+			assert.Equal(t, 1, signedRange.FirstRecord)
+			assert.Equal(t, 2, signedRange.LastRecord)
+			assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), signedRange.StartLogTime)
+			assert.Equal(t, time.Date(2024, 1, 3, 3, 4, 5, 0, time.UTC), signedRange.EndLogTime)
+			assert.Equal(t, time.Date(2024, 1, 4, 3, 4, 5, 0, time.UTC), signedRange.GenerationTime)
+			assert.Equal(t, signatureTestUUID, signedRange.UUID)
+			assert.Equal(t, "host.example.com", signedRange.FQDN)
+			assert.Equal(t, "Audit Signer", signedRange.Signer.Subject.CommonName)
+		})
+	}
+
+	chain := newSigningChain(t, rsaKey, notAfter)
+	export := chain.export(t, signatureTestRecords)
+
+	t.Run("validates the chain at the current time", func(t *testing.T) {
+		_, err := SignatureVerifier{Roots: chain.roots, UUID: signatureTestUUID}.Verify(signatureTestRecords, export)
+		assert.ErrorIs(t, err, ErrUntrustedSigner)
+	})
+
+	t.Run("validates an archived log at its generation time", func(t *testing.T) {
+		verifier := SignatureVerifier{Roots: chain.roots, UUID: signatureTestUUID, AtGenerationTime: true}
+
+		_, err := verifier.Verify(signatureTestRecords, export)
+		require.NoError(t, err)
+
+		// a generation time after the certificate expired does not verify
+		late := export
+		late.GenerationTime.Datetime = "2025-06-01T00:00:00Z"
+
+		_, err = verifier.Verify(signatureTestRecords, late)
+		assert.ErrorIs(t, err, ErrUntrustedSigner)
+
+		missing := export
+		missing.GenerationTime.Datetime = ""
+
+		_, err = verifier.Verify(signatureTestRecords, missing)
+		assert.ErrorIs(t, err, ErrInvalidSignatureExport)
+	})
+
+	t.Run("checks the platform", func(t *testing.T) {
+		verifier := SignatureVerifier{Roots: chain.roots, UUID: "DwAODQwLCgkIBwYFBAMCAQ==", AtGenerationTime: true}
+
+		_, err := verifier.Verify(signatureTestRecords, export)
+		assert.ErrorIs(t, err, ErrPlatformMismatch)
+
+		_, err = SignatureVerifier{Roots: chain.roots, AtGenerationTime: true}.Verify(signatureTestRecords, export)
+		assert.ErrorIs(t, err, ErrPlatformMismatch)
+	})
+}
+
+func TestSignatureVerifier_VerifyErrors(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	chain := newSigningChain(t, key, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	other := newSigningChain(t, key, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	ecdsaChain := newSigningChain(t, ecdsaKey, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	tampered := []string{signatureTestRecords[0], "ABMAAAI/9M1vAgAQAAwAAAAoBZkADAAAACQFlQ=="}
+
+	tests := []struct {
+		name    string
+		records []string
+		roots   *x509.CertPool
+		modify  func(*ExportAuditLogSignature_OUTPUT)
+		err     error
+	}{
+		{"tampered record", tampered, chain.roots, nil, ErrSignatureMismatch},
+		{"missing record", signatureTestRecords[:1], chain.roots, nil, ErrRecordCountMismatch},
+		{"invalid record", []string{"!", "!"}, chain.roots, nil, ErrInvalidRecord},
+		{"untrusted root", signatureTestRecords, other.roots, nil, ErrUntrustedSigner},
+		{
+			"invalid signature", signatureTestRecords, chain.roots,
+			func(e *ExportAuditLogSignature_OUTPUT) { e.Signature = "!" },
+			ErrInvalidSignatureExport,
+		},
+		{
+			"invalid time", signatureTestRecords, chain.roots,
+			func(e *ExportAuditLogSignature_OUTPUT) { e.EndLogTime.Datetime = "yesterday" },
+			ErrInvalidSignatureExport,
+		},
+		{
+			"certificate length too long", signatureTestRecords, chain.roots,
+			func(e *ExportAuditLogSignature_OUTPUT) {
+				e.LengthOfCertificates = []int{len(chain.leaf) + len(chain.root) + 1}
+			},
+			ErrInvalidCertificateChain,
+		},
+		{
+			"bytes after the last certificate", signatureTestRecords, chain.roots,
+			func(e *ExportAuditLogSignature_OUTPUT) { e.LengthOfCertificates = []int{len(chain.leaf)} },
+			ErrInvalidCertificateChain,
+		},
+		{
+			"unknown mechanism", signatureTestRecords, chain.roots,
+			func(e *ExportAuditLogSignature_OUTPUT) { e.SignatureMechanism = 7 },
+			ErrUnsupportedMechanism,
+		},
+		{
+			"key does not match the mechanism", signatureTestRecords, ecdsaChain.roots,
+			func(e *ExportAuditLogSignature_OUTPUT) {
+				e.LengthOfCertificates = []int{len(ecdsaChain.leaf), len(ecdsaChain.root)}
+				e.Certificates = base64.StdEncoding.EncodeToString(append(append([]byte{}, ecdsaChain.leaf...), ecdsaChain.root...))
+			},
+			ErrUnsupportedSigningKey,
+		},
+		{
+			"no certificates", signatureTestRecords, chain.roots,
+			func(e *ExportAuditLogSignature_OUTPUT) { e.LengthOfCertificates, e.Certificates = nil, "" },
+			ErrInvalidCertificateChain,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			export := chain.export(t, signatureTestRecords)
+			if test.modify != nil {
+				test.modify(&export)
+			}
+
+			verifier := SignatureVerifier{Roots: test.roots, UUID: signatureTestUUID, AtGenerationTime: true}
+
+			_, err := verifier.Verify(test.records, export)
+			assert.ErrorIs(t, err, test.err)
+		})
+	}
+}
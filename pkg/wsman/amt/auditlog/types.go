@@ -16,11 +16,43 @@ import (
 
 // INPUTS
 // Request Types.
-type ReadRecordsInput struct {
-	XMLName    xml.Name `xml:"h:ReadRecords_INPUT"`
-	H          string   `xml:"xmlns:h,attr"`
-	StartIndex int      `xml:"h:StartIndex" json:"StartIndex"`
-}
+type (
+	ReadRecordsInput struct {
+		XMLName    xml.Name `xml:"h:ReadRecords_INPUT"`
+		H          string   `xml:"xmlns:h,attr"`
+		StartIndex int      `xml:"h:StartIndex" json:"StartIndex"`
+	}
+
+	SetAuditLock_INPUT struct {
+		XMLName              xml.Name      `xml:"h:SetAuditLock_INPUT"`
+		H                    string        `xml:"xmlns:h,attr"`
+		LockTimeoutInSeconds int           `xml:"h:LockTimeoutInSeconds"` // Time after which the lock is released if it was not released by its holder
+		Flag                 AuditLockFlag `xml:"h:Flag"`                 // Whether to take or release the lock
+		Handle               int           `xml:"h:Handle"`               // Handle of the lock holder, as returned when the lock was taken; ignored when locking
+	}
+
+	SetStoragePolicy_INPUT struct {
+		XMLName       xml.Name      `xml:"h:SetStoragePolicy_INPUT"`
+		H             string        `xml:"xmlns:h,attr"`
+		StoragePolicy StoragePolicy `xml:"h:StoragePolicy"`           // AuditLog storage policy
+		MinDaysToKeep int           `xml:"h:MinDaysToKeep,omitempty"` // Minimum number of days to keep records, used by the restricted roll over policy
+	}
+
+	SetSigningKeyMaterial_INPUT struct {
+		XMLName              xml.Name         `xml:"h:SetSigningKeyMaterial_INPUT"`
+		H                    string           `xml:"xmlns:h,attr"`
+		SigningMechanismType SigningMechanism `xml:"h:SigningMechanismType"` // Signing mechanism of the key
+		SigningKey           string           `xml:"h:SigningKey"`           // Base64 encoded private key used to sign the log
+		LengthOfCertificates []int            `xml:"h:LengthOfCertificates"` // Length of each certificate in Certificates, leaf first
+		Certificates         string           `xml:"h:Certificates"`         // Base64 encoded concatenation of the DER certificates of the signing key chain
+	}
+
+	ExportAuditLogSignature_INPUT struct {
+		XMLName          xml.Name         `xml:"h:ExportAuditLogSignature_INPUT"`
+		H                string           `xml:"xmlns:h,attr"`
+		SigningMechanism SigningMechanism `xml:"h:SigningMechanism"` // Signing mechanism to use
+	}
+)
 
 // OUTPUTS
 // Response Types.
@@ -38,6 +70,14 @@ type (
 		PullResponse           PullResponse
 		ReadRecordsResponse    ReadRecords_OUTPUT
 		DecodedRecordsResponse []AuditLogRecord
+		RecordsPage            RecordsPage
+		AuditLock              AuditLockState
+
+		ClearLog_OUTPUT                ClearLog_OUTPUT                `xml:"ClearLog_OUTPUT"`
+		SetAuditLock_OUTPUT            SetAuditLock_OUTPUT            `xml:"SetAuditLock_OUTPUT"`
+		SetStoragePolicy_OUTPUT        SetStoragePolicy_OUTPUT        `xml:"SetStoragePolicy_OUTPUT"`
+		SetSigningKeyMaterial_OUTPUT   SetSigningKeyMaterial_OUTPUT   `xml:"SetSigningKeyMaterial_OUTPUT"`
+		ExportAuditLogSignature_OUTPUT ExportAuditLogSignature_OUTPUT `xml:"ExportAuditLogSignature_OUTPUT"`
 	}
 	PullResponse struct {
 		XMLName       xml.Name   `xml:"PullResponse"`
@@ -73,6 +113,51 @@ type (
 		ReturnValue      int      `xml:"ReturnValue,omitempty"`      // ValueMap={0, 1, 2, 35} Values={PT_STATUS_SUCCESS, PT_STATUS_INTERNAL_ERROR, PT_STATUS_NOT_READY, PT_STATUS_INVALID_INDEX}
 	}
 
+	// RecordsPage describes where a ReadRecords response is in the log.
+	RecordsPage struct {
+		StartIndex       int  // Index of the first record returned
+		NextIndex        int  // Index to pass to ReadRecords to read the following records
+		TotalRecordCount int  // Total number of records in the log
+		HasMore          bool // Whether records follow the ones returned
+	}
+
+	// AuditLockState is the state of the audit lock, derived from AuditState.
+	AuditLockState struct {
+		Locked             bool // Whether an auditor holds the audit lock
+		UnprovisioningLock bool // Whether the lock was taken with AuditLockFlagUnprovisioningLock
+	}
+
+	ClearLog_OUTPUT struct {
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+
+	SetAuditLock_OUTPUT struct {
+		Handle      int         `xml:"Handle"` // Handle of the lock holder, needed to release the lock
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+
+	SetStoragePolicy_OUTPUT struct {
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+
+	SetSigningKeyMaterial_OUTPUT struct {
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+
+	ExportAuditLogSignature_OUTPUT struct {
+		TotalRecordCount     int              `xml:"TotalRecordCount"`     // Number of records covered by the signature
+		StartLogTime         Datetime         `xml:"StartLogTime"`         // Time stamp of the oldest record
+		EndLogTime           Datetime         `xml:"EndLogTime"`           // Time stamp of the newest record
+		GenerationTime       Datetime         `xml:"GenerationTime"`       // Time the signature was generated
+		UUID                 string           `xml:"UUID"`                 // Base64 encoded UUID of the platform
+		FQDN                 string           `xml:"FQDN"`                 // FQDN of the platform
+		SignatureMechanism   SigningMechanism `xml:"SignatureMechanism"`   // Signing mechanism used
+		Signature            string           `xml:"Signature"`            // Base64 encoded signature
+		LengthOfCertificates []int            `xml:"LengthOfCertificates"` // Length of each certificate in Certificates, leaf first
+		Certificates         string           `xml:"Certificates"`         // Base64 encoded concatenation of the DER certificates of the signing key chain
+		ReturnValue          ReturnValue      `xml:"ReturnValue"`
+	}
+
 	AuditLogRecord struct {
 		AuditAppID     int       `json:"AuditAppId" binding:"required" example:"0"`
 		EventID        int       `json:"EventId" binding:"required" example:"0"`
@@ -99,6 +184,27 @@ type (
 	// RequestedState is an integer enumeration that indicates the last requested or desired state for the element, irrespective of the mechanism through which it was requested.
 	RequestedState int
 
+	// AuditLockFlag is an integer enumeration that indicates whether SetAuditLock takes or releases the audit lock.
+	AuditLockFlag int
+
+	// SigningMechanism is an integer enumeration that indicates how the audit log is signed.
+	SigningMechanism int
+
+	// ReturnValue is an integer enumeration that indicates the success or failure of an operation.
+	ReturnValue int
+
+	// AppID identifies the application, i.e. the feature, that records an audit event.
+	AppID int
+
+	// EventID identifies an audit event within its application.
+	EventID int
+
+	// Event identifies an auditable event.
+	Event struct {
+		AppID   AppID
+		EventID EventID
+	}
+
 	ProvisioningParameters struct {
 		ProvisioningMethod        uint8
 		HashType                  uint8
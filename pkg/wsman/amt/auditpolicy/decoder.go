@@ -0,0 +1,65 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package auditpolicy
+
+import "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
+
+const ValueNotFound string = "Value not found in map"
+
+// INPUTS Constants.
+const (
+	AMTAuditPolicyRule string = "AMT_AuditPolicyRule"
+	SetAuditPolicy     string = "SetAuditPolicy"
+	SetAuditPolicyBulk string = "SetAuditPolicyBulk"
+)
+
+const (
+	PolicyTypeAlert PolicyType = iota
+	PolicyTypeCritical
+	PolicyTypeNone
+)
+
+// policyTypeToString is a map of PolicyType values to their string representations.
+var policyTypeToString = map[PolicyType]string{
+	PolicyTypeAlert:    "Alert",
+	PolicyTypeCritical: "Critical",
+	PolicyTypeNone:     "None",
+}
+
+// String returns a string representation of a PolicyType.
+func (p PolicyType) String() string {
+	if value, exists := policyTypeToString[p]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+// eventIDBits is the number of low bits of an AuditApplicationEventID that hold the event ID.
+const eventIDBits = 16
+
+// Policies pairs the audited events of the rule with their policy types.
+func (r AuditPolicyRule) Policies() []Policy {
+	policies := make([]Policy, 0, len(r.AuditApplicationEventID))
+
+	for i, id := range r.AuditApplicationEventID {
+		policy := Policy{
+			Event: auditlog.Event{
+				AppID:   auditlog.AppID(id >> eventIDBits),
+				EventID: auditlog.EventID(id & (1<<eventIDBits - 1)),
+			},
+			PolicyType: PolicyTypeNone,
+		}
+
+		if i < len(r.PolicyType) {
+			policy.PolicyType = r.PolicyType[i]
+		}
+
+		policies = append(policies, policy)
+	}
+
+	return policies
+}
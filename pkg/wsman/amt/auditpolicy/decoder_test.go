@@ -0,0 +1,47 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package auditpolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
+)
+
+func TestPolicyType_String(t *testing.T) {
+	tests := []struct {
+		policyType PolicyType
+		expected   string
+	}{
+		{PolicyTypeAlert, "Alert"},
+		{PolicyTypeCritical, "Critical"},
+		{PolicyTypeNone, "None"},
+		{PolicyType(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.policyType.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestAuditPolicyRule_Policies(t *testing.T) {
+	rule := AuditPolicyRule{
+		AuditApplicationEventID: []uint32{uint32(auditlog.AppIDWatchdog)<<16 | 2, uint32(auditlog.AppIDSecurityAdmin)<<16 | 5},
+		PolicyType:              []PolicyType{PolicyTypeCritical},
+	}
+
+	assert.Equal(t, []Policy{
+		{Event: auditlog.Event{AppID: auditlog.AppIDWatchdog, EventID: 2}, PolicyType: PolicyTypeCritical},
+		{Event: auditlog.Event{AppID: auditlog.AppIDSecurityAdmin, EventID: 5}, PolicyType: PolicyTypeNone},
+	}, rule.Policies())
+	assert.Equal(t, "Watchdog Action Pairing Changed", rule.Policies()[0].Event.String())
+	assert.Empty(t, AuditPolicyRule{}.Policies())
+}
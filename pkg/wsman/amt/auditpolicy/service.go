@@ -0,0 +1,99 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package auditpolicy facilitates communication with Intel® AMT devices to choose which events are recorded in the audit log.
+//
+// Events are identified by the application and event IDs of the auditlog package, e.g. auditlog.LookupEvent("ACL Entry Added").
+// Changing the policy fails with auditlog.PTStatusAuditFail while another auditor holds the audit lock.
+package auditpolicy
+
+import (
+	"encoding/xml"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/methods"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/base"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+type Service struct {
+	base.WSManService[Response]
+}
+
+// NewServiceWithClient instantiates a new Audit Policy Rule service.
+func NewServiceWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) Service {
+	return Service{
+		base.NewService[Response](wsmanMessageCreator, AMTAuditPolicyRule, client),
+	}
+}
+
+// GetAuditPolicy returns the audited events and their policy types in Body.Policies.
+func (service Service) GetAuditPolicy(opts ...base.HeaderOption) (response Response, err error) {
+	response, err = service.Get(opts...)
+	if err != nil {
+		return response, err
+	}
+
+	response.Body.Policies = response.Body.GetResponse.Policies()
+
+	return response, nil
+}
+
+// SetAuditPolicy enables or disables auditing of an event.
+func (service Service) SetAuditPolicy(enable bool, event auditlog.Event, policyType PolicyType, opts ...base.HeaderOption) (response Response, err error) {
+	header := service.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuditPolicyRule, SetAuditPolicy), AMTAuditPolicyRule, nil, "", "", opts...)
+	body := service.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(SetAuditPolicy), AMTAuditPolicyRule, &SetAuditPolicy_INPUT{
+		Enable:       enable,
+		AuditedAppID: event.AppID,
+		EventID:      event.EventID,
+		PolicyType:   policyType,
+	})
+
+	return service.execute(header, body)
+}
+
+// SetAuditPolicyBulk enables or disables auditing of several events at once. The result of each setting is returned in
+// SetAuditPolicyBulk_OUTPUT.PolicyReturnValue.
+func (service Service) SetAuditPolicyBulk(settings []Setting, opts ...base.HeaderOption) (response Response, err error) {
+	input := &SetAuditPolicyBulk_INPUT{
+		Enable:       make([]bool, 0, len(settings)),
+		AuditedAppID: make([]auditlog.AppID, 0, len(settings)),
+		EventID:      make([]auditlog.EventID, 0, len(settings)),
+		PolicyType:   make([]PolicyType, 0, len(settings)),
+	}
+
+	for _, setting := range settings {
+		input.Enable = append(input.Enable, setting.Enable)
+		input.AuditedAppID = append(input.AuditedAppID, setting.Event.AppID)
+		input.EventID = append(input.EventID, setting.Event.EventID)
+		input.PolicyType = append(input.PolicyType, setting.PolicyType)
+	}
+
+	header := service.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuditPolicyRule, SetAuditPolicyBulk), AMTAuditPolicyRule, nil, "", "", opts...)
+	body := service.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(SetAuditPolicyBulk), AMTAuditPolicyRule, input)
+
+	return service.execute(header, body)
+}
+
+func (service Service) execute(header, body string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.Base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	err = service.Base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
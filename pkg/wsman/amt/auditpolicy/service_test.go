@@ -0,0 +1,264 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package auditpolicy
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+var (
+	aclEntryAdded         = auditlog.Event{AppID: auditlog.AppIDSecurityAdmin, EventID: 2}
+	auditPolicyModified   = auditlog.Event{AppID: auditlog.AppIDSecurityAuditLog, EventID: 1}
+	expectedAuditPolicies = []Policy{
+		{Event: aclEntryAdded, PolicyType: PolicyTypeAlert},
+		{Event: auditPolicyModified, PolicyType: PolicyTypeCritical},
+	}
+	expectedAuditPolicyRule = AuditPolicyRule{
+		XMLName:                 xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditPolicyRule", Local: AMTAuditPolicyRule},
+		CreationClassName:       AMTAuditPolicyRule,
+		ElementName:             "Intel(r) AMT Audit Policy Rule",
+		Name:                    "Intel(r) AMT Audit Policy Rule",
+		SystemCreationClassName: "CIM_ComputerSystem",
+		SystemName:              "Intel(r) AMT",
+		AuditApplicationEventID: []uint32{1048578, 1310721},
+		PolicyType:              []PolicyType{PolicyTypeAlert, PolicyTypeCritical},
+	}
+)
+
+func TestJson(t *testing.T) {
+	response := Response{
+		Body: Body{
+			GetResponse: AuditPolicyRule{},
+		},
+	}
+	expectedResult := "{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"GetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"CreationClassName\":\"\",\"ElementName\":\"\",\"Name\":\"\",\"SystemCreationClassName\":\"\",\"SystemName\":\"\",\"AuditApplicationEventID\":null,\"PolicyType\":null},\"EnumerateResponse\":{\"EnumerationContext\":\"\"},\"PullResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"AuditPolicyRuleItems\":null},\"Policies\":null,\"SetAuditPolicy_OUTPUT\":{\"ReturnValue\":0},\"SetAuditPolicyBulk_OUTPUT\":{\"PolicyReturnValue\":null,\"ReturnValue\":0}}"
+	result := response.JSON()
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestYaml(t *testing.T) {
+	response := Response{
+		Body: Body{
+			GetResponse: AuditPolicyRule{},
+		},
+	}
+	expectedResult := "xmlname:\n    space: \"\"\n    local: \"\"\ngetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    creationclassname: \"\"\n    elementname: \"\"\n    name: \"\"\n    systemcreationclassname: \"\"\n    systemname: \"\"\n    auditapplicationeventid: []\n    policytype: []\nenumerateresponse:\n    enumerationcontext: \"\"\npullresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    auditpolicyruleitems: []\npolicies: []\nsetauditpolicy_output:\n    returnvalue: 0\nsetauditpolicybulk_output:\n    policyreturnvalue: []\n    returnvalue: 0\n"
+	result := response.YAML()
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestPositiveAMT_AuditPolicyRule(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/auditpolicy",
+	}
+	elementUnderTest := NewServiceWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_AuditPolicyRule Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			// GETS
+			{
+				"should create a valid AMT_AuditPolicyRule Get wsman message",
+				AMTAuditPolicyRule,
+				wsmantesting.Get,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get()
+				},
+				Body{
+					XMLName:     xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					GetResponse: expectedAuditPolicyRule,
+				},
+			},
+			// ENUMERATES
+			{
+				"should create a valid AMT_AuditPolicyRule Enumerate wsman message",
+				AMTAuditPolicyRule,
+				wsmantesting.Enumerate,
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "5D000000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			// PULLS
+			{
+				"should create a valid AMT_AuditPolicyRule Pull wsman message",
+				AMTAuditPolicyRule,
+				wsmantesting.Pull,
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName:              xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/enumeration", Local: "PullResponse"},
+						AuditPolicyRuleItems: []AuditPolicyRule{expectedAuditPolicyRule},
+					},
+				},
+			},
+			// GET AUDIT POLICY
+			{
+				"should create a valid AMT_AuditPolicyRule GetAuditPolicy wsman message",
+				AMTAuditPolicyRule,
+				wsmantesting.Get,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.GetAuditPolicy()
+				},
+				Body{
+					XMLName:     xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					GetResponse: expectedAuditPolicyRule,
+					Policies:    expectedAuditPolicies,
+				},
+			},
+			// SET AUDIT POLICY
+			{
+				"should create a valid AMT_AuditPolicyRule SetAuditPolicy wsman message",
+				AMTAuditPolicyRule,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditPolicyRule/SetAuditPolicy`,
+				`<h:SetAuditPolicy_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditPolicyRule"><h:Enable>true</h:Enable><h:AuditedAppID>16</h:AuditedAppID><h:EventID>2</h:EventID><h:PolicyType>1</h:PolicyType></h:SetAuditPolicy_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = "SetAuditPolicy"
+
+					return elementUnderTest.SetAuditPolicy(true, aclEntryAdded, PolicyTypeCritical)
+				},
+				Body{
+					XMLName:               xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					SetAuditPolicy_OUTPUT: SetAuditPolicy_OUTPUT{ReturnValue: auditlog.PTStatusSuccess},
+				},
+			},
+			// SET AUDIT POLICY BULK
+			{
+				"should create a valid AMT_AuditPolicyRule SetAuditPolicyBulk wsman message",
+				AMTAuditPolicyRule,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditPolicyRule/SetAuditPolicyBulk`,
+				`<h:SetAuditPolicyBulk_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditPolicyRule"><h:Enable>true</h:Enable><h:Enable>false</h:Enable><h:AuditedAppID>16</h:AuditedAppID><h:AuditedAppID>20</h:AuditedAppID><h:EventID>2</h:EventID><h:EventID>1</h:EventID><h:PolicyType>0</h:PolicyType><h:PolicyType>2</h:PolicyType></h:SetAuditPolicyBulk_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = "SetAuditPolicyBulk"
+
+					return elementUnderTest.SetAuditPolicyBulk([]Setting{
+						{Enable: true, Event: aclEntryAdded, PolicyType: PolicyTypeAlert},
+						{Enable: false, Event: auditPolicyModified, PolicyType: PolicyTypeNone},
+					})
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					SetAuditPolicyBulk_OUTPUT: SetAuditPolicyBulk_OUTPUT{
+						PolicyReturnValue: []auditlog.ReturnValue{auditlog.PTStatusSuccess, auditlog.PTStatusSuccess},
+						ReturnValue:       auditlog.PTStatusSuccess,
+					},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, "", test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeAMT_AuditPolicyRule(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/auditpolicy",
+	}
+	elementUnderTest := NewServiceWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_AuditPolicyRule Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			{
+				"should handle error when AMT_AuditPolicyRule GetAuditPolicy fails",
+				AMTAuditPolicyRule,
+				wsmantesting.Get,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.GetAuditPolicy()
+				},
+				Body{
+					XMLName:     xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					GetResponse: expectedAuditPolicyRule,
+					Policies:    expectedAuditPolicies,
+				},
+			},
+			{
+				"should handle error when AMT_AuditPolicyRule SetAuditPolicy fails",
+				AMTAuditPolicyRule,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditPolicyRule/SetAuditPolicy`,
+				`<h:SetAuditPolicy_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditPolicyRule"><h:Enable>true</h:Enable><h:AuditedAppID>16</h:AuditedAppID><h:EventID>2</h:EventID><h:PolicyType>1</h:PolicyType></h:SetAuditPolicy_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.SetAuditPolicy(true, aclEntryAdded, PolicyTypeCritical)
+				},
+				Body{
+					XMLName:               xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					SetAuditPolicy_OUTPUT: SetAuditPolicy_OUTPUT{ReturnValue: auditlog.PTStatusSuccess},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, "", test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.NotEqual(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
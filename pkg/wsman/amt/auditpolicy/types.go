@@ -0,0 +1,95 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package auditpolicy
+
+import (
+	"encoding/xml"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+)
+
+// INPUTS
+// Request Types.
+type (
+	SetAuditPolicy_INPUT struct {
+		XMLName      xml.Name         `xml:"h:SetAuditPolicy_INPUT"`
+		H            string           `xml:"xmlns:h,attr"`
+		Enable       bool             `xml:"h:Enable"`       // Whether the event is audited
+		AuditedAppID auditlog.AppID   `xml:"h:AuditedAppID"` // Application of the event
+		EventID      auditlog.EventID `xml:"h:EventID"`      // Event within the application
+		PolicyType   PolicyType       `xml:"h:PolicyType"`   // What happens when the event cannot be recorded
+	}
+
+	SetAuditPolicyBulk_INPUT struct {
+		XMLName      xml.Name           `xml:"h:SetAuditPolicyBulk_INPUT"`
+		H            string             `xml:"xmlns:h,attr"`
+		Enable       []bool             `xml:"h:Enable"`
+		AuditedAppID []auditlog.AppID   `xml:"h:AuditedAppID"`
+		EventID      []auditlog.EventID `xml:"h:EventID"`
+		PolicyType   []PolicyType       `xml:"h:PolicyType"`
+	}
+)
+
+// OUTPUTS
+// Response Types.
+type (
+	Response struct {
+		*client.Message
+		XMLName xml.Name       `xml:"Envelope"`
+		Header  message.Header `xml:"Header"`
+		Body    Body           `xml:"Body"`
+	}
+	Body struct {
+		XMLName                   xml.Name `xml:"Body"`
+		GetResponse               AuditPolicyRule
+		EnumerateResponse         common.EnumerateResponse
+		PullResponse              PullResponse
+		Policies                  []Policy
+		SetAuditPolicy_OUTPUT     SetAuditPolicy_OUTPUT     `xml:"SetAuditPolicy_OUTPUT"`
+		SetAuditPolicyBulk_OUTPUT SetAuditPolicyBulk_OUTPUT `xml:"SetAuditPolicyBulk_OUTPUT"`
+	}
+	AuditPolicyRule struct {
+		XMLName                 xml.Name     `xml:"AMT_AuditPolicyRule"`
+		CreationClassName       string       `xml:"CreationClassName"`       // CreationClassName indicates the name of the class or the subclass used in the creation of an instance.
+		ElementName             string       `xml:"ElementName"`             // A user-friendly name for the object.
+		Name                    string       `xml:"Name"`                    // The name of the policy rule.
+		SystemCreationClassName string       `xml:"SystemCreationClassName"` // The CreationClassName of the scoping System.
+		SystemName              string       `xml:"SystemName"`              // The Name of the scoping System.
+		AuditApplicationEventID []uint32     `xml:"AuditApplicationEventID"` // Audited events, each the application ID in the high 16 bits and the event ID in the low 16 bits.
+		PolicyType              []PolicyType `xml:"PolicyType"`              // Policy type of each event in AuditApplicationEventID.
+	}
+	PullResponse struct {
+		XMLName              xml.Name          `xml:"PullResponse"`
+		AuditPolicyRuleItems []AuditPolicyRule `xml:"Items>AMT_AuditPolicyRule"`
+	}
+	SetAuditPolicy_OUTPUT struct {
+		ReturnValue auditlog.ReturnValue `xml:"ReturnValue"`
+	}
+	SetAuditPolicyBulk_OUTPUT struct {
+		PolicyReturnValue []auditlog.ReturnValue `xml:"PolicyReturnValue"` // Result of each policy, in the order they were set.
+		ReturnValue       auditlog.ReturnValue   `xml:"ReturnValue"`
+	}
+
+	// Policy is the audit policy of a single event.
+	Policy struct {
+		Event      auditlog.Event
+		PolicyType PolicyType
+	}
+
+	// Setting enables or disables auditing of a single event, for SetAuditPolicyBulk.
+	Setting struct {
+		Enable     bool
+		Event      auditlog.Event
+		PolicyType PolicyType
+	}
+
+	// PolicyType is an integer enumeration that indicates what happens when an audited event cannot be recorded.
+	// Critical events are blocked when the log is full or locked; alert events are not.
+	PolicyType int
+)
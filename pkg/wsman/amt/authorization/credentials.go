@@ -0,0 +1,154 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package authorization
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/common"
+)
+
+const (
+	maxDigestUsernameLength = 16
+	maxKerberosSidLength    = 28
+	sidHeaderLength         = 8
+)
+
+var (
+	ErrInvalidDigestUsername = errors.New("digest username must be 1 to 16 7-bit ASCII characters")
+	ErrInvalidKerberosSid    = errors.New("invalid Kerberos SID")
+	ErrInvalidUserACLEntry   = errors.New("user ACL entry must have either digest credentials or a Kerberos SID")
+)
+
+// DigestPassword returns the DigestPassword of an ACL entry: the base64 encoded MD5 hash of
+// username + ":" + digestRealm + ":" + password. The digest realm is the DigestRealm of AMT_GeneralSettings.
+func DigestPassword(username, digestRealm, password string) string {
+	hash := md5.Sum([]byte(username + ":" + digestRealm + ":" + password))
+
+	return base64.StdEncoding.EncodeToString(hash[:])
+}
+
+// EncodeKerberosSid converts a SID in string form, e.g. "S-1-5-21-1004336348-1177238915-682003330-512", to the base64
+// encoded binary form used by KerberosUserSid.
+func EncodeKerberosSid(sid string) (string, error) {
+	parts := strings.Split(sid, "-")
+	if len(parts) < 3 || !strings.EqualFold(parts[0], "S") {
+		return "", fmt.Errorf("%w: %q", ErrInvalidKerberosSid, sid)
+	}
+
+	revision, err := strconv.ParseUint(parts[1], 10, 8)
+	if err != nil {
+		return "", fmt.Errorf("%w: revision: %w", ErrInvalidKerberosSid, err)
+	}
+
+	authority, err := strconv.ParseUint(parts[2], 10, 48)
+	if err != nil {
+		return "", fmt.Errorf("%w: identifier authority: %w", ErrInvalidKerberosSid, err)
+	}
+
+	subAuthorities := parts[3:]
+	if sidHeaderLength+4*len(subAuthorities) > maxKerberosSidLength {
+		return "", fmt.Errorf("%w: more than %d bytes", ErrInvalidKerberosSid, maxKerberosSidLength)
+	}
+
+	encoded := make([]byte, sidHeaderLength, sidHeaderLength+4*len(subAuthorities))
+	encoded[0] = byte(revision)
+	encoded[1] = byte(len(subAuthorities))
+
+	// the identifier authority is a 48-bit big endian value
+	for i := range 6 {
+		encoded[7-i] = byte(authority >> (8 * i))
+	}
+
+	for _, part := range subAuthorities {
+		subAuthority, err := strconv.ParseUint(part, 10, 32)
+		if err != nil {
+			return "", fmt.Errorf("%w: sub-authority: %w", ErrInvalidKerberosSid, err)
+		}
+
+		encoded = binary.LittleEndian.AppendUint32(encoded, uint32(subAuthority))
+	}
+
+	return base64.StdEncoding.EncodeToString(encoded), nil
+}
+
+// DecodeKerberosSid converts a base64 encoded binary SID, as returned in KerberosUserSid, to its string form.
+func DecodeKerberosSid(encoded string) (string, error) {
+	sid, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidKerberosSid, err)
+	}
+
+	if len(sid) < sidHeaderLength || len(sid)%4 != 0 || len(sid) > maxKerberosSidLength {
+		return "", fmt.Errorf("%w: length of %d bytes", ErrInvalidKerberosSid, len(sid))
+	}
+
+	return common.GetSidString(string(sid)), nil
+}
+
+// NewDigestUserACLEntry returns an ACL entry for a digest user. The password is hashed with the digest realm of the
+// device, which is the DigestRealm of AMT_GeneralSettings.
+func NewDigestUserACLEntry(username, password, digestRealm string, accessPermission AccessPermission, realms RealmBitmap) (UserACLEntry, error) {
+	if err := validateDigestUsername(username); err != nil {
+		return UserACLEntry{}, err
+	}
+
+	return UserACLEntry{
+		DigestUsername:   username,
+		DigestPassword:   DigestPassword(username, digestRealm, password),
+		AccessPermission: accessPermission,
+		Realms:           realms,
+	}, nil
+}
+
+// NewKerberosUserACLEntry returns an ACL entry for the Kerberos user or group with the given SID, in string form.
+func NewKerberosUserACLEntry(sid string, accessPermission AccessPermission, realms RealmBitmap) (UserACLEntry, error) {
+	encoded, err := EncodeKerberosSid(sid)
+	if err != nil {
+		return UserACLEntry{}, err
+	}
+
+	return UserACLEntry{
+		KerberosUserSid:  encoded,
+		AccessPermission: accessPermission,
+		Realms:           realms,
+	}, nil
+}
+
+func (e UserACLEntry) validate() error {
+	digest := e.DigestUsername != "" || e.DigestPassword != ""
+	kerberos := e.KerberosUserSid != ""
+
+	if digest == kerberos {
+		return ErrInvalidUserACLEntry
+	}
+
+	if digest {
+		return validateDigestUsername(e.DigestUsername)
+	}
+
+	return nil
+}
+
+func validateDigestUsername(username string) error {
+	if username == "" || len(username) > maxDigestUsernameLength {
+		return ErrInvalidDigestUsername
+	}
+
+	for i := range len(username) {
+		if username[i] > 0x7F {
+			return ErrInvalidDigestUsername
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package authorization
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDigestPassword(t *testing.T) {
+	assert.Equal(t, "qJe69MoLt++ODtv4W8xA2w==", DigestPassword("operator", "Digest:A3829B3827DE4D33D4449B366831FD01", "P@ssw0rd"))
+}
+
+func TestKerberosSid(t *testing.T) {
+	tests := []struct {
+		name    string
+		sid     string
+		encoded string
+	}{
+		{"domain user", "S-1-5-21-1004336348-1177238915-682003330-512", "AQUAAAAAAAUVAAAA3PTcO4M9K0aCi6YoAAIAAA=="},
+		{"well known group", "S-1-5-32-544", "AQIAAAAAAAUgAAAAIAIAAA=="},
+		{"no sub-authorities", "S-1-5", "AQAAAAAAAAU="},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			encoded, err := EncodeKerberosSid(test.sid)
+			require.NoError(t, err)
+			assert.Equal(t, test.encoded, encoded)
+
+			decoded, err := DecodeKerberosSid(encoded)
+			require.NoError(t, err)
+			assert.Equal(t, test.sid, decoded)
+		})
+	}
+}
+
+func TestKerberosSidErrors(t *testing.T) {
+	for _, sid := range []string{
+		"",
+		"X-1-5-21",
+		"S-1",
+		"S-256-5",
+		"S-1-281474976710656",
+		"S-1-5-4294967296",
+		"S-1-5-21-1-2-3-4-5",
+	} {
+		_, err := EncodeKerberosSid(sid)
+		assert.ErrorIs(t, err, ErrInvalidKerberosSid, sid)
+	}
+
+	for _, encoded := range []string{"not base64!", "AQUAAA==", "AQUAAAAAAAUVAAAA3PTcO4M9K0aCi6YoAAIAAAAAAAAA"} {
+		_, err := DecodeKerberosSid(encoded)
+		assert.ErrorIs(t, err, ErrInvalidKerberosSid, encoded)
+	}
+}
@@ -29,6 +29,22 @@ const (
 	AccessPermissionLocalAndNetworkAccess
 )
 
+// accessPermissionToString is a map of AccessPermission values to their string representations.
+var accessPermissionToString = map[AccessPermission]string{
+	AccessPermissionLocalAccessOnly:       "LocalAccessOnly",
+	AccessPermissionNetworkAccessOnly:     "NetworkAccessOnly",
+	AccessPermissionLocalAndNetworkAccess: "LocalAndNetworkAccess",
+}
+
+// String returns the string representation of an AccessPermission value.
+func (a AccessPermission) String() string {
+	if value, exists := accessPermissionToString[a]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
 const (
 	RealmValuesInvalidRealm RealmValues = iota
 	RealmValuesReservedRealm0
@@ -57,6 +73,44 @@ const (
 	RealmValuesLocalSystemRealm
 )
 
+// realmValuesToString is a map of RealmValues values to their string representations.
+var realmValuesToString = map[RealmValues]string{
+	RealmValuesInvalidRealm:                    "InvalidRealm",
+	RealmValuesReservedRealm0:                  "ReservedRealm0",
+	RealmValuesRedirectionRealm:                "RedirectionRealm",
+	RealmValuesPTAdministrationRealm:           "PTAdministrationRealm",
+	RealmValuesHardwareAssetRealm:              "HardwareAssetRealm",
+	RealmValuesRemoteControlRealm:              "RemoteControlRealm",
+	RealmValuesStorageRealm:                    "StorageRealm",
+	RealmValuesEventManagerRealm:               "EventManagerRealm",
+	RealmValuesStorageAdminRealm:               "StorageAdminRealm",
+	RealmValuesAgentPresenceLocalRealm:         "AgentPresenceLocalRealm",
+	RealmValuesAgentPresenceRemoteRealm:        "AgentPresenceRemoteRealm",
+	RealmValuesCircuitBreakerRealm:             "CircuitBreakerRealm",
+	RealmValuesNetworkTimeRealm:                "NetworkTimeRealm",
+	RealmValuesGeneralInfoRealm:                "GeneralInfoRealm",
+	RealmValuesFirmwareUpdateRealm:             "FirmwareUpdateRealm",
+	RealmValuesEITRealm:                        "EITRealm",
+	RealmValuesLocalUN:                         "LocalUN",
+	RealmValuesEndpointAccessControlRealm:      "EndpointAccessControlRealm",
+	RealmValuesEndpointAccessControlAdminRealm: "EndpointAccessControlAdminRealm",
+	RealmValuesEventLogReaderRealm:             "EventLogReaderRealm",
+	RealmValuesAuditLogRealm:                   "AuditLogRealm",
+	RealmValuesACLRealm:                        "ACLRealm",
+	RealmValuesReservedRealm1:                  "ReservedRealm1",
+	RealmValuesReservedRealm2:                  "ReservedRealm2",
+	RealmValuesLocalSystemRealm:                "LocalSystemRealm",
+}
+
+// String returns the string representation of a RealmValues value.
+func (r RealmValues) String() string {
+	if value, exists := realmValuesToString[r]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
 const (
 	EnabledStateUnknown EnabledState = iota
 	EnabledStateOther
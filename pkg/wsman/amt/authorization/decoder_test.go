@@ -89,3 +89,42 @@ func TestReturnValue_String(t *testing.T) {
 		}
 	}
 }
+
+func TestAccessPermission_String(t *testing.T) {
+	tests := []struct {
+		state    AccessPermission
+		expected string
+	}{
+		{AccessPermissionLocalAccessOnly, "LocalAccessOnly"},
+		{AccessPermissionNetworkAccessOnly, "NetworkAccessOnly"},
+		{AccessPermissionLocalAndNetworkAccess, "LocalAndNetworkAccess"},
+		{AccessPermission(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestRealmValues_String(t *testing.T) {
+	tests := []struct {
+		state    RealmValues
+		expected string
+	}{
+		{RealmValuesRedirectionRealm, "RedirectionRealm"},
+		{RealmValuesPTAdministrationRealm, "PTAdministrationRealm"},
+		{RealmValuesAuditLogRealm, "AuditLogRealm"},
+		{RealmValuesLocalSystemRealm, "LocalSystemRealm"},
+		{RealmValues(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
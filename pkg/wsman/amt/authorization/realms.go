@@ -0,0 +1,57 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package authorization
+
+// realmBitmapSize is the number of realms a RealmBitmap can hold.
+const realmBitmapSize = 32
+
+// NewRealmBitmap returns the bitmap holding the given realms. Realms outside of the bitmap are ignored.
+func NewRealmBitmap(realms ...RealmValues) RealmBitmap {
+	var bitmap RealmBitmap
+
+	for _, realm := range realms {
+		if realm >= 0 && realm < realmBitmapSize {
+			bitmap |= 1 << realm
+		}
+	}
+
+	return bitmap
+}
+
+// Has reports whether realm is in the bitmap.
+func (b RealmBitmap) Has(realm RealmValues) bool {
+	return realm >= 0 && realm < realmBitmapSize && b&(1<<realm) != 0
+}
+
+// Realms returns the realms in the bitmap in ascending order.
+func (b RealmBitmap) Realms() []RealmValues {
+	realms := []RealmValues{}
+
+	for realm := RealmValues(0); realm < realmBitmapSize; realm++ {
+		if b.Has(realm) {
+			realms = append(realms, realm)
+		}
+	}
+
+	return realms
+}
+
+// Names returns the names of the realms in the bitmap in ascending order.
+func (b RealmBitmap) Names() []string {
+	realms := b.Realms()
+	names := make([]string, 0, len(realms))
+
+	for _, realm := range realms {
+		names = append(names, realm.String())
+	}
+
+	return names
+}
+
+// RealmsBitmap returns the realms of the ACL entry as a bitmap.
+func (o GetUserAclEntryEx_OUTPUT) RealmsBitmap() RealmBitmap {
+	return NewRealmBitmap(o.Realms...)
+}
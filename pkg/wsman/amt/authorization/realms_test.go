@@ -0,0 +1,30 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package authorization
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRealmBitmap(t *testing.T) {
+	bitmap := NewRealmBitmap(RealmValuesRemoteControlRealm, RealmValuesRedirectionRealm, RealmValuesRedirectionRealm, RealmValues(-1), RealmValues(32))
+
+	assert.Equal(t, RealmBitmap(0x24), bitmap)
+	assert.True(t, bitmap.Has(RealmValuesRedirectionRealm))
+	assert.False(t, bitmap.Has(RealmValuesStorageRealm))
+	assert.False(t, bitmap.Has(RealmValues(40)))
+	assert.Equal(t, []RealmValues{RealmValuesRedirectionRealm, RealmValuesRemoteControlRealm}, bitmap.Realms())
+	assert.Equal(t, []string{"RedirectionRealm", "RemoteControlRealm"}, bitmap.Names())
+	assert.Empty(t, RealmBitmap(0).Realms())
+}
+
+func TestGetUserAclEntryEx_OUTPUT_RealmsBitmap(t *testing.T) {
+	output := GetUserAclEntryEx_OUTPUT{Realms: []RealmValues{RealmValuesLocalUN, RealmValuesGeneralInfoRealm}}
+
+	assert.Equal(t, []string{"GeneralInfoRealm", "LocalUN"}, output.RealmsBitmap().Names())
+}
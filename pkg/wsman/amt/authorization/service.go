@@ -35,12 +35,12 @@ func NewServiceWithClient(wsmanMessageCreator *message.WSManMessageCreator, clie
 }
 
 // EnumerateUserACLEntries enumerates entries in the User Access Control List (ACL).
-func (as Service) EnumerateUserACLEntries(startIndex int) (response Response, err error) {
+func (as Service) EnumerateUserACLEntries(startIndex int, opts ...base.HeaderOption) (response Response, err error) {
 	if startIndex == 0 {
 		startIndex = 1
 	}
 
-	header := as.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuthorizationService, EnumerateUserACLEntries), AMTAuthorizationService, nil, "", "")
+	header := as.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuthorizationService, EnumerateUserACLEntries), AMTAuthorizationService, nil, "", "", opts...)
 	body := as.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(EnumerateUserACLEntries), AMTAuthorizationService, &EnumerateUserAclEntries_INPUT{StartIndex: startIndex})
 
 	response = Response{
@@ -64,8 +64,8 @@ func (as Service) EnumerateUserACLEntries(startIndex int) (response Response, er
 }
 
 // Gets the state of a user ACL entry (enabled/disabled).
-func (as Service) GetACLEnabledState(handle int) (response Response, err error) {
-	header := as.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuthorizationService, GetACLEnabledState), AMTAuthorizationService, nil, "", "")
+func (as Service) GetACLEnabledState(handle int, opts ...base.HeaderOption) (response Response, err error) {
+	header := as.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuthorizationService, GetACLEnabledState), AMTAuthorizationService, nil, "", "", opts...)
 	body := as.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(GetACLEnabledState), AMTAuthorizationService, &GetAclEnabledState_INPUT{Handle: handle})
 
 	response = Response{
@@ -89,8 +89,8 @@ func (as Service) GetACLEnabledState(handle int) (response Response, err error)
 }
 
 // Returns the username attribute of the Admin ACL.
-func (as Service) GetAdminACLEntry() (response Response, err error) {
-	header := as.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuthorizationService, GetAdminACLEntry), AMTAuthorizationService, nil, "", "")
+func (as Service) GetAdminACLEntry(opts ...base.HeaderOption) (response Response, err error) {
+	header := as.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuthorizationService, GetAdminACLEntry), AMTAuthorizationService, nil, "", "", opts...)
 	body := as.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(GetAdminACLEntry), AMTAuthorizationService, nil)
 
 	response = Response{
@@ -114,8 +114,8 @@ func (as Service) GetAdminACLEntry() (response Response, err error) {
 }
 
 // Reads the Admin ACL Entry status from Intel® AMT. The return state changes as a function of the admin password.
-func (as Service) GetAdminACLEntryStatus() (response Response, err error) {
-	header := as.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuthorizationService, GetAdminACLEntryStatus), AMTAuthorizationService, nil, "", "")
+func (as Service) GetAdminACLEntryStatus(opts ...base.HeaderOption) (response Response, err error) {
+	header := as.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuthorizationService, GetAdminACLEntryStatus), AMTAuthorizationService, nil, "", "", opts...)
 	body := as.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(GetAdminACLEntryStatus), AMTAuthorizationService, nil)
 
 	response = Response{
@@ -139,8 +139,8 @@ func (as Service) GetAdminACLEntryStatus() (response Response, err error) {
 }
 
 // Reads the remote Admin ACL Entry status from Intel® AMT. The return state changes as a function of the remote admin password.
-func (as Service) GetAdminNetACLEntryStatus() (response Response, err error) {
-	header := as.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuthorizationService, GetAdminNetACLEntryStatus), AMTAuthorizationService, nil, "", "")
+func (as Service) GetAdminNetACLEntryStatus(opts ...base.HeaderOption) (response Response, err error) {
+	header := as.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuthorizationService, GetAdminNetACLEntryStatus), AMTAuthorizationService, nil, "", "", opts...)
 	body := as.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(GetAdminNetACLEntryStatus), AMTAuthorizationService, nil)
 
 	response = Response{
@@ -164,8 +164,8 @@ func (as Service) GetAdminNetACLEntryStatus() (response Response, err error) {
 }
 
 // Reads a user entry from the Intel® AMT device. Note: confidential information, such as password (hash) is omitted or zeroed in the response.
-func (as Service) GetUserACLEntryEx(handle int) (response Response, err error) {
-	header := as.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuthorizationService, GetUserACLEntryEx), AMTAuthorizationService, nil, "", "")
+func (as Service) GetUserACLEntryEx(handle int, opts ...base.HeaderOption) (response Response, err error) {
+	header := as.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuthorizationService, GetUserACLEntryEx), AMTAuthorizationService, nil, "", "", opts...)
 	body := as.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(GetUserACLEntryEx), AMTAuthorizationService, &GetUserAclEntryEx_INPUT{Handle: handle})
 
 	response = Response{
@@ -189,8 +189,8 @@ func (as Service) GetUserACLEntryEx(handle int) (response Response, err error) {
 }
 
 // Removes an entry from the User Access Control List (ACL), given a handle.
-func (as Service) RemoveUserACLEntry(handle int) (response Response, err error) {
-	header := as.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuthorizationService, RemoveUserACLEntry), AMTAuthorizationService, nil, "", "")
+func (as Service) RemoveUserACLEntry(handle int, opts ...base.HeaderOption) (response Response, err error) {
+	header := as.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuthorizationService, RemoveUserACLEntry), AMTAuthorizationService, nil, "", "", opts...)
 	body := as.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(RemoveUserACLEntry), AMTAuthorizationService, &RemoveUserAclEntry_INPUT{Handle: handle})
 
 	response = Response{
@@ -214,8 +214,8 @@ func (as Service) RemoveUserACLEntry(handle int) (response Response, err error)
 }
 
 // Enables or disables a user ACL entry. Disabling ACL entries is useful when accounts that cannot be removed (system accounts - starting with $$) are required to be disabled.
-func (as Service) SetACLEnabledState(handle int, enabled bool) (response Response, err error) {
-	header := as.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuthorizationService, SetACLEnabledState), AMTAuthorizationService, nil, "", "")
+func (as Service) SetACLEnabledState(handle int, enabled bool, opts ...base.HeaderOption) (response Response, err error) {
+	header := as.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuthorizationService, SetACLEnabledState), AMTAuthorizationService, nil, "", "", opts...)
 	body := as.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(SetACLEnabledState), AMTAuthorizationService, &SetAclEnabledState_INPUT{Handle: handle, Enabled: enabled})
 
 	response = Response{
@@ -239,8 +239,8 @@ func (as Service) SetACLEnabledState(handle int, enabled bool) (response Respons
 }
 
 // Updates an Admin entry in the Intel® AMT device.
-func (as Service) SetAdminAclEntryEx(username, digestPassword string) (response Response, err error) {
-	header := as.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuthorizationService, SetAdminACLEntryEx), AMTAuthorizationService, nil, "", "")
+func (as Service) SetAdminAclEntryEx(username, digestPassword string, opts ...base.HeaderOption) (response Response, err error) {
+	header := as.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuthorizationService, SetAdminACLEntryEx), AMTAuthorizationService, nil, "", "", opts...)
 	body := as.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(SetAdminACLEntryEx), AMTAuthorizationService, &SetAdminAclEntryEx_INPUT{Username: username, DigestPassword: digestPassword})
 
 	response = Response{
@@ -262,3 +262,72 @@ func (as Service) SetAdminAclEntryEx(username, digestPassword string) (response
 
 	return response, err
 }
+
+// Adds a digest or Kerberos user entry to the User Access Control List (ACL). The handle of the new entry is returned in AddUserAclEntryEx_OUTPUT.
+func (as Service) AddUserACLEntryEx(entry UserACLEntry, opts ...base.HeaderOption) (response Response, err error) {
+	if err := entry.validate(); err != nil {
+		return response, err
+	}
+
+	header := as.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuthorizationService, AddUserACLEntryEx), AMTAuthorizationService, nil, "", "", opts...)
+	body := as.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(AddUserACLEntryEx), AMTAuthorizationService, &AddUserAclEntry{
+		DigestUsername:   entry.DigestUsername,
+		DigestPassword:   entry.DigestPassword,
+		KerberosUserSid:  entry.KerberosUserSid,
+		AccessPermission: entry.AccessPermission,
+		Realms:           entry.Realms.Realms(),
+	})
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: as.Base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	err = as.Base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, err
+}
+
+// Updates the credentials, access permission and realms of a user ACL entry, given a handle.
+func (as Service) UpdateUserACLEntryEx(handle int, entry UserACLEntry, opts ...base.HeaderOption) (response Response, err error) {
+	if err := entry.validate(); err != nil {
+		return response, err
+	}
+
+	header := as.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuthorizationService, UpdateUserACLEntryEx), AMTAuthorizationService, nil, "", "", opts...)
+	body := as.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(UpdateUserACLEntryEx), AMTAuthorizationService, &UpdateUserAclEntry{
+		Handle:           handle,
+		DigestUsername:   entry.DigestUsername,
+		DigestPassword:   entry.DigestPassword,
+		KerberosUserSid:  entry.KerberosUserSid,
+		AccessPermission: entry.AccessPermission,
+		Realms:           entry.Realms.Realms(),
+	})
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: as.Base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	err = as.Base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, err
+}
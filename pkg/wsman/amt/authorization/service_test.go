@@ -22,7 +22,7 @@ func TestJson(t *testing.T) {
 			GetResponse: AuthorizationOccurrence{},
 		},
 	}
-	expectedResult := "{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"GetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"AllowHttpQopAuthOnly\":0,\"CreationClassName\":\"\",\"ElementName\":\"\",\"EnabledState\":0,\"Name\":\"\",\"RequestedState\":0,\"SystemCreationClassName\":\"\",\"SystemName\":\"\"},\"EnumerateResponse\":{\"EnumerationContext\":\"\"},\"PullResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"AuthorizationOccurrenceItems\":null},\"SetAdminResponse\":{\"ReturnValue\":0}}"
+	expectedResult := "{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"GetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"AllowHttpQopAuthOnly\":0,\"CreationClassName\":\"\",\"ElementName\":\"\",\"EnabledState\":0,\"Name\":\"\",\"RequestedState\":0,\"SystemCreationClassName\":\"\",\"SystemName\":\"\"},\"EnumerateResponse\":{\"EnumerationContext\":\"\"},\"PullResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"AuthorizationOccurrenceItems\":null},\"SetAdminResponse\":{\"ReturnValue\":0},\"EnumerateUserAclEntries_OUTPUT\":{\"TotalCount\":0,\"HandlesCount\":0,\"Handles\":null,\"ReturnValue\":0},\"GetUserAclEntryEx_OUTPUT\":{\"DigestUsername\":\"\",\"KerberosUserSid\":\"\",\"AccessPermission\":0,\"Realms\":null,\"ReturnValue\":0},\"AddUserAclEntryEx_OUTPUT\":{\"Handle\":0,\"ReturnValue\":0},\"UpdateUserAclEntryEx_OUTPUT\":{\"ReturnValue\":0}}"
 	result := response.JSON()
 	assert.Equal(t, expectedResult, result)
 }
@@ -33,7 +33,7 @@ func TestYaml(t *testing.T) {
 			GetResponse: AuthorizationOccurrence{},
 		},
 	}
-	expectedResult := "xmlname:\n    space: \"\"\n    local: \"\"\ngetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    allowhttpqopauthonly: 0\n    creationclassname: \"\"\n    elementname: \"\"\n    enabledstate: 0\n    name: \"\"\n    requestedstate: 0\n    systemcreationclassname: \"\"\n    systemname: \"\"\nenumerateresponse:\n    enumerationcontext: \"\"\npullresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    authorizationoccurrenceitems: []\nsetadminresponse:\n    returnvalue: 0\n"
+	expectedResult := "xmlname:\n    space: \"\"\n    local: \"\"\ngetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    allowhttpqopauthonly: 0\n    creationclassname: \"\"\n    elementname: \"\"\n    enabledstate: 0\n    name: \"\"\n    requestedstate: 0\n    systemcreationclassname: \"\"\n    systemname: \"\"\nenumerateresponse:\n    enumerationcontext: \"\"\npullresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    authorizationoccurrenceitems: []\nsetadminresponse:\n    returnvalue: 0\nenumerateuseraclentries_output:\n    totalcount: 0\n    handlescount: 0\n    handles: []\n    returnvalue: 0\ngetuseraclentryex_output:\n    digestusername: \"\"\n    kerberosusersid: \"\"\n    accesspermission: 0\n    realms: []\n    returnvalue: 0\nadduseraclentryex_output:\n    handle: 0\n    returnvalue: 0\nupdateuseraclentryex_output:\n    returnvalue: 0\n"
 	result := response.YAML()
 	assert.Equal(t, expectedResult, result)
 }
@@ -209,6 +209,114 @@ func TestPositiveAMT_AuthorizationService(t *testing.T) {
 					},
 				},
 			},
+			{
+				"should return a valid amt_AuthorizationService EnumerateUserAclEntries wsman message",
+				AMTAuthorizationService,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService/EnumerateUserAclEntries`,
+				`<h:EnumerateUserAclEntries_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService"><h:StartIndex>1</h:StartIndex></h:EnumerateUserAclEntries_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = "EnumerateUserAclEntries"
+
+					return elementUnderTest.EnumerateUserACLEntries(0)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateUserAclEntries_OUTPUT: EnumerateUserAclEntries_OUTPUT{
+						TotalCount:   2,
+						HandlesCount: 2,
+						Handles:      []int{1, 2},
+						ReturnValue:  PTStatusSuccess,
+					},
+				},
+			},
+			{
+				"should return a valid amt_AuthorizationService GetUserAclEntryEx wsman message",
+				AMTAuthorizationService,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService/GetUserAclEntryEx`,
+				`<h:GetUserAclEntryEx_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService"><h:Handle>1</h:Handle></h:GetUserAclEntryEx_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = "GetUserAclEntryEx"
+
+					return elementUnderTest.GetUserACLEntryEx(1)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					GetUserAclEntryEx_OUTPUT: GetUserAclEntryEx_OUTPUT{
+						DigestUsername:   "$$uns",
+						AccessPermission: AccessPermissionLocalAccessOnly,
+						Realms:           []RealmValues{RealmValuesLocalUN},
+						ReturnValue:      PTStatusSuccess,
+					},
+				},
+			},
+			{
+				"should return a valid amt_AuthorizationService AddUserAclEntryEx wsman message using digest",
+				AMTAuthorizationService,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService/AddUserAclEntryEx`,
+				`<h:AddUserAclEntryEx_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService"><h:DigestUsername>operator</h:DigestUsername><h:DigestPassword>qJe69MoLt++ODtv4W8xA2w==</h:DigestPassword><h:AccessPermission>1</h:AccessPermission><h:Realms>2</h:Realms><h:Realms>5</h:Realms></h:AddUserAclEntryEx_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = "AddUserAclEntryEx"
+
+					entry, err := NewDigestUserACLEntry("operator", "P@ssw0rd", "Digest:A3829B3827DE4D33D4449B366831FD01", AccessPermissionNetworkAccessOnly, NewRealmBitmap(RealmValuesRedirectionRealm, RealmValuesRemoteControlRealm))
+					if err != nil {
+						return Response{}, err
+					}
+
+					return elementUnderTest.AddUserACLEntryEx(entry)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					AddUserAclEntryEx_OUTPUT: AddUserAclEntryEx_OUTPUT{
+						Handle:      3,
+						ReturnValue: PTStatusSuccess,
+					},
+				},
+			},
+			{
+				"should return a valid amt_AuthorizationService AddUserAclEntryEx wsman message using kerberos",
+				AMTAuthorizationService,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService/AddUserAclEntryEx`,
+				`<h:AddUserAclEntryEx_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService"><h:KerberosUserSid>AQUAAAAAAAUVAAAA3PTcO4M9K0aCi6YoAAIAAA==</h:KerberosUserSid><h:AccessPermission>2</h:AccessPermission><h:Realms>13</h:Realms></h:AddUserAclEntryEx_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = "AddUserAclEntryEx"
+
+					entry, err := NewKerberosUserACLEntry("S-1-5-21-1004336348-1177238915-682003330-512", AccessPermissionLocalAndNetworkAccess, NewRealmBitmap(RealmValuesGeneralInfoRealm))
+					if err != nil {
+						return Response{}, err
+					}
+
+					return elementUnderTest.AddUserACLEntryEx(entry)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					AddUserAclEntryEx_OUTPUT: AddUserAclEntryEx_OUTPUT{
+						Handle:      3,
+						ReturnValue: PTStatusSuccess,
+					},
+				},
+			},
+			{
+				"should return a valid amt_AuthorizationService UpdateUserAclEntryEx wsman message",
+				AMTAuthorizationService,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService/UpdateUserAclEntryEx`,
+				`<h:UpdateUserAclEntryEx_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService"><h:Handle>3</h:Handle><h:DigestUsername>operator</h:DigestUsername><h:DigestPassword>qJe69MoLt++ODtv4W8xA2w==</h:DigestPassword><h:AccessPermission>2</h:AccessPermission><h:Realms>13</h:Realms></h:UpdateUserAclEntryEx_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = "UpdateUserAclEntryEx"
+
+					entry, err := NewDigestUserACLEntry("operator", "P@ssw0rd", "Digest:A3829B3827DE4D33D4449B366831FD01", AccessPermissionLocalAndNetworkAccess, NewRealmBitmap(RealmValuesGeneralInfoRealm))
+					if err != nil {
+						return Response{}, err
+					}
+
+					return elementUnderTest.UpdateUserACLEntryEx(3, entry)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					UpdateUserAclEntryEx_OUTPUT: UpdateUserAclEntryEx_OUTPUT{
+						ReturnValue: PTStatusSuccess,
+					},
+				},
+			},
 		}
 		for _, test := range tests {
 			t.Run(test.name, func(t *testing.T) {
@@ -313,3 +421,39 @@ func TestNegativeAMT_AuthorizationService(t *testing.T) {
 		}
 	})
 }
+
+func TestUserACLEntryValidation(t *testing.T) {
+	wsmanMessageCreator := message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/authorization",
+	}
+	elementUnderTest := NewServiceWithClient(wsmanMessageCreator, &client)
+
+	tests := []struct {
+		name  string
+		entry UserACLEntry
+		err   error
+	}{
+		{"no credentials", UserACLEntry{}, ErrInvalidUserACLEntry},
+		{"digest and kerberos", UserACLEntry{DigestUsername: "operator", KerberosUserSid: "AQUAAAAAAAUVAAAA"}, ErrInvalidUserACLEntry},
+		{"password without username", UserACLEntry{DigestPassword: "qJe69MoLt++ODtv4W8xA2w=="}, ErrInvalidDigestUsername},
+		{"username too long", UserACLEntry{DigestUsername: "thisusernameistoolong"}, ErrInvalidDigestUsername},
+		{"username not ascii", UserACLEntry{DigestUsername: "opérateur"}, ErrInvalidDigestUsername},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := elementUnderTest.AddUserACLEntryEx(test.entry)
+			assert.ErrorIs(t, err, test.err)
+
+			_, err = elementUnderTest.UpdateUserACLEntryEx(1, test.entry)
+			assert.ErrorIs(t, err, test.err)
+		})
+	}
+
+	_, err := NewDigestUserACLEntry("", "P@ssw0rd", "Digest:A3829B3827DE4D33D4449B366831FD01", AccessPermissionLocalAccessOnly, 0)
+	assert.ErrorIs(t, err, ErrInvalidDigestUsername)
+
+	_, err = NewKerberosUserACLEntry("not a sid", AccessPermissionLocalAccessOnly, 0)
+	assert.ErrorIs(t, err, ErrInvalidKerberosSid)
+}
@@ -23,15 +23,39 @@ type (
 		Body    Body           `xml:"Body"`
 	}
 	Body struct {
-		XMLName           xml.Name `xml:"Body"`
-		GetResponse       AuthorizationOccurrence
-		EnumerateResponse common.EnumerateResponse
-		PullResponse      PullResponse
-		SetAdminResponse  SetAdminAclEntryEx_OUTPUT
+		XMLName                        xml.Name `xml:"Body"`
+		GetResponse                    AuthorizationOccurrence
+		EnumerateResponse              common.EnumerateResponse
+		PullResponse                   PullResponse
+		SetAdminResponse               SetAdminAclEntryEx_OUTPUT      `xml:"SetAdminAclEntryEx_OUTPUT"`
+		EnumerateUserAclEntries_OUTPUT EnumerateUserAclEntries_OUTPUT `xml:"EnumerateUserAclEntries_OUTPUT"`
+		GetUserAclEntryEx_OUTPUT       GetUserAclEntryEx_OUTPUT       `xml:"GetUserAclEntryEx_OUTPUT"`
+		AddUserAclEntryEx_OUTPUT       AddUserAclEntryEx_OUTPUT       `xml:"AddUserAclEntryEx_OUTPUT"`
+		UpdateUserAclEntryEx_OUTPUT    UpdateUserAclEntryEx_OUTPUT    `xml:"UpdateUserAclEntryEx_OUTPUT"`
 	}
 	SetAdminAclEntryEx_OUTPUT struct {
 		ReturnValue ReturnValue `xml:"ReturnValue"`
 	}
+	EnumerateUserAclEntries_OUTPUT struct {
+		TotalCount   int         `xml:"TotalCount"`   // Total number of user ACL entries, not including the admin entry.
+		HandlesCount int         `xml:"HandlesCount"` // Number of handles returned in this response, at most 50.
+		Handles      []int       `xml:"Handles"`      // Handles of the ACL entries, starting at the requested index.
+		ReturnValue  ReturnValue `xml:"ReturnValue"`
+	}
+	GetUserAclEntryEx_OUTPUT struct {
+		DigestUsername   string           `xml:"DigestUsername"`   // Username of a digest entry.
+		KerberosUserSid  string           `xml:"KerberosUserSid"`  // Base64 encoded SID of a Kerberos entry.
+		AccessPermission AccessPermission `xml:"AccessPermission"` // Interfaces the entry may access Intel® AMT from.
+		Realms           []RealmValues    `xml:"Realms"`           // Realms the entry may access.
+		ReturnValue      ReturnValue      `xml:"ReturnValue"`
+	}
+	AddUserAclEntryEx_OUTPUT struct {
+		Handle      int         `xml:"Handle"` // Handle of the new ACL entry.
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	UpdateUserAclEntryEx_OUTPUT struct {
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
 	AuthorizationOccurrence struct {
 		XMLName                 xml.Name       `xml:"AMT_AuthorizationService"`
 		AllowHttpQopAuthOnly    int            `xml:"AllowHttpQopAuthOnly"`    // Indicates whether using the http "quality of protection" (qop) directive with value auth is allowed
@@ -68,6 +92,19 @@ type AccessPermission int
 // Values={InvalidRealm, ReservedRealm0, RedirectionRealm, PTAdministrationRealm, HardwareAssetRealm, RemoteControlRealm, StorageRealm, EventManagerRealm, StorageAdminRealm, AgentPresenceLocalRealm, AgentPresenceRemoteRealm, CircuitBreakerRealm, NetworkTimeRealm, GeneralInfoRealm, FirmwareUpdateRealm, EITRealm, LocalUN, EndpointAccessControlRealm, EndpointAccessControlAdminRealm, EventLogReaderRealm, AuditLogRealm, ACLRealm, ReservedRealm1, ReservedRealm2, LocalSystemRealm, Reserved}.
 type RealmValues int
 
+// RealmBitmap is a set of realms in which bit n stands for the RealmValues value n.
+type RealmBitmap uint32
+
+// UserACLEntry describes a digest or Kerberos user ACL entry. Use NewDigestUserACLEntry or NewKerberosUserACLEntry to
+// create one with the credentials encoded the way Intel® AMT expects.
+type UserACLEntry struct {
+	DigestUsername   string           // Username of a digest entry, at most 16 7-bit ASCII characters.
+	DigestPassword   string           // Base64 encoded MD5 hash of Username + ":" + DigestRealm + ":" + Password.
+	KerberosUserSid  string           // Base64 encoded binary SID of a Kerberos entry.
+	AccessPermission AccessPermission // Interfaces the entry may access Intel® AMT from.
+	Realms           RealmBitmap      // Realms the entry may access.
+}
+
 // INPUTS
 // Request Types.
 type (
@@ -109,21 +146,20 @@ type (
 	AddUserAclEntry struct {
 		XMLName          xml.Name         `xml:"h:AddUserAclEntryEx_INPUT"`
 		H                string           `xml:"xmlns:h,attr"`
-		Handle           int              `xml:"h:Handle,omitempty"`              // Contains a creation handle.
-		DigestUsername   string           `xml:"h:DigestUsername"`                // Username for access control. Contains 7-bit ASCII characters. String length is limited to 16 characters. Username cannot be an empty string.
-		DigestPassword   string           `xml:"h:DigestPassword"`                // An MD5 Hash of these parameters concatenated together (Username + ":" + DigestRealm + ":" + Password). The DigestRealm is a field in AMT_GeneralSettings
-		AccessPermission AccessPermission `xml:"h:AccessPermission"`              // Indicates whether the User is allowed to access Intel® AMT from the Network or Local Interfaces. Note: this definition is restricted by the Default Interface Access Permissions of each Realm.
-		Realms           []RealmValues    `xml:"h:Realms>h:RealmValue,omitempty"` // Array of interface names the ACL entry is allowed to access.
-		KerberosUserSid  string           `xml:"h:KerberosUserSid"`               // Descriptor for user (SID) which is authenticated using the Kerberos Authentication. Byte array, specifying the Security Identifier (SID) according to the Kerberos specification. Current requirements imply that SID should be not smaller than 1 byte length and no longer than 28 bytes. SID length should also be a multiplicand of 4.
+		DigestUsername   string           `xml:"h:DigestUsername,omitempty"`  // Username for access control. Contains 7-bit ASCII characters. String length is limited to 16 characters. Username cannot be an empty string.
+		DigestPassword   string           `xml:"h:DigestPassword,omitempty"`  // An MD5 Hash of these parameters concatenated together (Username + ":" + DigestRealm + ":" + Password). The DigestRealm is a field in AMT_GeneralSettings
+		KerberosUserSid  string           `xml:"h:KerberosUserSid,omitempty"` // Descriptor for user (SID) which is authenticated using the Kerberos Authentication. Byte array, specifying the Security Identifier (SID) according to the Kerberos specification. Current requirements imply that SID should be not smaller than 1 byte length and no longer than 28 bytes. SID length should also be a multiplicand of 4.
+		AccessPermission AccessPermission `xml:"h:AccessPermission"`          // Indicates whether the User is allowed to access Intel® AMT from the Network or Local Interfaces. Note: this definition is restricted by the Default Interface Access Permissions of each Realm.
+		Realms           []RealmValues    `xml:"h:Realms"`                    // Array of interface names the ACL entry is allowed to access.
 	}
 	UpdateUserAclEntry struct {
-		XMLName          xml.Name         `xml:"h:UpdateUserAclEntry_INPUT"`
+		XMLName          xml.Name         `xml:"h:UpdateUserAclEntryEx_INPUT"`
 		H                string           `xml:"xmlns:h,attr"`
-		Handle           int              `xml:"h:Handle,omitempty"`              // Contains a creation handle.
-		DigestUsername   string           `xml:"h:DigestUsername"`                // Username for access control. Contains 7-bit ASCII characters. String length is limited to 16 characters. Username cannot be an empty string.
-		DigestPassword   string           `xml:"h:DigestPassword"`                // An MD5 Hash of these parameters concatenated together (Username + ":" + DigestRealm + ":" + Password). The DigestRealm is a field in AMT_GeneralSettings
-		AccessPermission AccessPermission `xml:"h:AccessPermission"`              // Indicates whether the User is allowed to access Intel® AMT from the Network or Local Interfaces. Note: this definition is restricted by the Default Interface Access Permissions of each Realm.
-		Realms           []RealmValues    `xml:"h:Realms>h:RealmValue,omitempty"` // Array of interface names the ACL entry is allowed to access.
-		KerberosUserSid  string           `xml:"h:KerberosUserSid"`               // Descriptor for user (SID) which is authenticated using the Kerberos Authentication. Byte array, specifying the Security Identifier (SID) according to the Kerberos specification. Current requirements imply that SID should be not smaller than 1 byte length and no longer than 28 bytes. SID length should also be a multiplicand of 4.
+		Handle           int              `xml:"h:Handle"`                    // Specifies the ACL entry to update.
+		DigestUsername   string           `xml:"h:DigestUsername,omitempty"`  // Username for access control. Contains 7-bit ASCII characters. String length is limited to 16 characters. Username cannot be an empty string.
+		DigestPassword   string           `xml:"h:DigestPassword,omitempty"`  // An MD5 Hash of these parameters concatenated together (Username + ":" + DigestRealm + ":" + Password). The DigestRealm is a field in AMT_GeneralSettings
+		KerberosUserSid  string           `xml:"h:KerberosUserSid,omitempty"` // Descriptor for user (SID) which is authenticated using the Kerberos Authentication. Byte array, specifying the Security Identifier (SID) according to the Kerberos specification. Current requirements imply that SID should be not smaller than 1 byte length and no longer than 28 bytes. SID length should also be a multiplicand of 4.
+		AccessPermission AccessPermission `xml:"h:AccessPermission"`          // Indicates whether the User is allowed to access Intel® AMT from the Network or Local Interfaces. Note: this definition is restricted by the Default Interface Access Permissions of each Realm.
+		Realms           []RealmValues    `xml:"h:Realms"`                    // Array of interface names the ACL entry is allowed to access.
 	}
 )
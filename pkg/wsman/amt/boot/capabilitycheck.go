@@ -0,0 +1,268 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package boot
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Kinds of the problems reported by CheckSettingData.
+const (
+	ViolationUnsupported  ViolationKind = iota // AMT_BootCapabilities reports the option as unsupported
+	ViolationVersion                           // The option needs a newer AMT version
+	ViolationConflict                          // The option cannot be combined with another option of the request
+	ViolationInvalidValue                      // The value is out of range or malformed
+)
+
+// violationKindToString is a map of ViolationKind values to their string representation.
+var violationKindToString = map[ViolationKind]string{
+	ViolationUnsupported:  "Unsupported",
+	ViolationVersion:      "Version",
+	ViolationConflict:     "Conflict",
+	ViolationInvalidValue: "InvalidValue",
+}
+
+// String returns the string representation of the ViolationKind value.
+func (k ViolationKind) String() string {
+	if value, exists := violationKindToString[k]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+// Limits of AMT_BootSettingData.
+const (
+	maxRSEPasswordLength = 32
+	// minUEFIBootVersion is the first AMT version with One-Click Recovery and Remote Platform Erase.
+	minUEFIBootVersion = 16
+)
+
+// ErrInvalidAMTVersion is returned by CheckSettingData when the AMT version cannot be parsed.
+var ErrInvalidAMTVersion = errors.New("invalid AMT version")
+
+// ViolationKind is the kind of a Violation.
+type ViolationKind int
+
+// Violation is an option of a BootSettingDataRequest that the device would reject or ignore.
+type Violation struct {
+	Field   string // The BootSettingDataRequest field at fault
+	Kind    ViolationKind
+	Message string
+}
+
+// Error returns the field and the reason of the violation.
+func (v Violation) Error() string {
+	return v.Field + ": " + v.Message
+}
+
+// Violations is the list of violations returned by CheckSettingData.
+type Violations []Violation
+
+// Err returns the violations as an error, or nil when there are none.
+func (v Violations) Err() error {
+	if len(v) == 0 {
+		return nil
+	}
+
+	errs := make([]error, 0, len(v))
+	for _, violation := range v {
+		errs = append(errs, violation)
+	}
+
+	return errors.Join(errs...)
+}
+
+// capabilityChecks pairs the boolean options of a request with the capability that they need.
+var capabilityChecks = []struct {
+	field      string
+	requested  func(BootSettingDataRequest) bool
+	capability func(BootCapabilitiesResponse) bool
+}{
+	{"UseIDER", func(r BootSettingDataRequest) bool { return r.UseIDER }, func(c BootCapabilitiesResponse) bool { return c.IDER }},
+	{"UseSOL", func(r BootSettingDataRequest) bool { return r.UseSOL }, func(c BootCapabilitiesResponse) bool { return c.SOL }},
+	{"BIOSPause", func(r BootSettingDataRequest) bool { return r.BIOSPause }, func(c BootCapabilitiesResponse) bool { return c.BIOSPause }},
+	{"BIOSSetup", func(r BootSettingDataRequest) bool { return r.BIOSSetup }, func(c BootCapabilitiesResponse) bool { return c.BIOSSetup }},
+	{"ReflashBIOS", func(r BootSettingDataRequest) bool { return r.ReflashBIOS }, func(c BootCapabilitiesResponse) bool { return c.BIOSReflash }},
+	{"UseSafeMode", func(r BootSettingDataRequest) bool { return r.UseSafeMode }, func(c BootCapabilitiesResponse) bool { return c.ForceHardDriveSafeModeBoot }},
+	{"LockKeyboard", func(r BootSettingDataRequest) bool { return r.LockKeyboard }, func(c BootCapabilitiesResponse) bool { return c.KeyboardLock }},
+	{"LockPowerButton", func(r BootSettingDataRequest) bool { return r.LockPowerButton }, func(c BootCapabilitiesResponse) bool { return c.PowerButtonLock }},
+	{"LockResetButton", func(r BootSettingDataRequest) bool { return r.LockResetButton }, func(c BootCapabilitiesResponse) bool { return c.ResetButtonLock }},
+	{"LockSleepButton", func(r BootSettingDataRequest) bool { return r.LockSleepButton }, func(c BootCapabilitiesResponse) bool { return c.SleepButtonLock }},
+	{"UserPasswordBypass", func(r BootSettingDataRequest) bool { return r.UserPasswordBypass }, func(c BootCapabilitiesResponse) bool { return c.UserPasswordBypass }},
+	{"ForcedProgressEvents", func(r BootSettingDataRequest) bool { return r.ForcedProgressEvents }, func(c BootCapabilitiesResponse) bool { return c.ForcedProgressEvents }},
+	{"ConfigurationDataReset", func(r BootSettingDataRequest) bool { return r.ConfigurationDataReset }, func(c BootCapabilitiesResponse) bool { return c.ConfigurationDataReset }},
+	{"SecureErase", func(r BootSettingDataRequest) bool { return r.SecureErase }, func(c BootCapabilitiesResponse) bool { return c.SecureErase }},
+	{"PlatformErase", func(r BootSettingDataRequest) bool { return r.PlatformErase }, func(c BootCapabilitiesResponse) bool { return c.PlatformErase != 0 }},
+	{"UEFIHTTPSBootEnabled", func(r BootSettingDataRequest) bool { return r.UEFIHTTPSBootEnabled }, func(c BootCapabilitiesResponse) bool { return c.ForceUEFIHTTPSBoot }},
+	{"UEFILocalPBABootEnabled", func(r BootSettingDataRequest) bool { return r.UEFILocalPBABootEnabled }, func(c BootCapabilitiesResponse) bool { return c.ForceUEFILocalPBABoot }},
+	{"WinREBootEnabled", func(r BootSettingDataRequest) bool { return r.WinREBootEnabled }, func(c BootCapabilitiesResponse) bool { return c.ForceWinREBoot }},
+}
+
+// CheckSettingData checks a request against the AMT_BootCapabilities of the device and its AMT version, such as the
+// VersionString of the AMT CIM_SoftwareIdentity, so that unsupported options are reported before the request is sent
+// with Put. The version checks are skipped when amtVersion is empty. All the violations found are returned.
+func CheckSettingData(request BootSettingDataRequest, capabilities BootCapabilitiesResponse, amtVersion string) (Violations, error) {
+	major := 0
+
+	if amtVersion != "" {
+		majorString, _, _ := strings.Cut(amtVersion, ".")
+
+		var err error
+
+		if major, err = strconv.Atoi(majorString); err != nil || major <= 0 {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidAMTVersion, amtVersion)
+		}
+	}
+
+	var violations Violations
+
+	add := func(field string, kind ViolationKind, message string) {
+		violations = append(violations, Violation{Field: field, Kind: kind, Message: message})
+	}
+
+	for _, check := range capabilityChecks {
+		if check.requested(request) && !check.capability(capabilities) {
+			add(check.field, ViolationUnsupported, "not supported by the device")
+		}
+	}
+
+	switch request.FirmwareVerbosity {
+	case SystemDefault:
+	case QuietMinimal:
+		if !capabilities.VerbosityQuiet {
+			add("FirmwareVerbosity", ViolationUnsupported, "quiet verbosity is not supported by the device")
+		}
+	case VerboseAll:
+		if !capabilities.VerbosityVerbose {
+			add("FirmwareVerbosity", ViolationUnsupported, "verbose verbosity is not supported by the device")
+		}
+	case ScreenBlank:
+		if !capabilities.VerbosityScreenBlank {
+			add("FirmwareVerbosity", ViolationUnsupported, "screen blank verbosity is not supported by the device")
+		}
+	default:
+		add("FirmwareVerbosity", ViolationInvalidValue, "unknown verbosity "+strconv.Itoa(int(request.FirmwareVerbosity)))
+	}
+
+	if request.UseIDER && request.IDERBootDevice != FloppyBoot && request.IDERBootDevice != CDBoot {
+		add("IDERBootDevice", ViolationInvalidValue, "unknown IDER boot device "+strconv.Itoa(int(request.IDERBootDevice)))
+	}
+
+	if request.BootMediaIndex < 0 {
+		add("BootMediaIndex", ViolationInvalidValue, "must not be negative")
+	}
+
+	if len(request.RSEPassword) > maxRSEPasswordLength {
+		add("RSEPassword", ViolationInvalidValue, fmt.Sprintf("must not exceed %d characters", maxRSEPasswordLength))
+	}
+
+	if request.RSEPassword != "" && !request.SecureErase {
+		add("RSEPassword", ViolationConflict, "is only used with SecureErase")
+	}
+
+	if request.SecureErase && request.PlatformErase {
+		add("SecureErase", ViolationConflict, "cannot be combined with PlatformErase")
+	}
+
+	uefiBoot := request.PlatformErase || request.UefiBootParametersArray != "" || request.UefiBootNumberOfParams != 0 ||
+		request.UEFIHTTPSBootEnabled || request.UEFILocalPBABootEnabled || request.WinREBootEnabled
+	if uefiBoot && major != 0 && major < minUEFIBootVersion {
+		add("UefiBootParametersArray", ViolationVersion, fmt.Sprintf("One-Click Recovery and Remote Platform Erase need AMT %d or later, the device runs %s", minUEFIBootVersion, amtVersion))
+	}
+
+	if !request.PlatformErase {
+		violations = append(violations, checkUEFIBootParameters(request, capabilities)...)
+	}
+
+	return violations, nil
+}
+
+// checkUEFIBootParameters checks the One-Click Recovery parameters of the request: their count, their types and sizes,
+// and the boot targets the device supports. The parameters of Remote Platform Erase use other types and are not
+// checked.
+func checkUEFIBootParameters(request BootSettingDataRequest, capabilities BootCapabilitiesResponse) Violations {
+	if request.UefiBootParametersArray == "" {
+		if request.UefiBootNumberOfParams != 0 {
+			return Violations{{Field: "UefiBootNumberOfParams", Kind: ViolationConflict, Message: "is set without UefiBootParametersArray"}}
+		}
+
+		return nil
+	}
+
+	buffer, err := base64.StdEncoding.DecodeString(request.UefiBootParametersArray)
+	if err != nil {
+		return Violations{{Field: "UefiBootParametersArray", Kind: ViolationInvalidValue, Message: "is not base64 encoded"}}
+	}
+
+	parameters, err := decodeUEFIBootParameters(buffer)
+	if err != nil {
+		return Violations{{Field: "UefiBootParametersArray", Kind: ViolationInvalidValue, Message: err.Error()}}
+	}
+
+	var violations Violations
+
+	if len(parameters) != request.UefiBootNumberOfParams {
+		violations = append(violations, Violation{
+			Field:   "UefiBootNumberOfParams",
+			Kind:    ViolationConflict,
+			Message: fmt.Sprintf("is %d but UefiBootParametersArray holds %d parameters", request.UefiBootNumberOfParams, len(parameters)),
+		})
+	}
+
+	for _, parameter := range parameters {
+		name, known := ParameterNames[parameter.Type]
+
+		switch {
+		case !known:
+			violations = append(violations, Violation{Field: "UefiBootParametersArray", Kind: ViolationInvalidValue, Message: fmt.Sprintf("unknown parameter type %d", parameter.Type)})
+		case len(parameter.Value) > MaxSizes[parameter.Type]:
+			violations = append(violations, Violation{Field: "UefiBootParametersArray", Kind: ViolationInvalidValue, Message: fmt.Sprintf("%s exceeds %d bytes", name, MaxSizes[parameter.Type])})
+		case parameter.Type == OCR_EFI_NETWORK_DEVICE_PATH && !capabilities.ForceUEFIHTTPSBoot:
+			violations = append(violations, Violation{Field: "UefiBootParametersArray", Kind: ViolationUnsupported, Message: "HTTPS boot is not supported by the device"})
+		case parameter.Type == OCR_EFI_FILE_DEVICE_PATH && !capabilities.ForceUEFILocalPBABoot:
+			violations = append(violations, Violation{Field: "UefiBootParametersArray", Kind: ViolationUnsupported, Message: "local PBA boot is not supported by the device"})
+		}
+	}
+
+	if !request.EnforceSecureBoot && !capabilities.AMTSecureBootControl {
+		violations = append(violations, Violation{Field: "EnforceSecureBoot", Kind: ViolationUnsupported, Message: "the BIOS does not allow AMT to disable secure boot"})
+	}
+
+	return violations
+}
+
+// decodeUEFIBootParameters splits a parameters array in the format written by CreateTLVBuffer: a 16-bit vendor, a
+// 16-bit type and a 32-bit length, little endian, followed by the value.
+func decodeUEFIBootParameters(buffer []byte) ([]TLVParameter, error) {
+	const headerLength = 8
+
+	var parameters []TLVParameter
+
+	for offset := 0; offset < len(buffer); {
+		if len(buffer)-offset < headerLength {
+			return nil, errors.New("incomplete parameter header")
+		}
+
+		paramType := binary.LittleEndian.Uint16(buffer[offset+2:])
+		length := binary.LittleEndian.Uint32(buffer[offset+4:])
+		offset += headerLength
+
+		if uint64(length) > uint64(len(buffer)-offset) {
+			return nil, fmt.Errorf("incomplete value for parameter type %d", paramType)
+		}
+
+		parameters = append(parameters, TLVParameter{Type: ParameterType(paramType), Value: buffer[offset : offset+int(length)]})
+		offset += int(length)
+	}
+
+	return parameters, nil
+}
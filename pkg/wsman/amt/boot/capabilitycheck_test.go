@@ -0,0 +1,129 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package boot
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var allCapabilities = BootCapabilitiesResponse{
+	IDER: true, SOL: true, BIOSReflash: true, BIOSSetup: true, BIOSPause: true, ForceHardDriveSafeModeBoot: true,
+	VerbosityScreenBlank: true, VerbosityVerbose: true, VerbosityQuiet: true, PowerButtonLock: true, ResetButtonLock: true,
+	KeyboardLock: true, SleepButtonLock: true, UserPasswordBypass: true, ForcedProgressEvents: true, ConfigurationDataReset: true,
+	SecureErase: true, ForceWinREBoot: true, ForceUEFILocalPBABoot: true, ForceUEFIHTTPSBoot: true, AMTSecureBootControl: true,
+	PlatformErase: 1,
+}
+
+func httpsBootParameters(t *testing.T) string {
+	t.Helper()
+
+	buffer, err := CreateTLVBuffer([]TLVParameter{
+		{Type: OCR_EFI_NETWORK_DEVICE_PATH, Value: []byte("https://example.com/boot.iso")},
+		{Type: OCR_HTTPS_CERT_SYNC_ROOT_CA, Value: []byte{1}},
+	})
+	require.NoError(t, err)
+
+	return base64.StdEncoding.EncodeToString(buffer)
+}
+
+func TestViolationKind_String(t *testing.T) {
+	assert.Equal(t, "Unsupported", ViolationUnsupported.String())
+	assert.Equal(t, "InvalidValue", ViolationInvalidValue.String())
+	assert.Equal(t, ValueNotFound, ViolationKind(99).String())
+}
+
+func TestCheckSettingData(t *testing.T) {
+	t.Run("accepts the options the device supports", func(t *testing.T) {
+		request := BootSettingDataRequest{
+			UseIDER: true, IDERBootDevice: CDBoot, UseSOL: true, BIOSPause: true, FirmwareVerbosity: VerboseAll,
+			LockKeyboard: true, UefiBootParametersArray: httpsBootParameters(t), UefiBootNumberOfParams: 2,
+		}
+
+		violations, err := CheckSettingData(request, allCapabilities, "16.1.25")
+		require.NoError(t, err)
+		assert.Empty(t, violations)
+		assert.NoError(t, violations.Err())
+	})
+
+	t.Run("reports unsupported options", func(t *testing.T) {
+		request := BootSettingDataRequest{UseIDER: true, BIOSPause: true, ConfigurationDataReset: true, SecureErase: true, FirmwareVerbosity: QuietMinimal}
+
+		violations, err := CheckSettingData(request, BootCapabilitiesResponse{SOL: true}, "")
+		require.NoError(t, err)
+		assert.Equal(t, Violations{
+			{Field: "UseIDER", Kind: ViolationUnsupported, Message: "not supported by the device"},
+			{Field: "BIOSPause", Kind: ViolationUnsupported, Message: "not supported by the device"},
+			{Field: "ConfigurationDataReset", Kind: ViolationUnsupported, Message: "not supported by the device"},
+			{Field: "SecureErase", Kind: ViolationUnsupported, Message: "not supported by the device"},
+			{Field: "FirmwareVerbosity", Kind: ViolationUnsupported, Message: "quiet verbosity is not supported by the device"},
+		}, violations)
+		assert.ErrorContains(t, violations.Err(), "UseIDER: not supported by the device\nBIOSPause")
+	})
+
+	t.Run("reports invalid values and conflicts", func(t *testing.T) {
+		request := BootSettingDataRequest{
+			UseIDER: true, IDERBootDevice: 2, FirmwareVerbosity: 7, BootMediaIndex: -1,
+			SecureErase: true, PlatformErase: true, RSEPassword: "0123456789012345678901234567890123",
+		}
+
+		violations, err := CheckSettingData(request, allCapabilities, "")
+		require.NoError(t, err)
+		assert.Equal(t, Violations{
+			{Field: "FirmwareVerbosity", Kind: ViolationInvalidValue, Message: "unknown verbosity 7"},
+			{Field: "IDERBootDevice", Kind: ViolationInvalidValue, Message: "unknown IDER boot device 2"},
+			{Field: "BootMediaIndex", Kind: ViolationInvalidValue, Message: "must not be negative"},
+			{Field: "RSEPassword", Kind: ViolationInvalidValue, Message: "must not exceed 32 characters"},
+			{Field: "SecureErase", Kind: ViolationConflict, Message: "cannot be combined with PlatformErase"},
+		}, violations)
+	})
+
+	t.Run("reports a password without secure erase", func(t *testing.T) {
+		violations, err := CheckSettingData(BootSettingDataRequest{RSEPassword: "P@ssw0rd"}, allCapabilities, "")
+		require.NoError(t, err)
+		assert.Equal(t, Violations{{Field: "RSEPassword", Kind: ViolationConflict, Message: "is only used with SecureErase"}}, violations)
+	})
+
+	t.Run("reports options that need a newer AMT version", func(t *testing.T) {
+		violations, err := CheckSettingData(BootSettingDataRequest{PlatformErase: true}, allCapabilities, "15.0.45")
+		require.NoError(t, err)
+		require.Len(t, violations, 1)
+		assert.Equal(t, ViolationVersion, violations[0].Kind)
+	})
+
+	t.Run("checks the UEFI boot parameters", func(t *testing.T) {
+		request := BootSettingDataRequest{UefiBootParametersArray: httpsBootParameters(t), UefiBootNumberOfParams: 1}
+
+		violations, err := CheckSettingData(request, BootCapabilitiesResponse{}, "")
+		require.NoError(t, err)
+		assert.Equal(t, Violations{
+			{Field: "UefiBootNumberOfParams", Kind: ViolationConflict, Message: "is 1 but UefiBootParametersArray holds 2 parameters"},
+			{Field: "UefiBootParametersArray", Kind: ViolationUnsupported, Message: "HTTPS boot is not supported by the device"},
+			{Field: "EnforceSecureBoot", Kind: ViolationUnsupported, Message: "the BIOS does not allow AMT to disable secure boot"},
+		}, violations)
+	})
+
+	t.Run("reports malformed UEFI boot parameters", func(t *testing.T) {
+		for array, message := range map[string]string{
+			"not base64!": "is not base64 encoded",
+			base64.StdEncoding.EncodeToString([]byte{0x86, 0x80, 1}):                     "incomplete parameter header",
+			base64.StdEncoding.EncodeToString([]byte{0x86, 0x80, 1, 0, 9, 0, 0, 0, 'h'}): "incomplete value for parameter type 1",
+			base64.StdEncoding.EncodeToString([]byte{0x86, 0x80, 99, 0, 0, 0, 0, 0}):     "unknown parameter type 99",
+		} {
+			violations, err := CheckSettingData(BootSettingDataRequest{UefiBootParametersArray: array, UefiBootNumberOfParams: 1, EnforceSecureBoot: true}, allCapabilities, "")
+			require.NoError(t, err)
+			assert.Equal(t, Violations{{Field: "UefiBootParametersArray", Kind: ViolationInvalidValue, Message: message}}, violations)
+		}
+	})
+
+	t.Run("rejects a malformed AMT version", func(t *testing.T) {
+		_, err := CheckSettingData(BootSettingDataRequest{}, allCapabilities, "sixteen")
+		assert.ErrorIs(t, err, ErrInvalidAMTVersion)
+	})
+}
@@ -7,7 +7,8 @@ package boot
 
 import (
 	"encoding/xml"
-	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/base"
@@ -24,36 +25,9 @@ func NewBootSettingDataWithClient(wsmanMessageCreator *message.WSManMessageCreat
 // Put overrides the generic Put because AMT expects only a specific subset of
 // BootSettingData fields on the wire; marshaling the full request struct would
 // cause firmware to reject the request. Keep the hand-crafted body.
-func (settingData SettingData) Put(bootSettingData BootSettingDataRequest) (response Response, err error) {
-	header := settingData.Base.WSManMessageCreator.CreateHeader(message.BaseActionsPut, AMTBootSettingData, nil, "", "")
-	body := fmt.Sprintf(
-		`<Body><h:AMT_BootSettingData xmlns:h="%sAMT_BootSettingData"><h:BIOSPause>%t</h:BIOSPause><h:BIOSSetup>%t</h:BIOSSetup><h:BootMediaIndex>%d</h:BootMediaIndex><h:ConfigurationDataReset>%t</h:ConfigurationDataReset><h:ElementName>%s</h:ElementName><h:EnforceSecureBoot>%t</h:EnforceSecureBoot><h:FirmwareVerbosity>%d</h:FirmwareVerbosity><h:ForcedProgressEvents>%t</h:ForcedProgressEvents><h:IDERBootDevice>%d</h:IDERBootDevice><h:InstanceID>%s</h:InstanceID><h:LockKeyboard>%t</h:LockKeyboard><h:LockPowerButton>%t</h:LockPowerButton><h:LockResetButton>%t</h:LockResetButton><h:LockSleepButton>%t</h:LockSleepButton><h:OwningEntity>%s</h:OwningEntity><h:PlatformErase>%t</h:PlatformErase><h:RSEPassword>%s</h:RSEPassword><h:ReflashBIOS>%t</h:ReflashBIOS><h:SecureErase>%t</h:SecureErase><h:UefiBootParametersArray>%s</h:UefiBootParametersArray><h:UefiBootNumberOfParams>%d</h:UefiBootNumberOfParams><h:UseIDER>%t</h:UseIDER><h:UseSOL>%t</h:UseSOL><h:UseSafeMode>%t</h:UseSafeMode><h:UserPasswordBypass>%t</h:UserPasswordBypass></h:AMT_BootSettingData></Body>`,
-		settingData.Base.WSManMessageCreator.ResourceURIBase,
-		bootSettingData.BIOSPause,
-		bootSettingData.BIOSSetup,
-		bootSettingData.BootMediaIndex,
-		bootSettingData.ConfigurationDataReset,
-		bootSettingData.ElementName,
-		bootSettingData.EnforceSecureBoot,
-		bootSettingData.FirmwareVerbosity,
-		bootSettingData.ForcedProgressEvents,
-		bootSettingData.IDERBootDevice,
-		bootSettingData.InstanceID,
-		bootSettingData.LockKeyboard,
-		bootSettingData.LockPowerButton,
-		bootSettingData.LockResetButton,
-		bootSettingData.LockSleepButton,
-		bootSettingData.OwningEntity,
-		bootSettingData.PlatformErase,
-		bootSettingData.RSEPassword,
-		bootSettingData.ReflashBIOS,
-		bootSettingData.SecureErase,
-		bootSettingData.UefiBootParametersArray,
-		bootSettingData.UefiBootNumberOfParams,
-		bootSettingData.UseIDER,
-		bootSettingData.UseSOL,
-		bootSettingData.UseSafeMode,
-		bootSettingData.UserPasswordBypass)
+func (settingData SettingData) Put(bootSettingData BootSettingDataRequest, opts ...base.HeaderOption) (response Response, err error) {
+	header := settingData.Base.WSManMessageCreator.CreateHeader(message.BaseActionsPut, AMTBootSettingData, nil, "", "", opts...)
+	body := settingDataBody(settingData.Base.WSManMessageCreator.ResourceURIBase, bootSettingData)
 
 	response = Response{
 		Message: &client.Message{
@@ -75,3 +49,46 @@ func (settingData SettingData) Put(bootSettingData BootSettingDataRequest) (resp
 
 	return response, err
 }
+
+// settingDataBody writes the hand-crafted Put body field by field, in the order the firmware expects.
+func settingDataBody(resourceURIBase string, data BootSettingDataRequest) string {
+	var body strings.Builder
+
+	body.Grow(1536 + len(data.ElementName) + len(data.InstanceID) + len(data.OwningEntity) + len(data.UefiBootParametersArray))
+
+	element := func(name, value string) {
+		body.WriteString("<h:" + name + ">")
+		body.WriteString(value)
+		body.WriteString("</h:" + name + ">")
+	}
+
+	body.WriteString(`<Body><h:AMT_BootSettingData xmlns:h="` + resourceURIBase + `AMT_BootSettingData">`)
+	element("BIOSPause", strconv.FormatBool(data.BIOSPause))
+	element("BIOSSetup", strconv.FormatBool(data.BIOSSetup))
+	element("BootMediaIndex", strconv.Itoa(data.BootMediaIndex))
+	element("ConfigurationDataReset", strconv.FormatBool(data.ConfigurationDataReset))
+	element("ElementName", data.ElementName)
+	element("EnforceSecureBoot", strconv.FormatBool(data.EnforceSecureBoot))
+	element("FirmwareVerbosity", strconv.Itoa(int(data.FirmwareVerbosity)))
+	element("ForcedProgressEvents", strconv.FormatBool(data.ForcedProgressEvents))
+	element("IDERBootDevice", strconv.Itoa(int(data.IDERBootDevice)))
+	element("InstanceID", data.InstanceID)
+	element("LockKeyboard", strconv.FormatBool(data.LockKeyboard))
+	element("LockPowerButton", strconv.FormatBool(data.LockPowerButton))
+	element("LockResetButton", strconv.FormatBool(data.LockResetButton))
+	element("LockSleepButton", strconv.FormatBool(data.LockSleepButton))
+	element("OwningEntity", data.OwningEntity)
+	element("PlatformErase", strconv.FormatBool(data.PlatformErase))
+	element("RSEPassword", data.RSEPassword)
+	element("ReflashBIOS", strconv.FormatBool(data.ReflashBIOS))
+	element("SecureErase", strconv.FormatBool(data.SecureErase))
+	element("UefiBootParametersArray", data.UefiBootParametersArray)
+	element("UefiBootNumberOfParams", strconv.Itoa(data.UefiBootNumberOfParams))
+	element("UseIDER", strconv.FormatBool(data.UseIDER))
+	element("UseSOL", strconv.FormatBool(data.UseSOL))
+	element("UseSafeMode", strconv.FormatBool(data.UseSafeMode))
+	element("UserPasswordBypass", strconv.FormatBool(data.UserPasswordBypass))
+	body.WriteString("</h:AMT_BootSettingData></Body>")
+
+	return body.String()
+}
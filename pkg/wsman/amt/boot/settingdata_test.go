@@ -8,6 +8,7 @@ package boot
 import (
 	"encoding/xml"
 	"fmt"
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -409,3 +410,30 @@ func TestNegativeAMT_BootSettingData(t *testing.T) {
 		}
 	})
 }
+
+// cannedClient answers every request with the same response without touching the disk.
+type cannedClient struct {
+	wsmantesting.MockClient
+	response []byte
+}
+
+func (c *cannedClient) Post(string) ([]byte, error) {
+	return c.response, nil
+}
+
+func BenchmarkSettingDataPut(b *testing.B) {
+	response, err := os.ReadFile("../../wsmantesting/responses/amt/boot/settingdata/put.xml")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	settingData := NewBootSettingDataWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), &cannedClient{response: response})
+
+	b.ReportAllocs()
+
+	for b.Loop() {
+		if _, err := settingData.Put(boot_settings); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -25,7 +25,7 @@ func NewEnvironmentDetectionSettingDataWithClient(wsmanMessageCreator *message.W
 // Put overrides the generic Put because this instance must be addressed by a
 // specific InstanceID selector ("Intel(r) AMT Environment Detection Settings"),
 // which the generic Put does not provide.
-func (sd SettingData) Put(environmentDetectionSettingData EnvironmentDetectionSettingDataRequest) (response Response, err error) {
+func (sd SettingData) Put(environmentDetectionSettingData EnvironmentDetectionSettingDataRequest, opts ...base.HeaderOption) (response Response, err error) {
 	environmentDetectionSettingData.H = fmt.Sprintf("%s%s", message.AMTSchema, AMTEnvironmentDetectionSettingData)
 	selector := []message.Selector{{
 		Name:  "InstanceID",
@@ -33,7 +33,7 @@ func (sd SettingData) Put(environmentDetectionSettingData EnvironmentDetectionSe
 	}}
 	response = Response{
 		Message: &client.Message{
-			XMLInput: sd.Base.Put(environmentDetectionSettingData, true, selector),
+			XMLInput: sd.Base.Put(environmentDetectionSettingData, true, selector, opts...),
 		},
 	}
 	// send the message to AMT
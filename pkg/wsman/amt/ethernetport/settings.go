@@ -28,13 +28,13 @@ func NewEthernetPortSettingsWithClient(wsmanMessageCreator *message.WSManMessage
 // Get retrieves the representation of the instance identified by the InstanceID
 // selector. This shadows the generic parameterless Get because the public API
 // has historically required an InstanceID argument here.
-func (s Settings) Get(instanceID string) (response Response, err error) {
-	return s.GetByInstanceID(instanceID)
+func (s Settings) Get(instanceID string, opts ...base.HeaderOption) (response Response, err error) {
+	return s.GetByInstanceID(instanceID, opts...)
 }
 
 // Put overrides the generic Put because each instance must be addressed by an
 // InstanceID selector, which the generic Put does not provide.
-func (s Settings) Put(instanceID string, ethernetPortSettings SettingsRequest) (response Response, err error) {
+func (s Settings) Put(instanceID string, ethernetPortSettings SettingsRequest, opts ...base.HeaderOption) (response Response, err error) {
 	ethernetPortSettings.H = fmt.Sprintf("%s%s", message.AMTSchema, AMTEthernetPortSettings)
 	selector := []message.Selector{{
 		Name:  "InstanceID",
@@ -42,7 +42,7 @@ func (s Settings) Put(instanceID string, ethernetPortSettings SettingsRequest) (
 	}}
 	response = Response{
 		Message: &client.Message{
-			XMLInput: s.Base.Put(ethernetPortSettings, true, selector),
+			XMLInput: s.Base.Put(ethernetPortSettings, true, selector, opts...),
 		},
 	}
 	// send the message to AMT
@@ -65,12 +65,12 @@ func (s Settings) Put(instanceID string, ethernetPortSettings SettingsRequest) (
 // linkPreference: 1 for ME, 2 for Host.
 // timeout: timeout value in seconds.
 // instanceID: the InstanceID of the AMT_EthernetPortSettings to modify.
-func (s Settings) SetLinkPreference(linkPreference, timeout uint32, instanceID string) (response Response, err error) {
+func (s Settings) SetLinkPreference(linkPreference, timeout uint32, instanceID string, opts ...base.HeaderOption) (response Response, err error) {
 	selector := message.Selector{
 		Name:  "InstanceID",
 		Value: instanceID,
 	}
-	header := s.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTEthernetPortSettings, SetLinkPreference), AMTEthernetPortSettings, []message.Selector{selector}, "", "")
+	header := s.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTEthernetPortSettings, SetLinkPreference), AMTEthernetPortSettings, []message.Selector{selector}, "", "", opts...)
 
 	request := SetLinkPreferenceRequest{
 		H:              fmt.Sprintf("%s%s", message.AMTSchema, AMTEthernetPortSettings),
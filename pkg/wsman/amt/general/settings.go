@@ -22,3 +22,42 @@ func NewGeneralSettingsWithClient(wsmanMessageCreator *message.WSManMessageCreat
 		base.NewService[Response](wsmanMessageCreator, AMTGeneralSettings, client),
 	}
 }
+
+// ToRequest copies the writable properties of the settings into a Put request, as Put replaces the whole instance.
+func (settings GeneralSettingsResponse) ToRequest() GeneralSettingsPutRequest {
+	return GeneralSettingsPutRequest{
+		ElementName:                   settings.ElementName,
+		InstanceID:                    settings.InstanceID,
+		IdleWakeTimeout:               settings.IdleWakeTimeout,
+		HostName:                      settings.HostName,
+		DomainName:                    settings.DomainName,
+		PingResponseEnabled:           settings.PingResponseEnabled,
+		WsmanOnlyMode:                 settings.WsmanOnlyMode,
+		PreferredAddressFamily:        settings.PreferredAddressFamily,
+		DHCPv6ConfigurationTimeout:    settings.DHCPv6ConfigurationTimeout,
+		DDNSUpdateEnabled:             settings.DDNSUpdateEnabled,
+		DDNSUpdateByDHCPServerEnabled: settings.DDNSUpdateByDHCPServerEnabled,
+		SharedFQDN:                    settings.SharedFQDN,
+		HostOSFQDN:                    settings.HostOSFQDN,
+		DDNSTTL:                       settings.DDNSTTL,
+		AMTNetworkEnabled:             settings.AMTNetworkEnabled,
+		RmcpPingResponseEnabled:       settings.RmcpPingResponseEnabled,
+		DDNSPeriodicUpdateInterval:    settings.DDNSPeriodicUpdateInterval,
+		PresenceNotificationInterval:  settings.PresenceNotificationInterval,
+		ThunderboltDockEnabled:        settings.ThunderboltDockEnabled,
+		OemID:                         settings.OemID,
+		DHCPSyncRequiresHostname:      int(settings.DHCPSyncRequiresHostname),
+	}
+}
+
+// settingsConversion declares how AMT_GeneralSettings is read back for Update.
+var settingsConversion = base.Conversion[Response, GeneralSettingsPutRequest]{
+	ToRequest: func(response Response) GeneralSettingsPutRequest {
+		return response.Body.GetResponse.ToRequest()
+	},
+}
+
+// Update reads the settings, applies mutate and writes the whole instance back. See base.Update.
+func (settings Settings) Update(mutate func(*GeneralSettingsPutRequest), opts ...base.UpdateOption) (Response, error) {
+	return base.Update(settings.WSManService, settingsConversion, mutate, opts...)
+}
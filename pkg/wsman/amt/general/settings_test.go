@@ -312,3 +312,40 @@ func TestNegativeAMT_GeneralSettings(t *testing.T) {
 		}
 	})
 }
+
+func TestAMT_GeneralSettings_Update(t *testing.T) {
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/general",
+		CurrentMessage:   wsmantesting.CurrentMessageGet,
+	}
+	elementUnderTest := NewGeneralSettingsWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), &client)
+
+	response, err := elementUnderTest.Update(func(request *GeneralSettingsPutRequest) {
+		assert.Equal(t, "Test Host Name", request.HostName)
+		assert.True(t, request.PingResponseEnabled)
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Test Host Name", response.Body.GetResponse.HostName)
+
+	// the instance does not change between the two reads, so the change is written
+	response, err = elementUnderTest.Update(func(request *GeneralSettingsPutRequest) {
+		request.PingResponseEnabled = !request.PingResponseEnabled
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, response.XMLInput, "<h:PingResponseEnabled>false</h:PingResponseEnabled>")
+}
+
+func TestGeneralSettingsResponse_ToRequest(t *testing.T) {
+	request := GeneralSettingsResponse{HostName: "host", SharedFQDN: false, DDNSUpdateByDHCPServerEnabled: true}.ToRequest()
+
+	data, err := xml.Marshal(request)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), "<h:SharedFQDN>false</h:SharedFQDN>")
+	assert.Contains(t, string(data), "<h:RmcpPingResponseEnabled>false</h:RmcpPingResponseEnabled>")
+	assert.Contains(t, string(data), "<h:DDNSUpdateByDHCPServerEnabled>true</h:DDNSUpdateByDHCPServerEnabled>")
+
+	// GeneralSettingsRequest keeps leaving unset booleans out
+	data, err = xml.Marshal(GeneralSettingsRequest{HostName: "host"})
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "SharedFQDN")
+}
@@ -95,6 +95,35 @@ type (
 		OemID                         int                    `xml:"h:OemID,omitempty"`                         // The OEM's vendor ID as listed in the Peripheral Component Interconnect Special Interest Group (PCI-SIG) list of member companies. Available in Release 16.1 and later releases.
 		DHCPSyncRequiresHostname      int                    `xml:"h:DHCPSyncRequiresHostname,omitempty"`      // When set to Enabled, the Intel AMT device will require the client to provide a hostname when requesting an IP address from a DHCP server. This setting is only applicable when DHCP is enabled. Values: 0=Disabled, 1=Enabled. Default: Disabled.
 	}
+
+	// GeneralSettingsPutRequest is the complete instance written back by ToRequest and Update. Unlike
+	// GeneralSettingsRequest, it always writes the boolean properties, so a property can be turned off and the ones
+	// left untouched keep their current value. See GeneralSettingsRequest for the properties.
+	GeneralSettingsPutRequest struct {
+		XMLName                       xml.Name               `xml:"h:AMT_GeneralSettings"`
+		H                             string                 `xml:"xmlns:h,attr"`
+		ElementName                   string                 `xml:"h:ElementName,omitempty"`
+		InstanceID                    string                 `xml:"h:InstanceID,omitempty"`
+		IdleWakeTimeout               int                    `xml:"h:IdleWakeTimeout,omitempty"`
+		HostName                      string                 `xml:"h:HostName,omitempty"`
+		DomainName                    string                 `xml:"h:DomainName,omitempty"`
+		PingResponseEnabled           bool                   `xml:"h:PingResponseEnabled"`
+		WsmanOnlyMode                 bool                   `xml:"h:WsmanOnlyMode"`
+		PreferredAddressFamily        PreferredAddressFamily `xml:"h:PreferredAddressFamily,omitempty"`
+		DHCPv6ConfigurationTimeout    int                    `xml:"h:DHCPv6ConfigurationTimeout,omitempty"`
+		DDNSUpdateEnabled             bool                   `xml:"h:DDNSUpdateEnabled"`
+		DDNSUpdateByDHCPServerEnabled bool                   `xml:"h:DDNSUpdateByDHCPServerEnabled"`
+		SharedFQDN                    bool                   `xml:"h:SharedFQDN"`
+		HostOSFQDN                    string                 `xml:"h:HostOSFQDN,omitempty"`
+		DDNSTTL                       int                    `xml:"h:DDNSTTL,omitempty"`
+		AMTNetworkEnabled             AMTNetwork             `xml:"h:AMTNetworkEnabled,omitempty"`
+		RmcpPingResponseEnabled       bool                   `xml:"h:RmcpPingResponseEnabled"`
+		DDNSPeriodicUpdateInterval    int                    `xml:"h:DDNSPeriodicUpdateInterval,omitempty"`
+		PresenceNotificationInterval  int                    `xml:"h:PresenceNotificationInterval,omitempty"`
+		ThunderboltDockEnabled        ThunderboltDock        `xml:"h:ThunderboltDockEnabled,omitempty"`
+		OemID                         int                    `xml:"h:OemID,omitempty"`
+		DHCPSyncRequiresHostname      int                    `xml:"h:DHCPSyncRequiresHostname,omitempty"`
+	}
 )
 
 // Preferred Address Family (IPv4/IPv6). Preferred Address Family (IPv4/IPv6) used for controlling outbound traffic such as events and user initiated traffic. For such traffic, the preferred addressing family will be attempted first, but other considerations also apply, depending on the traffic and the destination.
@@ -4,9 +4,16 @@
  **********************************************************************/
 
 // Package hdr8021filter facilitates communication with Intel AMT devices for 802.1 filter data.
+//
+// 802.1 filters match Ethernet frames and are added to System Defense policies like the IP filters of the systemdefense
+// package.
 package hdr8021filter
 
 import (
+	"encoding/base64"
+	"encoding/xml"
+	"net"
+
 	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/base"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
@@ -22,3 +29,40 @@ func NewServiceWithClient(wsmanMessageCreator *message.WSManMessageCreator, clie
 		base.NewService[Response](wsmanMessageCreator, AMTHdr8021Filter, client),
 	}
 }
+
+// Create adds a filter. The Name selector of Body.CreateResponse identifies the new filter, see systemdefense.Handle.
+func (service Service) Create(request Hdr8021FilterRequest, opts ...base.HeaderOption) (response Response, err error) {
+	return service.execute(service.Base.Create(&request, nil, opts...))
+}
+
+// Delete removes the filter with the given name. A filter can't be deleted while a policy references it.
+func (service Service) Delete(name string, opts ...base.HeaderOption) (response Response, err error) {
+	selector := message.Selector{Name: "Name", Value: name}
+
+	return service.execute(service.Base.Delete(selector, opts...))
+}
+
+// EncodeMAC returns the base64 encoding of a MAC address used by Hdr8021FilterRequest.
+func EncodeMAC(mac net.HardwareAddr) string {
+	return base64.StdEncoding.EncodeToString(mac)
+}
+
+func (service Service) execute(xmlInput string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: xmlInput,
+		},
+	}
+
+	err = service.Base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
@@ -6,11 +6,13 @@
 package hdr8021filter
 
 import (
+	"net"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/systemdefense"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
 )
 
@@ -55,6 +57,24 @@ func TestPositiveAMT_Hdr8021Filter(t *testing.T) {
 					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
 				},
 			},
+			{
+				"should create a valid AMT_Hdr8021Filter Create call",
+				AMTHdr8021Filter,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageCreate
+
+					return elementUnderTest.Create(Hdr8021FilterRequest{ElementName: "LLDP", HdrProtocolID8021: 0x88CC})
+				},
+			},
+			{
+				"should create a valid AMT_Hdr8021Filter Delete call",
+				AMTHdr8021Filter,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageDelete
+
+					return elementUnderTest.Delete("Intel(r) AMT:802.1 Filter 3")
+				},
+			},
 		}
 
 		for _, test := range tests {
@@ -106,6 +126,22 @@ func TestNegativeAMT_Hdr8021Filter(t *testing.T) {
 					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
 				},
 			},
+			{
+				"should handle error when Create fails",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Create(Hdr8021FilterRequest{})
+				},
+			},
+			{
+				"should handle error when Delete fails",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Delete("Intel(r) AMT:802.1 Filter 3")
+				},
+			},
 		}
 
 		for _, test := range tests {
@@ -116,3 +152,29 @@ func TestNegativeAMT_Hdr8021Filter(t *testing.T) {
 		}
 	})
 }
+
+func TestAMT_Hdr8021Filter_Create(t *testing.T) {
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/hdr8021filter",
+		CurrentMessage:   wsmantesting.CurrentMessageCreate,
+	}
+	elementUnderTest := NewServiceWithClient(wsmanMessageCreator, &client)
+
+	mac, err := net.ParseMAC("01:80:c2:00:00:0e")
+	assert.NoError(t, err)
+
+	response, err := elementUnderTest.Create(Hdr8021FilterRequest{
+		ElementName:        "LLDP",
+		FilterDirection:    systemdefense.FilterDirectionReceive,
+		FilterProfile:      systemdefense.FilterProfileDropWithStatistics,
+		HdrDestMACAddr8021: EncodeMAC(mac),
+		HdrProtocolID8021:  0x88CC,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, wsmantesting.ExpectedResponse(0, resourceURIBase, AMTHdr8021Filter, wsmantesting.Create, "",
+		`<h:AMT_Hdr8021Filter xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_Hdr8021Filter"><h:ElementName>LLDP</h:ElementName><h:ActionEventOnMatch>false</h:ActionEventOnMatch><h:FilterDirection>1</h:FilterDirection><h:FilterProfile>1</h:FilterProfile><h:HdrDestMACAddr8021>AYDCAAAO</h:HdrDestMACAddr8021><h:HdrProtocolID8021>35020</h:HdrProtocolID8021></h:AMT_Hdr8021Filter>`),
+		response.XMLInput)
+	assert.Equal(t, "Intel(r) AMT:802.1 Filter 3", response.Body.CreateResponse.Selector("Name"))
+}
@@ -9,6 +9,7 @@ import (
 	"encoding/xml"
 
 	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/systemdefense"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/common"
 )
@@ -27,6 +28,7 @@ type (
 		GetResponse       Hdr8021Filter
 		EnumerateResponse common.EnumerateResponse
 		PullResponse      PullResponse
+		CreateResponse    systemdefense.ResourceCreated
 	}
 	PullResponse struct {
 		XMLName            xml.Name        `xml:"PullResponse"`
@@ -45,5 +47,31 @@ type (
 		SystemName              string   `xml:"SystemName"`
 		VLANPriority            int      `xml:"VLANPriority"`
 		VLANID                  int      `xml:"VLANID"`
+		ActionEventOnMatch      bool     `xml:"ActionEventOnMatch"`
+		FilterProfile           int      `xml:"FilterProfile"`
+		FilterProfileData       int      `xml:"FilterProfileData"`
+		HdrSrcMACAddr8021       string   `xml:"HdrSrcMACAddr8021,omitempty"`
+		HdrDestMACAddr8021      string   `xml:"HdrDestMACAddr8021,omitempty"`
+		HdrProtocolID8021       int      `xml:"HdrProtocolID8021,omitempty"`
+	}
+)
+
+// INPUTS
+// Request Types.
+type (
+	// Hdr8021FilterRequest matches Ethernet frames. MAC addresses are base64 encoded, e.g. with EncodeMAC.
+	Hdr8021FilterRequest struct {
+		XMLName            xml.Name                      `xml:"h:AMT_Hdr8021Filter"`
+		H                  string                        `xml:"xmlns:h,attr"`
+		ElementName        string                        `xml:"h:ElementName,omitempty"`
+		ActionEventOnMatch bool                          `xml:"h:ActionEventOnMatch"`
+		FilterDirection    systemdefense.FilterDirection `xml:"h:FilterDirection"`
+		FilterProfile      systemdefense.FilterProfile   `xml:"h:FilterProfile"`
+		FilterProfileData  int                           `xml:"h:FilterProfileData,omitempty"`  // The rate limit in packets per second when FilterProfile is RateLimit.
+		HdrSrcMACAddr8021  string                        `xml:"h:HdrSrcMACAddr8021,omitempty"`  // Base64 encoded source MAC address.
+		HdrDestMACAddr8021 string                        `xml:"h:HdrDestMACAddr8021,omitempty"` // Base64 encoded destination MAC address.
+		HdrProtocolID8021  int                           `xml:"h:HdrProtocolID8021,omitempty"`  // The EtherType of the frame, e.g. 0x88CC for LLDP.
+		VLANID             int                           `xml:"h:VLANID,omitempty"`
+		VLANPriority       int                           `xml:"h:VLANPriority,omitempty"`
 	}
 )
@@ -6,6 +6,9 @@
 package ieee8021x
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/base"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
@@ -21,3 +24,70 @@ func NewIEEE8021xProfileWithClient(wsmanMessageCreator *message.WSManMessageCrea
 		base.NewService[Response](wsmanMessageCreator, AMTIEEE8021xProfile, client),
 	}
 }
+
+// ErrPasswordRequired is returned by Update when an enabled profile uses a password-based protocol and the request has
+// no password. The password is never returned by Get, so it has to be supplied again on every Put.
+var ErrPasswordRequired = errors.New("802.1x password is required")
+
+// ToRequest copies the properties of the profile into a Put request. The passwords and certificate references are
+// never returned by Get, so they are left empty.
+func (profile ProfileResponse) ToRequest() ProfileRequest {
+	return ProfileRequest{
+		ElementName:                     profile.ElementName,
+		InstanceID:                      profile.InstanceID,
+		Enabled:                         profile.Enabled,
+		ActiveInS0:                      profile.ActiveInS0,
+		AuthenticationProtocol:          profile.AuthenticationProtocol,
+		RoamingIdentity:                 profile.RoamingIdentity,
+		ServerCertificateName:           profile.ServerCertificateName,
+		ServerCertificateNameComparison: profile.ServerCertificateNameComparison,
+		Username:                        profile.Username,
+		Domain:                          profile.Domain,
+		ProtectedAccessCredential:       profile.ProtectedAccessCredential,
+		PxeTimeout:                      profile.PxeTimeout,
+	}
+}
+
+// profileConversion declares how AMT_8021XProfile is read back for Update. Passwords and certificate references are
+// never returned by Get.
+var profileConversion = base.Conversion[Response, ProfileRequest]{
+	ToRequest: func(response Response) ProfileRequest {
+		return response.Body.ProfileGetAndPutResponse.ToRequest()
+	},
+	WriteOnly: func(request *ProfileRequest) {
+		request.Password = ""
+		request.PACPassword = ""
+		request.ClientCertificate = ""
+		request.ServerCertificateIssue = ""
+	},
+	Validate: func(request *ProfileRequest) error {
+		if request.Enabled && request.Password == "" && usesPassword(request.AuthenticationProtocol) {
+			return fmt.Errorf("%w: %s", ErrPasswordRequired, request.AuthenticationProtocol)
+		}
+
+		return nil
+	},
+}
+
+// usesPassword reports whether the protocol authenticates with the profile's username and password.
+func usesPassword(protocol AuthenticationProtocol) bool {
+	switch protocol {
+	case AuthenticationProtocolTTLSMSCHAPv2, AuthenticationProtocolPEAPMSCHAPv2, AuthenticationProtocolEAPGTC,
+		AuthenticationProtocolEAPFASTMSCHAPv2, AuthenticationProtocolEAPFASTGTC:
+		return true
+	case AuthenticationProtocolTLS, AuthenticationProtocolEAPFASTTLS:
+	}
+
+	return false
+}
+
+// Update reads the profile, applies mutate and writes the whole instance back. See base.Update.
+//
+// Get never returns Password, PACPassword, ClientCertificate or ServerCertificateIssue, so mutate must set them again
+// whenever they are needed: an enabled profile with a password-based protocol and no password fails with
+// ErrPasswordRequired before anything is written. A Put without ClientCertificate or ServerCertificateIssue removes
+// the AMT_8021xCredentialContext instances of the certificates, so profiles using certificates must be bound again
+// with IPS_IEEE8021xSettings.SetCertificates after the update.
+func (profile Profile) Update(mutate func(*ProfileRequest), opts ...base.UpdateOption) (Response, error) {
+	return base.Update(profile.WSManService, profileConversion, mutate, opts...)
+}
@@ -277,3 +277,55 @@ func TestNegativeAMT_8021XProfile(t *testing.T) {
 		}
 	})
 }
+
+func TestAMT_8021XProfile_Update(t *testing.T) {
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/ieee8021x/profile",
+		CurrentMessage:   wsmantesting.CurrentMessageGet,
+	}
+	elementUnderTest := NewIEEE8021xProfileWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), &client)
+
+	_, err := elementUnderTest.Update(func(request *ProfileRequest) {
+		assert.Equal(t, "Intel(r) AMT 802.1x Profile 0", request.InstanceID)
+		request.Password = "P@ssw0rd"
+	})
+	assert.NoError(t, err)
+
+	// the instance does not change between the two reads, so the change is written
+	response, err := elementUnderTest.Update(func(request *ProfileRequest) {
+		request.Enabled = true
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, response.XMLInput, "<h:Enabled>true</h:Enabled>")
+
+	// the password is never read back, so an enabled PEAP profile needs it on every update
+	_, err = elementUnderTest.Update(func(request *ProfileRequest) {
+		request.Enabled = true
+		request.AuthenticationProtocol = AuthenticationProtocolPEAPMSCHAPv2
+	})
+	assert.ErrorIs(t, err, ErrPasswordRequired)
+
+	_, err = elementUnderTest.Update(func(request *ProfileRequest) {
+		request.Enabled = true
+		request.AuthenticationProtocol = AuthenticationProtocolPEAPMSCHAPv2
+		request.Password = "P@ssw0rd"
+	})
+	assert.NoError(t, err)
+}
+
+func TestProfileResponse_ToRequest(t *testing.T) {
+	request := ProfileResponse{
+		InstanceID:             "Intel(r) AMT 802.1x Profile 0",
+		Username:               "device",
+		Password:               "ignored",
+		PACPassword:            "ignored",
+		ClientCertificate:      "ignored",
+		ServerCertificateIssue: "ignored",
+	}.ToRequest()
+
+	assert.Equal(t, "device", request.Username)
+	assert.Empty(t, request.Password)
+	assert.Empty(t, request.PACPassword)
+	assert.Empty(t, request.ClientCertificate)
+	assert.Empty(t, request.ServerCertificateIssue)
+}
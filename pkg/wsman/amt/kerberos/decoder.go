@@ -133,3 +133,37 @@ func (c ConfiguredEncryptionAlgorithms) String() string {
 
 	return ValueNotFound
 }
+
+const (
+	EncryptionTypeAES128CTSHMACSHA196 EncryptionType = 17
+	EncryptionTypeAES256CTSHMACSHA196 EncryptionType = 18
+	EncryptionTypeRC4HMAC             EncryptionType = 23
+)
+
+// encryptionTypeToString is a map of EncryptionType to string.
+var encryptionTypeToString = map[EncryptionType]string{
+	EncryptionTypeAES128CTSHMACSHA196: "aes128-cts-hmac-sha1-96",
+	EncryptionTypeAES256CTSHMACSHA196: "aes256-cts-hmac-sha1-96",
+	EncryptionTypeRC4HMAC:             "rc4-hmac",
+}
+
+// String returns the string representation of the EncryptionType value.
+func (e EncryptionType) String() string {
+	if value, exists := encryptionTypeToString[e]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+// KeyLength returns the key length of the encryption type in bytes, or 0 for an unsupported type.
+func (e EncryptionType) KeyLength() int {
+	switch e {
+	case EncryptionTypeAES128CTSHMACSHA196, EncryptionTypeRC4HMAC:
+		return 16
+	case EncryptionTypeAES256CTSHMACSHA196:
+		return 32
+	}
+
+	return 0
+}
@@ -0,0 +1,173 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package kerberos
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// keytabVersion is the MIT keytab format version 2, which stores integers in big-endian order.
+const keytabVersion = 0x0502
+
+var (
+	ErrInvalidKeytab = errors.New("invalid keytab")
+	ErrNoKeytabEntry = errors.New("no matching keytab entry")
+)
+
+// KeytabEntry is a key of a service principal read from a keytab.
+type KeytabEntry struct {
+	Realm          string
+	Components     []string // The name components of the principal, e.g. "HTTP" and "amt.example.com".
+	NameType       uint32
+	Timestamp      time.Time
+	KeyVersion     int
+	EncryptionType EncryptionType
+	Key            []byte
+}
+
+// Principal returns the principal name of the entry, e.g. "HTTP/amt.example.com@EXAMPLE.COM".
+func (entry KeytabEntry) Principal() string {
+	return strings.Join(entry.Components, "/") + "@" + entry.Realm
+}
+
+// ParseKeytab parses a keytab in the MIT version 2 format as written by ktutil and ktpass. Deleted entries are skipped.
+func ParseKeytab(data []byte) ([]KeytabEntry, error) {
+	if len(data) < 2 || binary.BigEndian.Uint16(data) != keytabVersion {
+		return nil, fmt.Errorf("%w: unsupported format version", ErrInvalidKeytab)
+	}
+
+	entries := []KeytabEntry{}
+	reader := keytabReader{data: data[2:]}
+
+	for len(reader.data) > 0 {
+		// a negative size marks a hole left by a deleted entry
+		size := int64(int32(reader.uint32()))
+		record := keytabReader{data: reader.bytes(int(max(size, -size)))}
+
+		if reader.err != nil {
+			break
+		}
+
+		if size <= 0 {
+			continue
+		}
+
+		entry := record.entry()
+		if record.err != nil {
+			return nil, fmt.Errorf("%w: entry %d is truncated", ErrInvalidKeytab, len(entries)+1)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if reader.err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidKeytab, reader.err)
+	}
+
+	return entries, nil
+}
+
+// FindKeytabEntry returns the entry of principal with the given encryption type and the highest key version.
+func FindKeytabEntry(entries []KeytabEntry, principal string, encryptionType EncryptionType) (KeytabEntry, error) {
+	var found *KeytabEntry
+
+	for i := range entries {
+		entry := &entries[i]
+
+		if !strings.EqualFold(entry.Principal(), principal) || entry.EncryptionType != encryptionType {
+			continue
+		}
+
+		if found == nil || entry.KeyVersion > found.KeyVersion {
+			found = entry
+		}
+	}
+
+	if found == nil {
+		return KeytabEntry{}, fmt.Errorf("%w: %s %s", ErrNoKeytabEntry, principal, encryptionType)
+	}
+
+	return *found, nil
+}
+
+type keytabReader struct {
+	data []byte
+	err  error
+}
+
+func (r *keytabReader) bytes(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+
+	if n < 0 || n > len(r.data) {
+		r.err = errors.New("unexpected end of data")
+
+		return nil
+	}
+
+	data := r.data[:n]
+	r.data = r.data[n:]
+
+	return data
+}
+
+func (r *keytabReader) uint8() uint8 {
+	if data := r.bytes(1); data != nil {
+		return data[0]
+	}
+
+	return 0
+}
+
+func (r *keytabReader) uint16() uint16 {
+	if data := r.bytes(2); data != nil {
+		return binary.BigEndian.Uint16(data)
+	}
+
+	return 0
+}
+
+func (r *keytabReader) uint32() uint32 {
+	if data := r.bytes(4); data != nil {
+		return binary.BigEndian.Uint32(data)
+	}
+
+	return 0
+}
+
+func (r *keytabReader) string() string {
+	return string(r.bytes(int(r.uint16())))
+}
+
+func (r *keytabReader) entry() KeytabEntry {
+	entry := KeytabEntry{}
+	components := int(r.uint16())
+	entry.Realm = r.string()
+
+	for range components {
+		entry.Components = append(entry.Components, r.string())
+	}
+
+	entry.NameType = r.uint32()
+	entry.Timestamp = time.Unix(int64(r.uint32()), 0).UTC()
+	entry.KeyVersion = int(r.uint8())
+	entry.EncryptionType = EncryptionType(r.uint16())
+	entry.Key = append([]byte{}, r.bytes(int(r.uint16()))...)
+
+	// newer writers append the full 32-bit key version, which replaces the 8-bit one unless it's zero
+	if len(r.data) >= 4 {
+		if keyVersion := r.uint32(); keyVersion != 0 {
+			entry.KeyVersion = int(keyVersion)
+		}
+	}
+
+	return entry
+}
@@ -0,0 +1,115 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package kerberos
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// keytabRecord encodes an entry in the MIT version 2 keytab format, with the 32-bit key version when it's above 255.
+func keytabRecord(entry KeytabEntry) []byte {
+	record := binary.BigEndian.AppendUint16(nil, uint16(len(entry.Components)))
+	record = binary.BigEndian.AppendUint16(record, uint16(len(entry.Realm)))
+	record = append(record, entry.Realm...)
+
+	for _, component := range entry.Components {
+		record = binary.BigEndian.AppendUint16(record, uint16(len(component)))
+		record = append(record, component...)
+	}
+
+	record = binary.BigEndian.AppendUint32(record, entry.NameType)
+	record = binary.BigEndian.AppendUint32(record, uint32(entry.Timestamp.Unix()))
+	record = append(record, byte(entry.KeyVersion))
+	record = binary.BigEndian.AppendUint16(record, uint16(entry.EncryptionType))
+	record = binary.BigEndian.AppendUint16(record, uint16(len(entry.Key)))
+	record = append(record, entry.Key...)
+
+	if entry.KeyVersion > 0xff {
+		record = binary.BigEndian.AppendUint32(record, uint32(entry.KeyVersion))
+	}
+
+	return append(binary.BigEndian.AppendUint32(nil, uint32(len(record))), record...)
+}
+
+func TestParseKeytab(t *testing.T) {
+	rc4Key, err := StringToKey(EncryptionTypeRC4HMAC, "password", "", 0)
+	require.NoError(t, err)
+
+	aesKey, err := StringToKey(EncryptionTypeAES256CTSHMACSHA196, "password", DefaultSalt("EXAMPLE.COM", "HTTP", "amt.example.com"), 0)
+	require.NoError(t, err)
+
+	entries := []KeytabEntry{
+		{Realm: "EXAMPLE.COM", Components: []string{"HTTP", "amt.example.com"}, NameType: 1, Timestamp: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), KeyVersion: 2, EncryptionType: EncryptionTypeRC4HMAC, Key: make([]byte, 16)},
+		{Realm: "EXAMPLE.COM", Components: []string{"HTTP", "amt.example.com"}, NameType: 1, Timestamp: time.Date(2026, 2, 2, 3, 4, 5, 0, time.UTC), KeyVersion: 300, EncryptionType: EncryptionTypeRC4HMAC, Key: rc4Key},
+		{Realm: "EXAMPLE.COM", Components: []string{"HTTP", "amt.example.com"}, NameType: 1, Timestamp: time.Date(2026, 2, 2, 3, 4, 5, 0, time.UTC), KeyVersion: 300, EncryptionType: EncryptionTypeAES256CTSHMACSHA196, Key: aesKey},
+	}
+
+	data := []byte{0x05, 0x02}
+	data = append(data, keytabRecord(entries[0])...)
+	// a hole left by a deleted entry
+	data = append(binary.BigEndian.AppendUint32(data, uint32(0xfffffffc)), 0, 0, 0, 0)
+	data = append(data, keytabRecord(entries[1])...)
+	data = append(data, keytabRecord(entries[2])...)
+
+	parsed, err := ParseKeytab(data)
+	require.NoError(t, err)
+	assert.Equal(t, entries, parsed)
+	assert.Equal(t, "HTTP/amt.example.com@EXAMPLE.COM", parsed[0].Principal())
+
+	entry, err := FindKeytabEntry(parsed, "HTTP/amt.example.com@EXAMPLE.COM", EncryptionTypeRC4HMAC)
+	require.NoError(t, err)
+	assert.Equal(t, 300, entry.KeyVersion)
+
+	_, err = FindKeytabEntry(parsed, "HTTP/other.example.com@EXAMPLE.COM", EncryptionTypeRC4HMAC)
+	assert.ErrorIs(t, err, ErrNoKeytabEntry)
+
+	request := KerberosSettingDataRequest{KrbEnabled: true, Passphrase: "password", Salt: "salt"}
+	require.NoError(t, request.ImportKeytabEntry(entry))
+	assert.NoError(t, request.Validate())
+	assert.Equal(t, "EXAMPLE.COM", request.RealmName)
+	assert.Equal(t, []string{"HTTP/amt.example.com"}, request.ServicePrincipalName)
+	assert.Equal(t, 300, request.KeyVersion)
+	assert.Equal(t, []int{0x88, 0x46, 0xf7, 0xea, 0xee, 0x8f, 0xb1, 0x17, 0xad, 0x06, 0xbd, 0xd8, 0x30, 0xb7, 0x58, 0x6c}, request.MasterKey)
+	assert.Empty(t, request.Passphrase)
+
+	assert.ErrorIs(t, request.ImportKeytabEntry(parsed[2]), ErrUnsupportedEncryptionType)
+}
+
+func TestParseKeytabErrors(t *testing.T) {
+	_, err := ParseKeytab([]byte{0x05, 0x01})
+	assert.ErrorIs(t, err, ErrInvalidKeytab)
+
+	record := keytabRecord(KeytabEntry{Realm: "EXAMPLE.COM", Components: []string{"HTTP"}, EncryptionType: EncryptionTypeRC4HMAC, Key: make([]byte, 16)})
+
+	_, err = ParseKeytab(append([]byte{0x05, 0x02}, record[:len(record)-1]...))
+	assert.ErrorIs(t, err, ErrInvalidKeytab)
+
+	// a record whose size doesn't cover its key
+	binary.BigEndian.PutUint32(record, binary.BigEndian.Uint32(record)-4)
+
+	_, err = ParseKeytab(append([]byte{0x05, 0x02}, record[:len(record)-4]...))
+	assert.ErrorIs(t, err, ErrInvalidKeytab)
+
+	// the hole of the most negative size can't be negated in 32 bits
+	_, err = ParseKeytab([]byte{0x05, 0x02, 0x80, 0, 0, 0})
+	assert.ErrorIs(t, err, ErrInvalidKeytab)
+
+	_, err = ParseKeytab([]byte{0x05, 0x02, 0x7f, 0xff, 0xff, 0xff, 0x00})
+	assert.ErrorIs(t, err, ErrInvalidKeytab)
+
+	// a size cut short
+	_, err = ParseKeytab([]byte{0x05, 0x02, 0x00, 0x00})
+	assert.ErrorIs(t, err, ErrInvalidKeytab)
+
+	entries, err := ParseKeytab([]byte{0x05, 0x02})
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
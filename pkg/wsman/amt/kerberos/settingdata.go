@@ -8,7 +8,10 @@ package kerberos
 
 import (
 	"encoding/xml"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/methods"
@@ -16,6 +19,8 @@ import (
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
 )
 
+var ErrInvalidSettings = errors.New("invalid Kerberos settings")
+
 type SettingData struct {
 	base.WSManService[Response]
 }
@@ -28,8 +33,8 @@ func NewKerberosSettingDataWithClient(wsmanMessageCreator *message.WSManMessageC
 }
 
 // GetCredentialCacheState gets the current state of the credential caching functionality.
-func (settingData SettingData) GetCredentialCacheState() (response Response, err error) {
-	header := settingData.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTKerberosSettingData, GetCredentialCacheState), AMTKerberosSettingData, nil, "", "")
+func (settingData SettingData) GetCredentialCacheState(opts ...base.HeaderOption) (response Response, err error) {
+	header := settingData.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTKerberosSettingData, GetCredentialCacheState), AMTKerberosSettingData, nil, "", "", opts...)
 	body := settingData.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(GetCredentialCacheState), AMTKerberosSettingData, nil)
 
 	response = Response{
@@ -53,12 +58,12 @@ func (settingData SettingData) GetCredentialCacheState() (response Response, err
 
 // SetCredentialCacheState enables/disables the credential caching functionality
 // TODO: Current gets SOAP schema violation from AMT.
-func (settingData SettingData) SetCredentialCacheState(enabled bool) (response Response, err error) {
+func (settingData SettingData) SetCredentialCacheState(enabled bool, opts ...base.HeaderOption) (response Response, err error) {
 	credentialCasheState := SetCredentialCacheStateInput{
 		H:       fmt.Sprintf("%s%s", message.AMTSchema, AMTKerberosSettingData),
 		Enabled: enabled,
 	}
-	header := settingData.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTKerberosSettingData, SetCredentialCacheState), AMTKerberosSettingData, nil, "", "")
+	header := settingData.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTKerberosSettingData, SetCredentialCacheState), AMTKerberosSettingData, nil, "", "", opts...)
 	body := settingData.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(SetCredentialCacheState), AMTKerberosSettingData, credentialCasheState)
 
 	response = Response{
@@ -79,3 +84,87 @@ func (settingData SettingData) SetCredentialCacheState(enabled bool) (response R
 
 	return response, err
 }
+
+// Put validates request and writes the Kerberos settings. Disabling Kerberos needs no other property.
+func (settingData SettingData) Put(request KerberosSettingDataRequest, opts ...base.HeaderOption) (response Response, err error) {
+	if err := request.Validate(); err != nil {
+		return response, err
+	}
+
+	return settingData.WSManService.Put(&request, opts...)
+}
+
+// Validate checks that an enabled configuration names the realm and service principals and has exactly one source
+// for the key.
+func (request KerberosSettingDataRequest) Validate() error {
+	if !request.KrbEnabled {
+		return nil
+	}
+
+	if request.RealmName == "" {
+		return fmt.Errorf("%w: RealmName is required", ErrInvalidSettings)
+	}
+
+	if len(request.ServicePrincipalName) == 0 {
+		return fmt.Errorf("%w: ServicePrincipalName is required", ErrInvalidSettings)
+	}
+
+	if len(request.ServicePrincipalProtocol) != 0 && len(request.ServicePrincipalProtocol) != len(request.ServicePrincipalName) {
+		return fmt.Errorf("%w: %d ServicePrincipalProtocol values for %d ServicePrincipalName values", ErrInvalidSettings, len(request.ServicePrincipalProtocol), len(request.ServicePrincipalName))
+	}
+
+	switch {
+	case len(request.MasterKey) != 0 && request.Passphrase != "":
+		return fmt.Errorf("%w: MasterKey can't be used with Passphrase", ErrInvalidSettings)
+	case request.Passphrase != "" && request.Salt == "":
+		return fmt.Errorf("%w: Passphrase requires Salt", ErrInvalidSettings)
+	case request.Passphrase == "" && len(request.MasterKey) != EncryptionTypeRC4HMAC.KeyLength():
+		return fmt.Errorf("%w: MasterKey must have %d bytes", ErrInvalidSettings, EncryptionTypeRC4HMAC.KeyLength())
+	}
+
+	for _, octet := range request.MasterKey {
+		if octet < 0 || octet > 0xff {
+			return fmt.Errorf("%w: MasterKey value %d is not a byte", ErrInvalidSettings, octet)
+		}
+	}
+
+	return nil
+}
+
+// SetMasterKey sets the RC4-HMAC key, e.g. as returned by StringToKey, and clears the passphrase properties.
+func (request *KerberosSettingDataRequest) SetMasterKey(key []byte) error {
+	if len(key) != EncryptionTypeRC4HMAC.KeyLength() {
+		return fmt.Errorf("%w: MasterKey must have %d bytes", ErrInvalidSettings, EncryptionTypeRC4HMAC.KeyLength())
+	}
+
+	request.EncryptionAlgorithm = EncryptionAlgorithmRC4EncryptionAndHMACAuthentication
+	request.MasterKey = make([]int, len(key))
+	request.Passphrase, request.Salt, request.IterationCount = "", "", 0
+
+	for i, octet := range key {
+		request.MasterKey[i] = int(octet)
+	}
+
+	return nil
+}
+
+// ImportKeytabEntry configures the realm, service principal, key version and master key from an RC4-HMAC keytab entry.
+// The AES keys of a keytab can't be imported; the firmware derives them from Passphrase and Salt.
+func (request *KerberosSettingDataRequest) ImportKeytabEntry(entry KeytabEntry) error {
+	if entry.EncryptionType != EncryptionTypeRC4HMAC {
+		return fmt.Errorf("%w: %s keys can't be imported", ErrUnsupportedEncryptionType, entry.EncryptionType)
+	}
+
+	if err := request.SetMasterKey(entry.Key); err != nil {
+		return err
+	}
+
+	request.RealmName = entry.Realm
+	request.KeyVersion = entry.KeyVersion
+
+	if name := strings.Join(entry.Components, "/"); !slices.Contains(request.ServicePrincipalName, name) {
+		request.ServicePrincipalName = append(request.ServicePrincipalName, name)
+	}
+
+	return nil
+}
@@ -144,6 +144,43 @@ func TestPositiveAMT_KerberosSettingData(t *testing.T) {
 					},
 				},
 			},
+			// PUT
+			{
+				"should create a valid amt_KerberosSettingData Put wsman message",
+				AMTKerberosSettingData,
+				wsmantesting.Put,
+				`<h:AMT_KerberosSettingData xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_KerberosSettingData"><h:ElementName>Intel(r) AMT: Kerberos Settings</h:ElementName><h:InstanceID>Intel (r) AMT: Kerberos Settings</h:InstanceID><h:RealmName>EXAMPLE.COM</h:RealmName><h:ServicePrincipalName>HTTP/amt.example.com:16992</h:ServicePrincipalName><h:ServicePrincipalName>HTTP/amt.example.com:16993</h:ServicePrincipalName><h:KeyVersion>3</h:KeyVersion><h:EncryptionAlgorithm>0</h:EncryptionAlgorithm><h:MaximumClockTolerance>5</h:MaximumClockTolerance><h:KrbEnabled>true</h:KrbEnabled><h:Passphrase>password</h:Passphrase><h:Salt>EXAMPLE.COMHTTPamt.example.com</h:Salt><h:IterationCount>4096</h:IterationCount></h:AMT_KerberosSettingData>`,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePut
+
+					return elementUnderTest.Put(KerberosSettingDataRequest{
+						ElementName:           "Intel(r) AMT: Kerberos Settings",
+						InstanceID:            "Intel (r) AMT: Kerberos Settings",
+						RealmName:             "EXAMPLE.COM",
+						ServicePrincipalName:  []string{"HTTP/amt.example.com:16992", "HTTP/amt.example.com:16993"},
+						KeyVersion:            3,
+						MaximumClockTolerance: 5,
+						KrbEnabled:            true,
+						Passphrase:            "password",
+						Salt:                  DefaultSalt("EXAMPLE.COM", "HTTP", "amt.example.com"),
+						IterationCount:        DefaultIterationCount,
+					})
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					GetResponse: KerberosSettingDataResponse{
+						XMLName:                       xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTKerberosSettingData), Local: AMTKerberosSettingData},
+						ElementName:                   "Intel(r) AMT: Kerberos Settings",
+						InstanceID:                    "Intel (r) AMT: Kerberos Settings",
+						RealmName:                     "EXAMPLE.COM",
+						ServicePrincipalName:          []string{"HTTP/amt.example.com:16992", "HTTP/amt.example.com:16993"},
+						KeyVersion:                    3,
+						MaximumClockTolerance:         5,
+						KrbEnabled:                    true,
+						SupportedEncryptionAlgorithms: []SupportedEncryptionAlgorithms{0, 1, 2},
+					},
+				},
+			},
 
 			// SET CREDENTIAL CACHE STATE
 			// {
@@ -304,3 +341,44 @@ func TestNegativeAMT_KerberosSettingData(t *testing.T) {
 		}
 	})
 }
+
+func TestKerberosSettingDataRequestValidate(t *testing.T) {
+	valid := KerberosSettingDataRequest{
+		RealmName:            "EXAMPLE.COM",
+		ServicePrincipalName: []string{"HTTP/amt.example.com:16992"},
+		KrbEnabled:           true,
+	}
+	assert.NoError(t, valid.SetMasterKey(make([]byte, 16)))
+	assert.NoError(t, valid.Validate())
+	assert.NoError(t, KerberosSettingDataRequest{}.Validate())
+
+	tests := []struct {
+		name   string
+		mutate func(*KerberosSettingDataRequest)
+	}{
+		{"missing realm", func(r *KerberosSettingDataRequest) { r.RealmName = "" }},
+		{"missing service principal", func(r *KerberosSettingDataRequest) { r.ServicePrincipalName = nil }},
+		{"protocol count mismatch", func(r *KerberosSettingDataRequest) {
+			r.ServicePrincipalProtocol = []ServicePrincipalProtocol{ServicePrincipalProtocolHTTPProtocolDefinition, ServicePrincipalProtocolHTTPSProtocolDefinition}
+		}},
+		{"master key and passphrase", func(r *KerberosSettingDataRequest) { r.Passphrase, r.Salt = "password", "salt" }},
+		{"passphrase without salt", func(r *KerberosSettingDataRequest) { r.MasterKey, r.Passphrase = nil, "password" }},
+		{"short master key", func(r *KerberosSettingDataRequest) { r.MasterKey = r.MasterKey[1:] }},
+		{"master key value out of range", func(r *KerberosSettingDataRequest) { r.MasterKey[0] = 256 }},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request := valid
+			request.MasterKey = append([]int{}, valid.MasterKey...)
+			test.mutate(&request)
+			assert.ErrorIs(t, request.Validate(), ErrInvalidSettings)
+		})
+	}
+
+	client := wsmantesting.MockClient{PackageUnderTest: "amt/kerberos"}
+	elementUnderTest := NewKerberosSettingDataWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), &client)
+	_, err := elementUnderTest.Put(KerberosSettingDataRequest{KrbEnabled: true})
+	assert.ErrorIs(t, err, ErrInvalidSettings)
+	assert.ErrorIs(t, valid.SetMasterKey(make([]byte, 32)), ErrInvalidSettings)
+}
@@ -0,0 +1,178 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package kerberos
+
+import (
+	"crypto/aes"
+	"crypto/pbkdf2"
+	"crypto/sha1"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+	"strings"
+	"unicode/utf16"
+)
+
+// DefaultIterationCount is the PBKDF2 iteration count of the AES string-to-key function when none is given, see
+// RFC 3962 section 4.
+const DefaultIterationCount = 4096
+
+var ErrUnsupportedEncryptionType = errors.New("unsupported Kerberos encryption type")
+
+// DefaultSalt returns the default salt of a principal: the realm followed by the name components, e.g.
+// "EXAMPLE.COMHTTPamt.example.com" for HTTP/amt.example.com@EXAMPLE.COM.
+func DefaultSalt(realm string, components ...string) string {
+	return realm + strings.Join(components, "")
+}
+
+// StringToKey derives the key of encryptionType from a passphrase. The AES types use the RFC 3962 string-to-key
+// function with salt and iterationCount, where an iterationCount of 0 selects DefaultIterationCount. RC4-HMAC uses the
+// RFC 4757 function, the MD4 hash of the UTF-16LE passphrase, and ignores salt and iterationCount.
+func StringToKey(encryptionType EncryptionType, passphrase, salt string, iterationCount int) ([]byte, error) {
+	switch encryptionType {
+	case EncryptionTypeRC4HMAC:
+		return md4Sum(utf16LE(passphrase)), nil
+	case EncryptionTypeAES128CTSHMACSHA196, EncryptionTypeAES256CTSHMACSHA196:
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedEncryptionType, encryptionType)
+	}
+
+	if iterationCount == 0 {
+		iterationCount = DefaultIterationCount
+	}
+
+	keyLength := encryptionType.KeyLength()
+
+	temporaryKey, err := pbkdf2.Key(sha1.New, passphrase, []byte(salt), iterationCount, keyLength)
+	if err != nil {
+		return nil, err
+	}
+
+	return deriveKey(temporaryKey, []byte("kerberos"))
+}
+
+// deriveKey is the RFC 3961 DK function of the simplified profile with AES as the cipher. For AES random-to-key is
+// the identity function, so the key is the first bytes of the DR output.
+func deriveKey(key, constant []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	input := nfold(constant, aes.BlockSize)
+	derived := make([]byte, 0, len(key)+aes.BlockSize)
+
+	for len(derived) < len(key) {
+		output := make([]byte, aes.BlockSize)
+		block.Encrypt(output, input)
+		derived = append(derived, output...)
+		input = output
+	}
+
+	return derived[:len(key)], nil
+}
+
+// nfold stretches or shrinks input to size bytes with the RFC 3961 n-fold operation: the input is replicated, rotated
+// right by 13 bits each time, up to the least common multiple of both lengths and the copies are added with
+// end-around carry.
+func nfold(input []byte, size int) []byte {
+	inputLength := len(input)
+	lcm := size * inputLength / gcd(size, inputLength)
+	output := make([]byte, size)
+	sum := 0
+
+	for i := lcm - 1; i >= 0; i-- {
+		msbit := ((inputLength << 3) - 1 + ((inputLength<<3)+13)*(i/inputLength) + ((inputLength - i%inputLength) << 3)) % (inputLength << 3)
+		high := int(input[(inputLength-1-(msbit>>3))%inputLength])
+		low := int(input[(inputLength-(msbit>>3))%inputLength])
+
+		sum += ((high<<8 | low) >> ((msbit & 7) + 1)) & 0xff
+		sum += int(output[i%size])
+		output[i%size] = byte(sum)
+		sum >>= 8
+	}
+
+	for i := size - 1; sum != 0 && i >= 0; i-- {
+		sum += int(output[i])
+		output[i] = byte(sum)
+		sum >>= 8
+	}
+
+	return output
+}
+
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+
+	return a
+}
+
+func utf16LE(s string) []byte {
+	encoded := utf16.Encode([]rune(s))
+	data := make([]byte, 2*len(encoded))
+
+	for i, unit := range encoded {
+		binary.LittleEndian.PutUint16(data[2*i:], unit)
+	}
+
+	return data
+}
+
+// md4Sum returns the RFC 1320 MD4 hash of data. MD4 is broken and only implemented for the RC4-HMAC string-to-key
+// function.
+func md4Sum(data []byte) []byte {
+	state := [4]uint32{0x67452301, 0xefcdab89, 0x98badcfe, 0x10325476}
+
+	length := uint64(len(data)) << 3
+	message := append(append([]byte{}, data...), 0x80)
+
+	for len(message)%64 != 56 {
+		message = append(message, 0)
+	}
+
+	message = binary.LittleEndian.AppendUint64(message, length)
+
+	var x [16]uint32
+
+	for chunk := 0; chunk < len(message); chunk += 64 {
+		for i := range x {
+			x[i] = binary.LittleEndian.Uint32(message[chunk+4*i:])
+		}
+
+		a, b, c, d := state[0], state[1], state[2], state[3]
+
+		for _, i := range [16]int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15} {
+			f := (b & c) | (^b & d)
+			a, b, c, d = d, bits.RotateLeft32(a+f+x[i], [4]int{3, 7, 11, 19}[i%4]), b, c
+		}
+
+		for n, i := range [16]int{0, 4, 8, 12, 1, 5, 9, 13, 2, 6, 10, 14, 3, 7, 11, 15} {
+			g := (b & c) | (b & d) | (c & d)
+			a, b, c, d = d, bits.RotateLeft32(a+g+x[i]+0x5a827999, [4]int{3, 5, 9, 13}[n%4]), b, c
+		}
+
+		for n, i := range [16]int{0, 8, 4, 12, 2, 10, 6, 14, 1, 9, 5, 13, 3, 11, 7, 15} {
+			h := b ^ c ^ d
+			a, b, c, d = d, bits.RotateLeft32(a+h+x[i]+0x6ed9eba1, [4]int{3, 9, 11, 15}[n%4]), b, c
+		}
+
+		state[0] += a
+		state[1] += b
+		state[2] += c
+		state[3] += d
+	}
+
+	sum := make([]byte, 0, 16)
+
+	for _, word := range state {
+		sum = binary.LittleEndian.AppendUint32(sum, word)
+	}
+
+	return sum
+}
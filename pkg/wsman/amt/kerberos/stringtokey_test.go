@@ -0,0 +1,68 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package kerberos
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStringToKey(t *testing.T) {
+	// RFC 3962 appendix B and the RC4-HMAC key of "password"
+	tests := []struct {
+		encryptionType EncryptionType
+		passphrase     string
+		salt           string
+		iterationCount int
+		expected       string
+	}{
+		{EncryptionTypeAES128CTSHMACSHA196, "password", "ATHENA.MIT.EDUraeburn", 1, "42263c6e89f4fc28b8df68ee09799f15"},
+		{EncryptionTypeAES256CTSHMACSHA196, "password", "ATHENA.MIT.EDUraeburn", 1, "fe697b52bc0d3ce14432ba036a92e65bbb52280990a2fa27883998d72af30161"},
+		{EncryptionTypeAES128CTSHMACSHA196, "password", "ATHENA.MIT.EDUraeburn", 2, "c651bf29e2300ac27fa469d693bdda13"},
+		{EncryptionTypeAES256CTSHMACSHA196, "password", "ATHENA.MIT.EDUraeburn", 2, "a2e16d16b36069c135d5e9d2e25f896102685618b95914b467c67622225824ff"},
+		{EncryptionTypeAES128CTSHMACSHA196, "password", "ATHENA.MIT.EDUraeburn", 1200, "4c01cd46d632d01e6dbe230a01ed642a"},
+		{EncryptionTypeAES256CTSHMACSHA196, "password", "ATHENA.MIT.EDUraeburn", 1200, "55a6ac740ad17b4846941051e1e8b0a7548d93b0ab30a8bc3ff16280382b8c2a"},
+		{EncryptionTypeRC4HMAC, "password", "", 0, "8846f7eaee8fb117ad06bdd830b7586c"},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%s %d", test.encryptionType, test.iterationCount), func(t *testing.T) {
+			key, err := StringToKey(test.encryptionType, test.passphrase, test.salt, test.iterationCount)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, hex.EncodeToString(key))
+		})
+	}
+
+	key, err := StringToKey(EncryptionTypeAES128CTSHMACSHA196, "password", "ATHENA.MIT.EDUraeburn", 0)
+	require.NoError(t, err)
+	assert.Len(t, key, 16)
+
+	_, err = StringToKey(EncryptionType(3), "password", "", 0)
+	assert.ErrorIs(t, err, ErrUnsupportedEncryptionType)
+}
+
+func TestNfold(t *testing.T) {
+	// RFC 3961 appendix A.1
+	assert.Equal(t, "be072631276b1955", hex.EncodeToString(nfold([]byte("012345"), 8)))
+	assert.Equal(t, "78a07b6caf85fa", hex.EncodeToString(nfold([]byte("password"), 7)))
+	assert.Equal(t, "6b65726265726f737b9b5b2b93132b93", hex.EncodeToString(nfold([]byte("kerberos"), 16)))
+}
+
+func TestMD4(t *testing.T) {
+	// RFC 1320 appendix A.5
+	assert.Equal(t, "31d6cfe0d16ae931b73c59d7e0c089c0", hex.EncodeToString(md4Sum([]byte(""))))
+	assert.Equal(t, "a448017aaf21d8525fc10ae87aa6729d", hex.EncodeToString(md4Sum([]byte("abc"))))
+	assert.Equal(t, "e33b4ddc9c38f2199c3e7b164fcc0536", hex.EncodeToString(md4Sum([]byte("12345678901234567890123456789012345678901234567890123456789012345678901234567890"))))
+}
+
+func TestDefaultSalt(t *testing.T) {
+	assert.Equal(t, "ATHENA.MIT.EDUraeburn", DefaultSalt("ATHENA.MIT.EDU", "raeburn"))
+	assert.Equal(t, "EXAMPLE.COMHTTPamt.example.com", DefaultSalt("EXAMPLE.COM", "HTTP", "amt.example.com"))
+}
@@ -74,6 +74,26 @@ type (
 		H       string   `xml:"xmlns:h,attr"`
 		Enabled bool     `xml:"h:Enabled"`
 	}
+
+	// KerberosSettingDataRequest configures Kerberos authentication. The key is given either as a MasterKey or as a
+	// Passphrase, Salt and IterationCount from which the firmware derives the keys itself.
+	KerberosSettingDataRequest struct {
+		XMLName                  xml.Name                   `xml:"h:AMT_KerberosSettingData"`
+		H                        string                     `xml:"xmlns:h,attr"`
+		ElementName              string                     `xml:"h:ElementName,omitempty"`
+		InstanceID               string                     `xml:"h:InstanceID,omitempty"`
+		RealmName                string                     `xml:"h:RealmName,omitempty"`
+		ServicePrincipalName     []string                   `xml:"h:ServicePrincipalName,omitempty"`
+		ServicePrincipalProtocol []ServicePrincipalProtocol `xml:"h:ServicePrincipalProtocol,omitempty"`
+		KeyVersion               int                        `xml:"h:KeyVersion,omitempty"`
+		EncryptionAlgorithm      EncryptionAlgorithm        `xml:"h:EncryptionAlgorithm"`
+		MasterKey                []int                      `xml:"h:MasterKey,omitempty"`
+		MaximumClockTolerance    int                        `xml:"h:MaximumClockTolerance,omitempty"`
+		KrbEnabled               bool                       `xml:"h:KrbEnabled"`
+		Passphrase               string                     `xml:"h:Passphrase,omitempty"`
+		Salt                     string                     `xml:"h:Salt,omitempty"`
+		IterationCount           int                        `xml:"h:IterationCount,omitempty"`
+	}
 )
 
 // An array of 16-bit enumeration values, each of which corresponds to the string in the same position of ServicePrincipalName. In Intel AMT Release 6.0 and later releases this field is not in use and has no impact
@@ -106,3 +126,6 @@ type EncryptionAlgorithm int
 
 // ReturnValue is a 16-bit enumeration value that indicates the success or failure of an operation.
 type ReturnValue int
+
+// EncryptionType is a Kerberos encryption type number as assigned by IANA, used in keytabs and by StringToKey.
+type EncryptionType int
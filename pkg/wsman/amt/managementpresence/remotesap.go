@@ -26,11 +26,11 @@ func NewManagementPresenceRemoteSAPWithClient(wsmanMessageCreator *message.WSMan
 }
 
 // Delete removes a the specified instance.
-func (remoteSAP RemoteSAP) Delete(handle string) (response Response, err error) {
+func (remoteSAP RemoteSAP) Delete(handle string, opts ...base.HeaderOption) (response Response, err error) {
 	selector := message.Selector{Name: "Name", Value: handle}
 	response = Response{
 		Message: &client.Message{
-			XMLInput: remoteSAP.Base.Delete(selector),
+			XMLInput: remoteSAP.Base.Delete(selector, opts...),
 		},
 	}
 	// send the message to AMT
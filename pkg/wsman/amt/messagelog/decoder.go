@@ -17,6 +17,10 @@ const (
 	AMTMessageLog         string = "AMT_MessageLog"
 	GetRecords            string = "GetRecords"
 	PositionToFirstRecord string = "PositionToFirstRecord"
+	PositionAtRecord      string = "PositionAtRecord"
+	GetRecord             string = "GetRecord"
+	ClearLog              string = "ClearLog"
+	FreezeLog             string = "FreezeLog"
 	ValueNotFound         string = "Value not found in map"
 )
 
@@ -418,6 +422,34 @@ func (p PositionToFirstRecordReturnValue) String() string {
 	return ValueNotFound
 }
 
+const (
+	ReturnValueCompletedWithNoError ReturnValue = iota
+	ReturnValueNotSupported
+	ReturnValueUnspecifiedError
+	ReturnValueTimeout
+	ReturnValueFailed
+	ReturnValueInvalidParameter
+)
+
+// returnValueString is a map of the ReturnValue to their string representation.
+var returnValueString = map[ReturnValue]string{
+	ReturnValueCompletedWithNoError: "CompletedWithNoError",
+	ReturnValueNotSupported:         "NotSupported",
+	ReturnValueUnspecifiedError:     "UnspecifiedError",
+	ReturnValueTimeout:              "Timeout",
+	ReturnValueFailed:               "Failed",
+	ReturnValueInvalidParameter:     "InvalidParameter",
+}
+
+// String returns the string representation of the ReturnValue value.
+func (r ReturnValue) String() string {
+	if value, exists := returnValueString[r]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
 func parseEventLogResult(eventlogdata []string) (records []RawEventData, err error) {
 	records = make([]RawEventData, len(eventlogdata))
 
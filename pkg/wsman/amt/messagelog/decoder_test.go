@@ -353,3 +353,24 @@ func TestDecodeEventDetailString(t *testing.T) {
 		})
 	}
 }
+
+func TestReturnValue_String(t *testing.T) {
+	tests := []struct {
+		value    ReturnValue
+		expected string
+	}{
+		{ReturnValueCompletedWithNoError, "CompletedWithNoError"},
+		{ReturnValueNotSupported, "NotSupported"},
+		{ReturnValueUnspecifiedError, "UnspecifiedError"},
+		{ReturnValueTimeout, "Timeout"},
+		{ReturnValueFailed, "Failed"},
+		{ReturnValueInvalidParameter, "InvalidParameter"},
+		{ReturnValue(999), ValueNotFound},
+	}
+
+	for _, test := range tests {
+		if result := test.value.String(); result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
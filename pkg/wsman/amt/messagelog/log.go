@@ -47,7 +47,7 @@ func NewMessageLogWithClient(wsmanMessageCreator *message.WSManMessageCreator, c
 // GetRecords retrieves multiple records from event log.
 // The IterationIdentifier input parameter is a numeric value (starting at 1) which is the position of the first record in the log that should be extracted.
 // MaxReadRecords is set to 390.  If NoMoreRecords returns false, call this again setting the identifier to the start of the next IterationIdentifier.
-func (messageLog Service) GetRecords(identifier, maxReadRecords int) (response Response, err error) {
+func (messageLog Service) GetRecords(identifier, maxReadRecords int, opts ...base.HeaderOption) (response Response, err error) {
 	if identifier < 1 {
 		identifier = 1
 	}
@@ -58,7 +58,7 @@ func (messageLog Service) GetRecords(identifier, maxReadRecords int) (response R
 		maxReadRecords = MaxAMTRecords
 	}
 
-	header := messageLog.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTMessageLog, GetRecords), AMTMessageLog, nil, "", "")
+	header := messageLog.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTMessageLog, GetRecords), AMTMessageLog, nil, "", "", opts...)
 	body := messageLog.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(GetRecords), AMTMessageLog, &GetRecords_INPUT{
 		IterationIdentifier: identifier,
 		MaxReadRecords:      maxReadRecords,
@@ -93,8 +93,8 @@ func (messageLog Service) GetRecords(identifier, maxReadRecords int) (response R
 // Requests that an iteration of the MessageLog be established and that the iterator be set to the first entry in the Log. An identifier for the iterator is returned as an output parameter of the method. Regarding iteration, you have 2 choices: 1) Embed iteration data in the method call, and allow implementations to track/ store this data manually; or, 2) Iterate using a separate object (for example, class ActiveIterator) as an iteration agent. The first approach is used here for interoperability. The second requires an instance of the Iterator object for EACH iteration in progress. 2's functionality could be implemented underneath 1.
 //
 // Product Specific Usage: In current implementation this method doesn't have any affect. In order to get the events from the log user should just call GetRecord or GetRecords.
-func (messageLog Service) PositionToFirstRecord() (response Response, err error) {
-	header := messageLog.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTMessageLog, PositionToFirstRecord), AMTMessageLog, nil, "", "")
+func (messageLog Service) PositionToFirstRecord(opts ...base.HeaderOption) (response Response, err error) {
+	header := messageLog.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTMessageLog, PositionToFirstRecord), AMTMessageLog, nil, "", "", opts...)
 	body := messageLog.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(PositionToFirstRecord), AMTMessageLog, nil)
 	response = Response{
 		Message: &client.Message{
@@ -114,3 +114,83 @@ func (messageLog Service) PositionToFirstRecord() (response Response, err error)
 
 	return response, err
 }
+
+// PositionAtRecord moves the iterator to another record. When moveAbsolute is true, recordNumber is the position of the
+// record in the log, starting at 1; otherwise it is the number of records to move from the current position.
+func (messageLog Service) PositionAtRecord(identifier int, moveAbsolute bool, recordNumber int, opts ...base.HeaderOption) (response Response, err error) {
+	header := messageLog.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTMessageLog, PositionAtRecord), AMTMessageLog, nil, "", "", opts...)
+	body := messageLog.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(PositionAtRecord), AMTMessageLog, &PositionAtRecord_INPUT{
+		IterationIdentifier: identifier,
+		MoveAbsolute:        moveAbsolute,
+		RecordNumber:        recordNumber,
+	})
+
+	return messageLog.execute(header, body)
+}
+
+// GetRecord retrieves the record at the position of the iterator and advances the iterator to the next record.
+func (messageLog Service) GetRecord(identifier int, opts ...base.HeaderOption) (response Response, err error) {
+	if identifier < 1 {
+		identifier = 1
+	}
+
+	header := messageLog.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTMessageLog, GetRecord), AMTMessageLog, nil, "", "", opts...)
+	body := messageLog.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(GetRecord), AMTMessageLog, &GetRecord_INPUT{
+		IterationIdentifier: identifier,
+	})
+
+	response, err = messageLog.execute(header, body)
+	if err != nil {
+		return response, err
+	}
+
+	if response.Body.GetRecordResponse.RecordData == "" {
+		return response, nil
+	}
+
+	records, err := parseEventLogResult([]string{response.Body.GetRecordResponse.RecordData})
+	if err != nil {
+		return response, err
+	}
+
+	response.Body.GetRecordResponse.RawEventData = records[0]
+	response.Body.GetRecordResponse.RefinedEventData = decodeEventRecord(records)[0]
+
+	return response, nil
+}
+
+// ClearLog deletes all the records of the event log.
+func (messageLog Service) ClearLog(opts ...base.HeaderOption) (response Response, err error) {
+	header := messageLog.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTMessageLog, ClearLog), AMTMessageLog, nil, "", "", opts...)
+	body := messageLog.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(ClearLog), AMTMessageLog, nil)
+
+	return messageLog.execute(header, body)
+}
+
+// FreezeLog freezes or unfreezes the event log. No records are added to a frozen log; IsFrozen reports its state.
+func (messageLog Service) FreezeLog(freeze bool, opts ...base.HeaderOption) (response Response, err error) {
+	header := messageLog.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTMessageLog, FreezeLog), AMTMessageLog, nil, "", "", opts...)
+	body := messageLog.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(FreezeLog), AMTMessageLog, &FreezeLog_INPUT{Freeze: freeze})
+
+	return messageLog.execute(header, body)
+}
+
+func (messageLog Service) execute(header, body string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: messageLog.Base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+	// send the message to AMT
+	err = messageLog.Base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
@@ -23,7 +23,7 @@ func TestJson(t *testing.T) {
 			GetResponse: MessageLogResponse{},
 		},
 	}
-	expectedResult := "{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"GetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"Capabilities\":null,\"CharacterSet\":0,\"CreationClassName\":\"\",\"CurrentNumberOfRecords\":0,\"ElementName\":\"\",\"EnabledDefault\":0,\"EnabledState\":0,\"HealthState\":0,\"IsFrozen\":false,\"LastChange\":0,\"LogState\":0,\"MaxLogSize\":0,\"MaxNumberOfRecords\":0,\"MaxRecordSize\":0,\"Name\":\"\",\"OperationalStatus\":null,\"OverwritePolicy\":0,\"PercentageNearFull\":0,\"RequestedState\":0,\"SizeOfHeader\":0,\"SizeOfRecordHeader\":0,\"Status\":\"\"},\"EnumerateResponse\":{\"EnumerationContext\":\"\"},\"PullResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"MessageLogItems\":null},\"GetRecordsResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"IterationIdentifier\":0,\"NoMoreRecords\":false,\"RecordArray\":null,\"RawEventData\":null,\"RefinedEventData\":null,\"ReturnValue\":0},\"PositionToFirstRecordResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"IterationIdentifier\":0,\"ReturnValue\":0}}"
+	expectedResult := "{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"GetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"Capabilities\":null,\"CharacterSet\":0,\"CreationClassName\":\"\",\"CurrentNumberOfRecords\":0,\"ElementName\":\"\",\"EnabledDefault\":0,\"EnabledState\":0,\"HealthState\":0,\"IsFrozen\":false,\"LastChange\":0,\"LogState\":0,\"MaxLogSize\":0,\"MaxNumberOfRecords\":0,\"MaxRecordSize\":0,\"Name\":\"\",\"OperationalStatus\":null,\"OverwritePolicy\":0,\"PercentageNearFull\":0,\"RequestedState\":0,\"SizeOfHeader\":0,\"SizeOfRecordHeader\":0,\"Status\":\"\"},\"EnumerateResponse\":{\"EnumerationContext\":\"\"},\"PullResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"MessageLogItems\":null},\"GetRecordsResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"IterationIdentifier\":0,\"NoMoreRecords\":false,\"RecordArray\":null,\"RawEventData\":null,\"RefinedEventData\":null,\"ReturnValue\":0},\"PositionToFirstRecordResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"IterationIdentifier\":0,\"ReturnValue\":0},\"PositionAtRecordResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"IterationIdentifier\":0,\"RecordNumber\":0,\"ReturnValue\":0},\"GetRecordResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"IterationIdentifier\":0,\"NoMoreRecords\":false,\"RecordData\":\"\",\"RawEventData\":{\"TimeStamp\":0,\"DeviceAddress\":0,\"EventSensorType\":0,\"EventType\":0,\"EventOffset\":0,\"EventSourceType\":0,\"EventSeverity\":0,\"SensorNumber\":0,\"Entity\":0,\"EntityInstance\":0,\"EventData\":null},\"RefinedEventData\":{\"TimeStamp\":\"0001-01-01T00:00:00Z\",\"DeviceAddress\":0,\"Description\":\"\",\"Entity\":\"\",\"EntityInstance\":0,\"EventData\":null,\"EventSensorType\":0,\"EventType\":0,\"EventOffset\":0,\"EventSourceType\":0,\"EventSeverity\":\"\",\"SensorNumber\":0},\"ReturnValue\":0},\"ClearLogResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0},\"FreezeLogResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0}}"
 	result := response.JSON()
 	assert.Equal(t, expectedResult, result)
 }
@@ -34,7 +34,7 @@ func TestYaml(t *testing.T) {
 			GetResponse: MessageLogResponse{},
 		},
 	}
-	expectedResult := "xmlname:\n    space: \"\"\n    local: \"\"\ngetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    capabilities: []\n    characterset: 0\n    creationclassname: \"\"\n    currentnumberofrecords: 0\n    elementname: \"\"\n    enableddefault: 0\n    enabledstate: 0\n    healthstate: 0\n    isfrozen: false\n    lastchange: 0\n    logstate: 0\n    maxlogsize: 0\n    maxnumberofrecords: 0\n    maxrecordsize: 0\n    name: \"\"\n    operationalstatus: []\n    overwritepolicy: 0\n    percentagenearfull: 0\n    requestedstate: 0\n    sizeofheader: 0\n    sizeofrecordheader: 0\n    status: \"\"\nenumerateresponse:\n    enumerationcontext: \"\"\npullresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    messagelogitems: []\ngetrecordsresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    iterationidentifier: 0\n    nomorerecords: false\n    recordarray: []\n    raweventdata: []\n    refinedeventdata: []\n    returnvalue: 0\npositiontofirstrecordresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    iterationidentifier: 0\n    returnvalue: 0\n"
+	expectedResult := "xmlname:\n    space: \"\"\n    local: \"\"\ngetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    capabilities: []\n    characterset: 0\n    creationclassname: \"\"\n    currentnumberofrecords: 0\n    elementname: \"\"\n    enableddefault: 0\n    enabledstate: 0\n    healthstate: 0\n    isfrozen: false\n    lastchange: 0\n    logstate: 0\n    maxlogsize: 0\n    maxnumberofrecords: 0\n    maxrecordsize: 0\n    name: \"\"\n    operationalstatus: []\n    overwritepolicy: 0\n    percentagenearfull: 0\n    requestedstate: 0\n    sizeofheader: 0\n    sizeofrecordheader: 0\n    status: \"\"\nenumerateresponse:\n    enumerationcontext: \"\"\npullresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    messagelogitems: []\ngetrecordsresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    iterationidentifier: 0\n    nomorerecords: false\n    recordarray: []\n    raweventdata: []\n    refinedeventdata: []\n    returnvalue: 0\npositiontofirstrecordresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    iterationidentifier: 0\n    returnvalue: 0\npositionatrecordresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    iterationidentifier: 0\n    recordnumber: 0\n    returnvalue: 0\ngetrecordresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    iterationidentifier: 0\n    nomorerecords: false\n    recorddata: \"\"\n    raweventdata:\n        timestamp: 0\n        deviceaddress: 0\n        eventsensortype: 0\n        eventtype: 0\n        eventoffset: 0\n        eventsourcetype: 0\n        eventseverity: 0\n        sensornumber: 0\n        entity: 0\n        entityinstance: 0\n        eventdata: []\n    refinedeventdata:\n        timestamp: 0001-01-01T00:00:00Z\n        deviceaddress: 0\n        description: \"\"\n        entity: \"\"\n        entityinstance: 0\n        eventdata: []\n        eventsensortype: 0\n        eventtype: 0\n        eventoffset: 0\n        eventsourcetype: 0\n        eventseverity: \"\"\n        sensornumber: 0\n    returnvalue: 0\nclearlogresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\nfreezelogresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\n"
 	result := response.YAML()
 	assert.Equal(t, expectedResult, result)
 }
@@ -286,6 +286,112 @@ func TestPositiveAMT_MessageLog(t *testing.T) {
 					},
 				},
 			},
+			// GET RECORD
+			{
+				"should return a valid amt_MessageLog GetRecord wsman message",
+				AMTMessageLog,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_MessageLog/GetRecord`,
+				`<h:GetRecord_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_MessageLog"><h:IterationIdentifier>1</h:IterationIdentifier></h:GetRecord_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = "GetRecord"
+
+					return elementUnderTest.GetRecord(1)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					GetRecordResponse: GetRecordResponse{
+						XMLName:             xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_MessageLog", Local: "GetRecord_OUTPUT"},
+						IterationIdentifier: 2,
+						RecordData:          "Y8iYZf8GbwVoEP8mYaoKAAAAAAAA",
+						RawEventData: RawEventData{
+							TimeStamp:       0x6598c863,
+							DeviceAddress:   0xff,
+							EventSensorType: 0x6,
+							EventType:       0x6f,
+							EventOffset:     0x5,
+							EventSourceType: 0x68,
+							EventSeverity:   0x10,
+							SensorNumber:    0xff,
+							Entity:          0x26,
+							EntityInstance:  0x61,
+							EventData:       []uint8{0xaa, 0xa, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0},
+						},
+						RefinedEventData: RefinedEventData{
+							TimeStamp:       time.Unix(int64(0x6598c863), 0),
+							Description:     "Authentication failed 10 times. The system may be under attack.",
+							DeviceAddress:   255,
+							Entity:          "Intel(r) ME",
+							EntityInstance:  97,
+							EventData:       []uint8{0xaa, 0xa, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0},
+							EventOffset:     5,
+							EventSensorType: 6,
+							EventSeverity:   "Critical condition",
+							EventSourceType: 104,
+							EventType:       111,
+							SensorNumber:    255,
+						},
+					},
+				},
+			},
+			// POSITION AT RECORD
+			{
+				"should return a valid amt_MessageLog PositionAtRecord wsman message",
+				AMTMessageLog,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_MessageLog/PositionAtRecord`,
+				`<h:PositionAtRecord_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_MessageLog"><h:IterationIdentifier>1</h:IterationIdentifier><h:MoveAbsolute>true</h:MoveAbsolute><h:RecordNumber>5</h:RecordNumber></h:PositionAtRecord_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = "PositionAtRecord"
+
+					return elementUnderTest.PositionAtRecord(1, true, 5)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PositionAtRecordResponse: PositionAtRecordResponse{
+						XMLName:             xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_MessageLog", Local: "PositionAtRecord_OUTPUT"},
+						IterationIdentifier: 5,
+						RecordNumber:        5,
+						ReturnValue:         ReturnValueCompletedWithNoError,
+					},
+				},
+			},
+			// CLEAR LOG
+			{
+				"should return a valid amt_MessageLog ClearLog wsman message",
+				AMTMessageLog,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_MessageLog/ClearLog`,
+				`<h:ClearLog_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_MessageLog"></h:ClearLog_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = "ClearLog"
+
+					return elementUnderTest.ClearLog()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					ClearLogResponse: ClearLogResponse{
+						XMLName:     xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_MessageLog", Local: "ClearLog_OUTPUT"},
+						ReturnValue: ReturnValueCompletedWithNoError,
+					},
+				},
+			},
+			// FREEZE LOG
+			{
+				"should return a valid amt_MessageLog FreezeLog wsman message",
+				AMTMessageLog,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_MessageLog/FreezeLog`,
+				`<h:FreezeLog_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_MessageLog"><h:Freeze>true</h:Freeze></h:FreezeLog_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = "FreezeLog"
+
+					return elementUnderTest.FreezeLog(true)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					FreezeLogResponse: FreezeLogResponse{
+						XMLName:     xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_MessageLog", Local: "FreezeLog_OUTPUT"},
+						ReturnValue: ReturnValueCompletedWithNoError,
+					},
+				},
+			},
 		}
 
 		for _, test := range tests {
@@ -0,0 +1,93 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package messagelog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"time"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/base"
+)
+
+var ErrGetRecordsFailed = errors.New("failed to get event log records")
+
+// Records returns all the records of the event log in log order, reading them with GetRecords in batches of
+// MaxAMTRecords until NoMoreRecords is set. Iteration stops at the first error.
+func (messageLog Service) Records(opts ...base.HeaderOption) iter.Seq2[RefinedEventData, error] {
+	return func(yield func(RefinedEventData, error) bool) {
+		messageLog.readRecords(1, yield, opts)
+	}
+}
+
+// Follow returns all the records of the event log, like Records, and then polls every interval for records added after
+// the last one read, until ctx is done or the caller stops iterating. Polling continues from the last
+// IterationIdentifier, so it only sees records that the device appends to the end of the log.
+func (messageLog Service) Follow(ctx context.Context, interval time.Duration, opts ...base.HeaderOption) iter.Seq2[RefinedEventData, error] {
+	return func(yield func(RefinedEventData, error) bool) {
+		identifier := 1
+
+		for {
+			next, ok := messageLog.readRecords(identifier, yield, opts)
+			if !ok {
+				return
+			}
+
+			identifier = next
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(interval):
+			}
+		}
+	}
+}
+
+// readRecords yields the records from identifier to the end of the log. It returns the identifier of the record after
+// the last one, and false when iteration must stop.
+func (messageLog Service) readRecords(identifier int, yield func(RefinedEventData, error) bool, opts []base.HeaderOption) (int, bool) {
+	for {
+		response, err := messageLog.GetRecords(identifier, MaxAMTRecords, opts...)
+		if err != nil {
+			yield(RefinedEventData{}, err)
+
+			return identifier, false
+		}
+
+		output := response.Body.GetRecordsResponse
+
+		switch output.ReturnValue {
+		case GetRecordsReturnValueCompletedWithNoError:
+		case GetRecordsReturnValueInvalidRecordPointed, GetRecordsReturnValueNoRecordExistsInLog:
+			// the identifier is past the end of the log
+			return identifier, true
+		default:
+			yield(RefinedEventData{}, fmt.Errorf("%w: %s", ErrGetRecordsFailed, output.ReturnValue))
+
+			return identifier, false
+		}
+
+		for _, record := range output.RefinedEventData {
+			if !yield(record, nil) {
+				return identifier, false
+			}
+		}
+
+		next := output.IterationIdentifier
+		if output.NoMoreRecords || next <= identifier {
+			next = identifier + len(output.RefinedEventData)
+		}
+
+		if output.NoMoreRecords || next == identifier {
+			return next, true
+		}
+
+		identifier = next
+	}
+}
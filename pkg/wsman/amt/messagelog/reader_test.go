@@ -0,0 +1,154 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package messagelog
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+var iterationIdentifierPattern = regexp.MustCompile(`<h:IterationIdentifier>(\d+)</h:IterationIdentifier>`)
+
+// eventLogClient answers GetRecords requests from an in-memory event log.
+type eventLogClient struct {
+	client.WSMan
+
+	mu          sync.Mutex
+	records     []string
+	returnValue GetRecordsReturnValue
+	reads       []int
+}
+
+func (c *eventLogClient) Post(msg string) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	identifier, _ := strconv.Atoi(iterationIdentifierPattern.FindStringSubmatch(msg)[1])
+	c.reads = append(c.reads, identifier)
+
+	returnValue := c.returnValue
+	if identifier > len(c.records) {
+		returnValue = GetRecordsReturnValueInvalidRecordPointed
+	}
+
+	page := c.records[min(identifier-1, len(c.records)):min(identifier-1+MaxAMTRecords, len(c.records))]
+
+	var records strings.Builder
+	for _, record := range page {
+		records.WriteString("<RecordArray>" + record + "</RecordArray>")
+	}
+
+	return fmt.Appendf(nil, "<Envelope><Header></Header><Body><GetRecords_OUTPUT><IterationIdentifier>%d</IterationIdentifier><NoMoreRecords>%t</NoMoreRecords>%s<ReturnValue>%d</ReturnValue></GetRecords_OUTPUT></Body></Envelope>",
+		identifier+len(page), identifier-1+len(page) >= len(c.records), records.String(), returnValue), nil
+}
+
+func (c *eventLogClient) add(count int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for range count {
+		record := binary.LittleEndian.AppendUint32(nil, uint32(1700000000+len(c.records)))
+		record = append(record, make([]byte, 17)...)
+		c.records = append(c.records, base64.StdEncoding.EncodeToString(record))
+	}
+}
+
+func timeStamps(t *testing.T, records func(func(RefinedEventData, error) bool), limit int) []int64 {
+	t.Helper()
+
+	var result []int64
+
+	for record, err := range records {
+		require.NoError(t, err)
+
+		result = append(result, record.TimeStamp.Unix()-1700000000)
+		if len(result) == limit {
+			break
+		}
+	}
+
+	return result
+}
+
+func TestRecords(t *testing.T) {
+	log := &eventLogClient{}
+	log.add(MaxAMTRecords + 10)
+	service := NewMessageLogWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), log)
+
+	result := timeStamps(t, service.Records(), -1)
+	require.Len(t, result, MaxAMTRecords+10)
+	assert.Equal(t, int64(0), result[0])
+	assert.Equal(t, int64(MaxAMTRecords+9), result[len(result)-1])
+	assert.Equal(t, []int{1, MaxAMTRecords + 1}, log.reads)
+
+	t.Run("empty log", func(t *testing.T) {
+		empty := &eventLogClient{}
+		service := NewMessageLogWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), empty)
+
+		assert.Empty(t, timeStamps(t, service.Records(), -1))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		log.returnValue = GetRecordsReturnValueNotSupported
+
+		var last error
+		for _, err := range service.Records() {
+			last = err
+		}
+
+		assert.ErrorIs(t, last, ErrGetRecordsFailed)
+	})
+}
+
+func TestFollow(t *testing.T) {
+	log := &eventLogClient{}
+	log.add(3)
+	service := NewMessageLogWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), log)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	records := service.Follow(ctx, time.Millisecond)
+
+	var result []int64
+
+	for record, err := range records {
+		require.NoError(t, err)
+
+		result = append(result, record.TimeStamp.Unix()-1700000000)
+
+		if len(result) == 3 {
+			log.add(2)
+		}
+
+		if len(result) == 5 {
+			break
+		}
+	}
+
+	assert.Equal(t, []int64{0, 1, 2, 3, 4}, result)
+	assert.Equal(t, 4, log.reads[len(log.reads)-1])
+
+	cancel()
+
+	// a cancelled context ends the iteration once the log has been read
+	assert.Len(t, timeStamps(t, records, -1), 5)
+}
@@ -31,6 +31,10 @@ type (
 		PullResponse                  PullResponse
 		GetRecordsResponse            GetRecordsResponse
 		PositionToFirstRecordResponse PositionToFirstRecordResponse
+		PositionAtRecordResponse      PositionAtRecordResponse
+		GetRecordResponse             GetRecordResponse
+		ClearLogResponse              ClearLogResponse
+		FreezeLogResponse             FreezeLogResponse
 	}
 
 	PullResponse struct {
@@ -80,6 +84,33 @@ type (
 		ReturnValue         PositionToFirstRecordReturnValue `xml:"ReturnValue"`         // ValueMap={0, 1, 2} Values={Completed with No Error, Not Supported, No record exists}
 	}
 
+	PositionAtRecordResponse struct {
+		XMLName             xml.Name    `xml:"PositionAtRecord_OUTPUT"`
+		IterationIdentifier int         `xml:"IterationIdentifier"` // An identifier for the iterator, positioned at the requested record.
+		RecordNumber        int         `xml:"RecordNumber"`        // The number of records the iterator moved, or the absolute record number when MoveAbsolute is true.
+		ReturnValue         ReturnValue `xml:"ReturnValue"`         // ValueMap={0, 1, 2, 3, 4, 5} Values={Completed with no error, Not supported, Unspecified Error, Timeout, Failed, Invalid Parameter}
+	}
+
+	GetRecordResponse struct {
+		XMLName             xml.Name              `xml:"GetRecord_OUTPUT"`
+		IterationIdentifier int                   `xml:"IterationIdentifier"` // An identifier for the iterator, advanced to the next record.
+		NoMoreRecords       bool                  `xml:"NoMoreRecords"`       // Indicates that there are no more records to read
+		RecordData          string                `xml:"RecordData"`          // The record encoded as Base64
+		RawEventData        RawEventData          `xml:"RawEventData"`        // Raw event data of the record
+		RefinedEventData    RefinedEventData      `xml:"RefinedEventData"`    // Refined event data of the record
+		ReturnValue         GetRecordsReturnValue `xml:"ReturnValue"`         // ValueMap={0, 1, 2, 3} Values={Completed with No Error, Not Supported, Invalid record pointed, No record exists in log}
+	}
+
+	ClearLogResponse struct {
+		XMLName     xml.Name    `xml:"ClearLog_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"` // ValueMap={0, 1, 2, 3, 4, 5} Values={Completed with no error, Not supported, Unspecified Error, Timeout, Failed, Invalid Parameter}
+	}
+
+	FreezeLogResponse struct {
+		XMLName     xml.Name    `xml:"FreezeLog_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"` // ValueMap={0, 1, 2, 3, 4, 5} Values={Completed with no error, Not supported, Unspecified Error, Timeout, Failed, Invalid Parameter}
+	}
+
 	RawEventData struct {
 		TimeStamp       uint32
 		DeviceAddress   uint8
@@ -144,6 +175,9 @@ type (
 
 	// PositionToFirstRecordReturnValue is an integer indicating the return value of the PositionToFirstRecord operation.
 	PositionToFirstRecordReturnValue int
+
+	// ReturnValue is an integer indicating the return value of the ClearLog, FreezeLog and PositionAtRecord operations.
+	ReturnValue int
 )
 
 // INPUTS.
@@ -154,6 +188,26 @@ type GetRecords_INPUT struct {
 	MaxReadRecords      int      `xml:"h:MaxReadRecords"`      // Maximum number of records to read
 }
 
+type GetRecord_INPUT struct {
+	XMLName             xml.Name `xml:"h:GetRecord_INPUT"`
+	H                   string   `xml:"xmlns:h,attr"`
+	IterationIdentifier int      `xml:"h:IterationIdentifier"` // An identifier for the iterator.
+}
+
+type PositionAtRecord_INPUT struct {
+	XMLName             xml.Name `xml:"h:PositionAtRecord_INPUT"`
+	H                   string   `xml:"xmlns:h,attr"`
+	IterationIdentifier int      `xml:"h:IterationIdentifier"` // An identifier for the iterator.
+	MoveAbsolute        bool     `xml:"h:MoveAbsolute"`        // Whether RecordNumber is an absolute position or relative to the iterator
+	RecordNumber        int      `xml:"h:RecordNumber"`        // The record to move to, or the number of records to move
+}
+
+type FreezeLog_INPUT struct {
+	XMLName xml.Name `xml:"h:FreezeLog_INPUT"`
+	H       string   `xml:"xmlns:h,attr"`
+	Freeze  bool     `xml:"h:Freeze"` // Whether to freeze or unfreeze the log
+}
+
 const (
 	// Intel AMT can return 400 records in a single GetRecords call, but we limit it to 390.
 	MaxAMTRecords = 390
@@ -8,9 +8,11 @@ package amt
 
 import (
 	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/agentpresence"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/alarmclock"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/asset"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditpolicy"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/authorization"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/boot"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/cryptographiccapabilities"
@@ -29,52 +31,70 @@ import (
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/redirection"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/remoteaccess"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/setupandconfiguration"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/systemdefense"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/systempowerscheme"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/thirdpartydatastorage"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/timesynchronization"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/tls"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/userinitiatedconnection"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/webui"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/wifiportconfiguration"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
 )
 
 // Messages contains the supported AMT classes.
 type Messages struct {
-	wsmanMessageCreator             *message.WSManMessageCreator
-	AlarmClockService               alarmclock.Service
-	AssetTable                      asset.Table
-	AssetTableService               asset.Service
-	AuditLog                        auditlog.Service
-	AuthorizationService            authorization.Service
-	BootCapabilities                boot.Capabilities
-	CryptographicCapabilities       cryptographiccapabilities.Service
-	BootSettingData                 boot.SettingData
-	EventLogEntry                   eventlogentry.Service
-	EnvironmentDetectionSettingData environmentdetection.SettingData
-	EthernetPortSettings            ethernetport.Settings
-	GeneralSettings                 general.Settings
-	Hdr8021Filter                   hdr8021filter.Service
-	IEEE8021xCredentialContext      ieee8021x.CredentialContext
-	IEEE8021xProfile                ieee8021x.Profile
-	KerberosSettingData             kerberos.SettingData
-	ManagementPresenceRemoteSAP     managementpresence.RemoteSAP
-	MessageLog                      messagelog.Service
-	MPSUsernamePassword             mps.UsernamePassword
-	PublicKeyCertificate            publickey.Certificate
-	PublicKeyManagementService      publickey.ManagementService
-	PublicPrivateKeyPair            publicprivate.KeyPair
-	RedirectionService              redirection.Service
-	RemoteAccessCapabilities        remoteaccess.Capabilities
-	RemoteAccessPolicyAppliesToMPS  remoteaccess.PolicyAppliesToMPS
-	RemoteAccessPolicyRule          remoteaccess.PolicyRule
-	RemoteAccessService             remoteaccess.Service
-	SetupAndConfigurationService    setupandconfiguration.Service
-	SystemPowerScheme               systempowerscheme.Service
-	TimeSynchronizationService      timesynchronization.Service
-	TLSCredentialContext            tls.CredentialContext
-	TLSProtocolEndpointCollection   tls.ProtocolEndpointCollection
-	TLSSettingData                  tls.SettingData
-	UserInitiatedConnectionService  userinitiatedconnection.Service
-	WiFiPortConfigurationService    wifiportconfiguration.Service
+	wsmanMessageCreator                        *message.WSManMessageCreator
+	ActiveFilterStatistics                     systemdefense.ActiveFilterStatistics
+	AgentPresenceService                       agentpresence.Service
+	AgentPresenceWatchdog                      agentpresence.Watchdog
+	AgentPresenceWatchdogAction                agentpresence.WatchdogAction
+	AgentPresenceWatchdogVA                    agentpresence.WatchdogVA
+	AlarmClockService                          alarmclock.Service
+	AssetTable                                 asset.Table
+	AssetTableService                          asset.Service
+	AuditLog                                   auditlog.Service
+	AuditPolicyRule                            auditpolicy.Service
+	AuthorizationService                       authorization.Service
+	BootCapabilities                           boot.Capabilities
+	CryptographicCapabilities                  cryptographiccapabilities.Service
+	BootSettingData                            boot.SettingData
+	CRL                                        publickey.CRL
+	EventLogEntry                              eventlogentry.Service
+	EnvironmentDetectionSettingData            environmentdetection.SettingData
+	EthernetPortSettings                       ethernetport.Settings
+	GeneralSettings                            general.Settings
+	GeneralSystemDefenseCapabilities           systemdefense.Capabilities
+	Hdr8021Filter                              hdr8021filter.Service
+	IEEE8021xCredentialContext                 ieee8021x.CredentialContext
+	IEEE8021xProfile                           ieee8021x.Profile
+	IPHeadersFilter                            systemdefense.IPHeadersFilter
+	KerberosSettingData                        kerberos.SettingData
+	ManagementPresenceRemoteSAP                managementpresence.RemoteSAP
+	MessageLog                                 messagelog.Service
+	MPSUsernamePassword                        mps.UsernamePassword
+	NetworkPortDefensePolicy                   systemdefense.NetworkPortDefensePolicy
+	NetworkPortSystemDefensePolicy             systemdefense.NetworkPortSystemDefensePolicy
+	PublicKeyCertificate                       publickey.Certificate
+	PublicKeyManagementService                 publickey.ManagementService
+	PublicPrivateKeyPair                       publicprivate.KeyPair
+	RedirectionService                         redirection.Service
+	RemoteAccessCapabilities                   remoteaccess.Capabilities
+	RemoteAccessPolicyAppliesToMPS             remoteaccess.PolicyAppliesToMPS
+	RemoteAccessPolicyRule                     remoteaccess.PolicyRule
+	RemoteAccessService                        remoteaccess.Service
+	SetupAndConfigurationService               setupandconfiguration.Service
+	SystemDefensePolicy                        systemdefense.Policy
+	SystemPowerScheme                          systempowerscheme.Service
+	ThirdPartyDataStorageAdministrationService thirdpartydatastorage.AdministrationService
+	ThirdPartyDataStorageService               thirdpartydatastorage.Service
+	TimeSynchronizationService                 timesynchronization.Service
+	TLSCredentialContext                       tls.CredentialContext
+	TLSProtocolEndpointCollection              tls.ProtocolEndpointCollection
+	TLSSettingData                             tls.SettingData
+	UserInitiatedConnectionService             userinitiatedconnection.Service
+	WebUIService                               webui.Service
+	WiFiPortConfigurationService               wifiportconfiguration.Service
 }
 
 // NewMessages instantiates a new instance of amt Messages.
@@ -84,25 +104,36 @@ func NewMessages(client client.WSMan) Messages {
 	m := Messages{
 		wsmanMessageCreator: wsmanMessageCreator,
 	}
+	m.ActiveFilterStatistics = systemdefense.NewActiveFilterStatisticsWithClient(wsmanMessageCreator, client)
+	m.AgentPresenceService = agentpresence.NewServiceWithClient(wsmanMessageCreator, client)
+	m.AgentPresenceWatchdog = agentpresence.NewWatchdogWithClient(wsmanMessageCreator, client)
+	m.AgentPresenceWatchdogAction = agentpresence.NewWatchdogActionWithClient(wsmanMessageCreator, client)
+	m.AgentPresenceWatchdogVA = agentpresence.NewWatchdogVAWithClient(wsmanMessageCreator, client)
 	m.AlarmClockService = alarmclock.NewServiceWithClient(wsmanMessageCreator, client)
 	m.AssetTable = asset.NewTableWithClient(wsmanMessageCreator, client)
 	m.AssetTableService = asset.NewServiceWithClient(wsmanMessageCreator, client)
 	m.AuditLog = auditlog.NewAuditLogWithClient(wsmanMessageCreator, client)
+	m.AuditPolicyRule = auditpolicy.NewServiceWithClient(wsmanMessageCreator, client)
 	m.AuthorizationService = authorization.NewServiceWithClient(wsmanMessageCreator, client)
 	m.BootCapabilities = boot.NewBootCapabilitiesWithClient(wsmanMessageCreator, client)
 	m.CryptographicCapabilities = cryptographiccapabilities.NewServiceWithClient(wsmanMessageCreator, client)
 	m.BootSettingData = boot.NewBootSettingDataWithClient(wsmanMessageCreator, client)
+	m.CRL = publickey.NewCRLWithClient(wsmanMessageCreator, client)
 	m.EventLogEntry = eventlogentry.NewServiceWithClient(wsmanMessageCreator, client)
 	m.EnvironmentDetectionSettingData = environmentdetection.NewEnvironmentDetectionSettingDataWithClient(wsmanMessageCreator, client)
 	m.EthernetPortSettings = ethernetport.NewEthernetPortSettingsWithClient(wsmanMessageCreator, client)
 	m.GeneralSettings = general.NewGeneralSettingsWithClient(wsmanMessageCreator, client)
 	m.Hdr8021Filter = hdr8021filter.NewServiceWithClient(wsmanMessageCreator, client)
+	m.GeneralSystemDefenseCapabilities = systemdefense.NewCapabilitiesWithClient(wsmanMessageCreator, client)
 	m.IEEE8021xCredentialContext = ieee8021x.NewIEEE8021xCredentialContextWithClient(wsmanMessageCreator, client)
 	m.IEEE8021xProfile = ieee8021x.NewIEEE8021xProfileWithClient(wsmanMessageCreator, client)
+	m.IPHeadersFilter = systemdefense.NewIPHeadersFilterWithClient(wsmanMessageCreator, client)
 	m.KerberosSettingData = kerberos.NewKerberosSettingDataWithClient(wsmanMessageCreator, client)
 	m.ManagementPresenceRemoteSAP = managementpresence.NewManagementPresenceRemoteSAPWithClient(wsmanMessageCreator, client)
 	m.MessageLog = messagelog.NewMessageLogWithClient(wsmanMessageCreator, client)
 	m.MPSUsernamePassword = mps.NewMPSUsernamePasswordWithClient(wsmanMessageCreator, client)
+	m.NetworkPortDefensePolicy = systemdefense.NewNetworkPortDefensePolicyWithClient(wsmanMessageCreator, client)
+	m.NetworkPortSystemDefensePolicy = systemdefense.NewNetworkPortSystemDefensePolicyWithClient(wsmanMessageCreator, client)
 	m.PublicKeyCertificate = publickey.NewPublicKeyCertificateWithClient(wsmanMessageCreator, client)
 	m.PublicKeyManagementService = publickey.NewPublicKeyManagementServiceWithClient(wsmanMessageCreator, client)
 	m.PublicPrivateKeyPair = publicprivate.NewPublicPrivateKeyPairWithClient(wsmanMessageCreator, client)
@@ -112,12 +143,16 @@ func NewMessages(client client.WSMan) Messages {
 	m.RemoteAccessPolicyRule = remoteaccess.NewPolicyRuleWithClient(wsmanMessageCreator, client)
 	m.RemoteAccessService = remoteaccess.NewRemoteAccessServiceWithClient(wsmanMessageCreator, client)
 	m.SetupAndConfigurationService = setupandconfiguration.NewSetupAndConfigurationServiceWithClient(wsmanMessageCreator, client)
+	m.SystemDefensePolicy = systemdefense.NewPolicyWithClient(wsmanMessageCreator, client)
 	m.SystemPowerScheme = systempowerscheme.NewServiceWithClient(wsmanMessageCreator, client)
+	m.ThirdPartyDataStorageAdministrationService = thirdpartydatastorage.NewAdministrationServiceWithClient(wsmanMessageCreator, client)
+	m.ThirdPartyDataStorageService = thirdpartydatastorage.NewServiceWithClient(wsmanMessageCreator, client)
 	m.TimeSynchronizationService = timesynchronization.NewTimeSynchronizationServiceWithClient(wsmanMessageCreator, client)
 	m.TLSCredentialContext = tls.NewTLSCredentialContextWithClient(wsmanMessageCreator, client)
 	m.TLSProtocolEndpointCollection = tls.NewTLSProtocolEndpointCollectionWithClient(wsmanMessageCreator, client)
 	m.TLSSettingData = tls.NewTLSSettingDataWithClient(wsmanMessageCreator, client)
 	m.UserInitiatedConnectionService = userinitiatedconnection.NewUserInitiatedConnectionServiceWithClient(wsmanMessageCreator, client)
+	m.WebUIService = webui.NewWebUIServiceWithClient(wsmanMessageCreator, client)
 	m.WiFiPortConfigurationService = wifiportconfiguration.NewWiFiPortConfigurationServiceWithClient(wsmanMessageCreator, client)
 
 	return m
@@ -9,9 +9,11 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/agentpresence"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/alarmclock"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/asset"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditpolicy"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/authorization"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/boot"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/cryptographiccapabilities"
@@ -30,10 +32,13 @@ import (
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/redirection"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/remoteaccess"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/setupandconfiguration"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/systemdefense"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/systempowerscheme"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/thirdpartydatastorage"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/timesynchronization"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/tls"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/userinitiatedconnection"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/webui"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/wifiportconfiguration"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
 )
@@ -46,6 +51,26 @@ func TestNewMessages(t *testing.T) {
 		t.Error("wsmanMessageCreator is not initialized")
 	}
 
+	if reflect.DeepEqual(m.ActiveFilterStatistics, systemdefense.ActiveFilterStatistics{}) {
+		t.Error("ActiveFilterStatistics is not initialized")
+	}
+
+	if reflect.DeepEqual(m.AgentPresenceService, agentpresence.Service{}) {
+		t.Error("AgentPresenceService is not initialized")
+	}
+
+	if reflect.DeepEqual(m.AgentPresenceWatchdog, agentpresence.Watchdog{}) {
+		t.Error("AgentPresenceWatchdog is not initialized")
+	}
+
+	if reflect.DeepEqual(m.AgentPresenceWatchdogAction, agentpresence.WatchdogAction{}) {
+		t.Error("AgentPresenceWatchdogAction is not initialized")
+	}
+
+	if reflect.DeepEqual(m.AgentPresenceWatchdogVA, agentpresence.WatchdogVA{}) {
+		t.Error("AgentPresenceWatchdogVA is not initialized")
+	}
+
 	if reflect.DeepEqual(m.AlarmClockService, alarmclock.Service{}) {
 		t.Error("AlarmClockService is not initialized")
 	}
@@ -54,6 +79,10 @@ func TestNewMessages(t *testing.T) {
 		t.Error("AuditLog is not initialized")
 	}
 
+	if reflect.DeepEqual(m.AuditPolicyRule, auditpolicy.Service{}) {
+		t.Error("AuditPolicyRule is not initialized")
+	}
+
 	if reflect.DeepEqual(m.AuthorizationService, authorization.Service{}) {
 		t.Error("AuthorizationService is not initialized")
 	}
@@ -78,6 +107,10 @@ func TestNewMessages(t *testing.T) {
 		t.Error("BootSettingData is not initialized")
 	}
 
+	if reflect.DeepEqual(m.CRL, publickey.CRL{}) {
+		t.Error("CRL is not initialized")
+	}
+
 	if reflect.DeepEqual(m.EventLogEntry, eventlogentry.Service{}) {
 		t.Error("EventLogEntry is not initialized")
 	}
@@ -94,6 +127,10 @@ func TestNewMessages(t *testing.T) {
 		t.Error("GeneralSettings is not initialized")
 	}
 
+	if reflect.DeepEqual(m.GeneralSystemDefenseCapabilities, systemdefense.Capabilities{}) {
+		t.Error("GeneralSystemDefenseCapabilities is not initialized")
+	}
+
 	if reflect.DeepEqual(m.Hdr8021Filter, hdr8021filter.Service{}) {
 		t.Error("Hdr8021Filter is not initialized")
 	}
@@ -106,6 +143,10 @@ func TestNewMessages(t *testing.T) {
 		t.Error("IEEE8021xProfile is not initialized")
 	}
 
+	if reflect.DeepEqual(m.IPHeadersFilter, systemdefense.IPHeadersFilter{}) {
+		t.Error("IPHeadersFilter is not initialized")
+	}
+
 	if reflect.DeepEqual(m.KerberosSettingData, kerberos.SettingData{}) {
 		t.Error("KerberosSettingData is not initialized")
 	}
@@ -122,6 +163,14 @@ func TestNewMessages(t *testing.T) {
 		t.Error("MPSUsernamePassword is not initialized")
 	}
 
+	if reflect.DeepEqual(m.NetworkPortDefensePolicy, systemdefense.NetworkPortDefensePolicy{}) {
+		t.Error("NetworkPortDefensePolicy is not initialized")
+	}
+
+	if reflect.DeepEqual(m.NetworkPortSystemDefensePolicy, systemdefense.NetworkPortSystemDefensePolicy{}) {
+		t.Error("NetworkPortSystemDefensePolicy is not initialized")
+	}
+
 	if reflect.DeepEqual(m.PublicKeyCertificate, publickey.Certificate{}) {
 		t.Error("PublicKeyCertificate is not initialized")
 	}
@@ -158,10 +207,22 @@ func TestNewMessages(t *testing.T) {
 		t.Error("SetupAndConfigurationService is not initialized")
 	}
 
+	if reflect.DeepEqual(m.SystemDefensePolicy, systemdefense.Policy{}) {
+		t.Error("SystemDefensePolicy is not initialized")
+	}
+
 	if reflect.DeepEqual(m.SystemPowerScheme, systempowerscheme.Service{}) {
 		t.Error("SystemPowerScheme is not initialized")
 	}
 
+	if reflect.DeepEqual(m.ThirdPartyDataStorageAdministrationService, thirdpartydatastorage.AdministrationService{}) {
+		t.Error("ThirdPartyDataStorageAdministrationService is not initialized")
+	}
+
+	if reflect.DeepEqual(m.ThirdPartyDataStorageService, thirdpartydatastorage.Service{}) {
+		t.Error("ThirdPartyDataStorageService is not initialized")
+	}
+
 	if reflect.DeepEqual(m.TimeSynchronizationService, timesynchronization.Service{}) {
 		t.Error("TimeSynchronizationService is not initialized")
 	}
@@ -182,6 +243,10 @@ func TestNewMessages(t *testing.T) {
 		t.Error("UserInitiatedConnectionService is not initialized")
 	}
 
+	if reflect.DeepEqual(m.WebUIService, webui.Service{}) {
+		t.Error("WebUIService is not initialized")
+	}
+
 	if reflect.DeepEqual(m.WiFiPortConfigurationService, wifiportconfiguration.Service{}) {
 		t.Error("WiFiPortConfigurationService is not initialized")
 	}
@@ -30,18 +30,18 @@ func NewPublicKeyCertificateWithClient(wsmanMessageCreator *message.WSManMessage
 
 // Get retrieves the representation of the instance identified by the InstanceID
 // selector. Shadows the generic parameterless Get to preserve the public API.
-func (certificate Certificate) Get(instanceID string) (response Response, err error) {
-	return certificate.GetByInstanceID(instanceID)
+func (certificate Certificate) Get(instanceID string, opts ...base.HeaderOption) (response Response, err error) {
+	return certificate.GetByInstanceID(instanceID, opts...)
 }
 
 // Pull overrides the generic Pull to post-process the response into the
 // RefinedPullResponse shape used by callers.
-func (certificate Certificate) Pull(enumerationContext string) (response Response, err error) {
+func (certificate Certificate) Pull(enumerationContext string, opts ...base.HeaderOption) (response Response, err error) {
 	var refinedOutput []RefinedPublicKeyCertificateResponse
 
 	response = Response{
 		Message: &client.Message{
-			XMLInput: certificate.Base.Pull(enumerationContext),
+			XMLInput: certificate.Base.Pull(enumerationContext, opts...),
 		},
 	}
 
@@ -78,7 +78,7 @@ func (certificate Certificate) Pull(enumerationContext string) (response Respons
 
 // Put overrides the generic Put because each certificate must be addressed by
 // its InstanceID selector, which the generic Put does not provide.
-func (certificate Certificate) Put(instanceID, cert string) (response Response, err error) {
+func (certificate Certificate) Put(instanceID, cert string, opts ...base.HeaderOption) (response Response, err error) {
 	selector := []message.Selector{{
 		Name:  "InstanceID",
 		Value: instanceID,
@@ -88,7 +88,7 @@ func (certificate Certificate) Put(instanceID, cert string) (response Response,
 	publicKeyCertificate.H = fmt.Sprintf("%s%s", message.AMTSchema, AMTPublicKeyCertificate)
 	response = Response{
 		Message: &client.Message{
-			XMLInput: certificate.Base.Put(publicKeyCertificate, true, selector),
+			XMLInput: certificate.Base.Put(publicKeyCertificate, true, selector, opts...),
 		},
 	}
 	// send the message to AMT
@@ -106,11 +106,11 @@ func (certificate Certificate) Put(instanceID, cert string) (response Response,
 }
 
 // Delete removes the specified instance.
-func (certificate Certificate) Delete(instanceID string) (response Response, err error) {
+func (certificate Certificate) Delete(instanceID string, opts ...base.HeaderOption) (response Response, err error) {
 	selector := message.Selector{Name: "InstanceID", Value: instanceID}
 	response = Response{
 		Message: &client.Message{
-			XMLInput: certificate.Base.Delete(selector),
+			XMLInput: certificate.Base.Delete(selector, opts...),
 		},
 	}
 	// send the message to AMT
@@ -0,0 +1,160 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package publickey
+
+import (
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/base"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+var (
+	ErrInvalidCRL       = errors.New("invalid certificate revocation list")
+	ErrCRLLimitExceeded = errors.New("certificate revocation list exceeds the firmware limits")
+)
+
+// CRLLimits are the limits a list is checked against before it is added to the firmware CRL store.
+type CRLLimits struct {
+	MaxURLLength          int // The maximum length of the URL of a list.
+	MaxSerialNumbers      int // The maximum number of serial numbers in a list.
+	MaxSerialNumberLength int // The maximum length of a serial number in hexadecimal digits.
+}
+
+// DefaultCRLLimits are conservative limits chosen by this package, not limits reported by the device: AMT doesn't
+// expose the size of its CRL store, as AMT_CryptographicCapabilities only reports ElementName, HardwareAcceleration
+// and InstanceID, so a list within these limits can still be rejected by AddCRL. The URL length of 256 characters and
+// the 1024 serial numbers per list are local defaults that callers may tighten or relax for their firmware. The serial
+// number length of 40 hexadecimal digits is the 20 octets allowed by RFC 5280.
+var DefaultCRLLimits = CRLLimits{
+	MaxURLLength:          256,
+	MaxSerialNumbers:      1024,
+	MaxSerialNumberLength: 40,
+}
+
+// Validate checks a list against the limits.
+func (limits CRLLimits) Validate(url string, serialNumbers []string) error {
+	if url == "" || len(url) > limits.MaxURLLength {
+		return fmt.Errorf("%w: URL must have 1 to %d characters", ErrCRLLimitExceeded, limits.MaxURLLength)
+	}
+
+	if len(serialNumbers) > limits.MaxSerialNumbers {
+		return fmt.Errorf("%w: %d serial numbers exceed %d", ErrCRLLimitExceeded, len(serialNumbers), limits.MaxSerialNumbers)
+	}
+
+	for _, serialNumber := range serialNumbers {
+		if len(serialNumber) > limits.MaxSerialNumberLength {
+			return fmt.Errorf("%w: serial number %s exceeds %d digits", ErrCRLLimitExceeded, serialNumber, limits.MaxSerialNumberLength)
+		}
+	}
+
+	return nil
+}
+
+// ParseCRL parses a PEM or DER encoded X.509 certificate revocation list. The signature isn't checked.
+func ParseCRL(data []byte) (*x509.RevocationList, error) {
+	if block, _ := pem.Decode(data); block != nil {
+		if block.Type != "X509 CRL" {
+			return nil, fmt.Errorf("%w: unexpected PEM block %s", ErrInvalidCRL, block.Type)
+		}
+
+		data = block.Bytes
+	}
+
+	list, err := x509.ParseRevocationList(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCRL, err)
+	}
+
+	return list, nil
+}
+
+// RevokedSerialNumbers returns the serial numbers of the revoked certificates of a list in the firmware format:
+// uppercase hexadecimal with an even number of digits.
+func RevokedSerialNumbers(list *x509.RevocationList) []string {
+	serialNumbers := make([]string, 0, len(list.RevokedCertificateEntries))
+
+	for _, entry := range list.RevokedCertificateEntries {
+		serialNumber := entry.SerialNumber.Bytes()
+		if len(serialNumber) == 0 {
+			serialNumber = []byte{0}
+		}
+
+		serialNumbers = append(serialNumbers, strings.ToUpper(hex.EncodeToString(serialNumber)))
+	}
+
+	return serialNumbers
+}
+
+// AddRevocationList parses a PEM or DER encoded X.509 certificate revocation list, validates it against the limits and
+// adds its revoked serial numbers to the firmware CRL store under url.
+func (managementService ManagementService) AddRevocationList(url string, data []byte, limits CRLLimits, opts ...base.HeaderOption) (response Response, err error) {
+	list, err := ParseCRL(data)
+	if err != nil {
+		return response, err
+	}
+
+	serialNumbers := RevokedSerialNumbers(list)
+
+	if err := limits.Validate(url, serialNumbers); err != nil {
+		return response, err
+	}
+
+	return managementService.AddCRL(url, serialNumbers, opts...)
+}
+
+// NewCRLWithClient instantiates a new CRL.
+func NewCRLWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) CRL {
+	return CRL{
+		base.NewService[Response](wsmanMessageCreator, AMTCRL, client),
+	}
+}
+
+// Get retrieves the list identified by its URL. Shadows the generic parameterless Get, as the store can hold several
+// lists.
+func (crl CRL) Get(url string, opts ...base.HeaderOption) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: crl.Base.Get(&message.Selector{Name: "Url", Value: url}, opts...),
+		},
+	}
+
+	return crl.execute(response)
+}
+
+// Delete removes the list identified by its URL.
+func (crl CRL) Delete(url string, opts ...base.HeaderOption) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: crl.Base.Delete(message.Selector{Name: "Url", Value: url}, opts...),
+		},
+	}
+
+	return crl.execute(response)
+}
+
+func (crl CRL) execute(response Response) (Response, error) {
+	// send the message to AMT
+	err := crl.Base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
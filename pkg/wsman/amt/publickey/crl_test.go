@@ -0,0 +1,252 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package publickey
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"encoding/xml"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+const (
+	crlURL      = "http://crl.example.com/issuing-ca.crl"
+	urlSelector = `<w:SelectorSet><w:Selector Name="Url">` + crlURL + `</w:Selector></w:SelectorSet>`
+)
+
+func TestPositiveAMT_CRL(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/publickey/crl",
+	}
+	elementUnderTest := NewCRLWithClient(wsmanMessageCreator, &client)
+
+	expected := CRLResponse{
+		XMLName:       xml.Name{Space: message.AMTSchema + AMTCRL, Local: AMTCRL},
+		ElementName:   "Intel(r) AMT CRL",
+		Url:           crlURL,
+		SerialNumbers: []string{"0A1B", "7F3C21"},
+	}
+
+	t.Run("amt_CRL Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			action       string
+			extraHeader  string
+			body         string
+			responseFunc func() (Response, error)
+			check        func(t *testing.T, body Body)
+		}{
+			{
+				"should create a valid AMT_CRL Get wsman message",
+				wsmantesting.Get,
+				urlSelector,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get(crlURL)
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, expected, body.CRLGetResponse)
+				},
+			},
+			{
+				"should create a valid AMT_CRL Enumerate wsman message",
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, "D9000000-0000-0000-0000-000000000000", body.EnumerateResponse.EnumerationContext)
+				},
+			},
+			{
+				"should create a valid AMT_CRL Pull wsman message",
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, []CRLResponse{expected}, body.PullResponse.CRLItems)
+				},
+			},
+			{
+				"should create a valid AMT_CRL Delete wsman message",
+				wsmantesting.Delete,
+				urlSelector,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageDelete
+
+					return elementUnderTest.Delete(crlURL)
+				},
+				func(t *testing.T, _ Body) { t.Helper() },
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, AMTCRL, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				test.check(t, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeAMT_CRL(t *testing.T) {
+	wsmanMessageCreator := message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/publickey/crl",
+		CurrentMessage:   wsmantesting.CurrentMessageError,
+	}
+	elementUnderTest := NewCRLWithClient(wsmanMessageCreator, &client)
+
+	_, err := elementUnderTest.Get(crlURL)
+	assert.Error(t, err)
+
+	_, err = elementUnderTest.Delete(crlURL)
+	assert.Error(t, err)
+}
+
+func TestAMT_PublicKeyManagementService_AddCRL(t *testing.T) {
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/publickey/management",
+		CurrentMessage:   AddCRL,
+	}
+	elementUnderTest := NewPublicKeyManagementServiceWithClient(message.NewWSManMessageCreator(resourceURIBase), &client)
+
+	response, err := elementUnderTest.AddCRL(crlURL, []string{"0A1B", "7F3C21"})
+	require.NoError(t, err)
+	assert.Equal(t, wsmantesting.ExpectedResponse(0, resourceURIBase, AMTPublicKeyManagementService,
+		message.AMTSchema+AMTPublicKeyManagementService+"/"+AddCRL, "",
+		`<h:AddCRL_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_PublicKeyManagementService"><h:Url>`+crlURL+`</h:Url><h:SerialNumbers>0A1B</h:SerialNumbers><h:SerialNumbers>7F3C21</h:SerialNumbers></h:AddCRL_INPUT>`),
+		response.XMLInput)
+	assert.Equal(t, crlURL, response.Body.AddCRL_OUTPUT.Crl.ReferenceParameters.SelectorSet.Selectors[0].Text)
+
+	client.CurrentMessage = wsmantesting.CurrentMessageError
+	_, err = elementUnderTest.AddCRL(crlURL, nil)
+	assert.Error(t, err)
+}
+
+// createCRL returns a DER encoded CRL revoking the serial numbers.
+func createCRL(t *testing.T, serialNumbers ...int64) []byte {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Issuing CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	issuer, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	list := &x509.RevocationList{Number: big.NewInt(1), ThisUpdate: time.Now(), NextUpdate: time.Now().Add(time.Hour)}
+	for _, serialNumber := range serialNumbers {
+		list.RevokedCertificateEntries = append(list.RevokedCertificateEntries, x509.RevocationListEntry{SerialNumber: big.NewInt(serialNumber), RevocationTime: time.Now()})
+	}
+
+	crl, err := x509.CreateRevocationList(rand.Reader, list, issuer, key)
+	require.NoError(t, err)
+
+	return crl
+}
+
+func TestParseCRL(t *testing.T) {
+	der := createCRL(t, 0x0A1B, 0x7F3C21, 0)
+
+	for name, data := range map[string][]byte{
+		"DER": der,
+		"PEM": pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: der}),
+	} {
+		t.Run(name, func(t *testing.T) {
+			list, err := ParseCRL(data)
+			require.NoError(t, err)
+			assert.Equal(t, []string{"0A1B", "7F3C21", "00"}, RevokedSerialNumbers(list))
+		})
+	}
+
+	t.Run("rejects other PEM blocks", func(t *testing.T) {
+		_, err := ParseCRL(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+		assert.ErrorIs(t, err, ErrInvalidCRL)
+	})
+
+	t.Run("rejects invalid data", func(t *testing.T) {
+		_, err := ParseCRL([]byte("not a crl"))
+		assert.ErrorIs(t, err, ErrInvalidCRL)
+	})
+}
+
+func TestCRLLimits(t *testing.T) {
+	limits := CRLLimits{MaxURLLength: 64, MaxSerialNumbers: 2, MaxSerialNumberLength: 40}
+
+	assert.NoError(t, limits.Validate(crlURL, []string{"0A1B", "7F3C21"}))
+	assert.ErrorIs(t, limits.Validate("", nil), ErrCRLLimitExceeded)
+	assert.ErrorIs(t, limits.Validate(strings.Repeat("u", 65), nil), ErrCRLLimitExceeded)
+	assert.ErrorIs(t, limits.Validate(crlURL, []string{"01", "02", "03"}), ErrCRLLimitExceeded)
+	assert.ErrorIs(t, limits.Validate(crlURL, []string{strings.Repeat("0", 42)}), ErrCRLLimitExceeded)
+}
+
+func TestAMT_PublicKeyManagementService_AddRevocationList(t *testing.T) {
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/publickey/management",
+		CurrentMessage:   AddCRL,
+	}
+	elementUnderTest := NewPublicKeyManagementServiceWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), &client)
+	der := createCRL(t, 0x0A1B, 0x7F3C21)
+
+	response, err := elementUnderTest.AddRevocationList(crlURL, der, DefaultCRLLimits)
+	require.NoError(t, err)
+	assert.Contains(t, response.XMLInput, `<h:SerialNumbers>0A1B</h:SerialNumbers><h:SerialNumbers>7F3C21</h:SerialNumbers>`)
+
+	_, err = elementUnderTest.AddRevocationList(crlURL, der, CRLLimits{MaxURLLength: 256, MaxSerialNumbers: 1, MaxSerialNumberLength: 40})
+	assert.ErrorIs(t, err, ErrCRLLimitExceeded)
+
+	_, err = elementUnderTest.AddRevocationList(crlURL, []byte("not a crl"), DefaultCRLLimits)
+	assert.ErrorIs(t, err, ErrInvalidCRL)
+}
@@ -8,11 +8,13 @@ package publickey
 const (
 	AMTPublicKeyCertificate       string = "AMT_PublicKeyCertificate"
 	AMTPublicKeyManagementService string = "AMT_PublicKeyManagementService"
+	AMTCRL                        string = "AMT_CRL"
 	GeneratePKCS10RequestEx       string = "GeneratePKCS10RequestEx"
 	AddTrustedRootCertificate     string = "AddTrustedRootCertificate"
 	AddCertificate                string = "AddCertificate"
 	GenerateKeyPair               string = "GenerateKeyPair"
 	AddKey                        string = "AddKey"
+	AddCRL                        string = "AddCRL"
 	ValueNotFound                 string = "Value not found in map"
 )
 
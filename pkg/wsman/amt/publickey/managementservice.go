@@ -29,11 +29,11 @@ func NewPublicKeyManagementServiceWithClient(wsmanMessageCreator *message.WSManM
 }
 
 // Delete removes a the specified instance.
-func (managementService ManagementService) Delete(instanceID string) (response Response, err error) {
+func (managementService ManagementService) Delete(instanceID string, opts ...base.HeaderOption) (response Response, err error) {
 	selector := message.Selector{Name: "InstanceID", Value: instanceID}
 	response = Response{
 		Message: &client.Message{
-			XMLInput: managementService.Base.Delete(selector),
+			XMLInput: managementService.Base.Delete(selector, opts...),
 		},
 	}
 
@@ -88,8 +88,8 @@ func checkReturnValue(rc int, item string) (err error) {
 }
 
 // This function adds new certificate to the Intel® AMT CertStore. A certificate cannot be removed if it is referenced (for example, used by TLS, 802.1X or EAC).
-func (managementService ManagementService) AddCertificate(certificateBlob string) (response Response, err error) {
-	header := managementService.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTPublicKeyManagementService, AddCertificate), AMTPublicKeyManagementService, nil, "", "")
+func (managementService ManagementService) AddCertificate(certificateBlob string, opts ...base.HeaderOption) (response Response, err error) {
+	header := managementService.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTPublicKeyManagementService, AddCertificate), AMTPublicKeyManagementService, nil, "", "", opts...)
 	certificate := AddCertificate_INPUT{
 		H:               fmt.Sprintf("%s%s", message.AMTSchema, AMTPublicKeyManagementService),
 		CertificateBlob: certificateBlob,
@@ -120,8 +120,8 @@ func (managementService ManagementService) AddCertificate(certificateBlob string
 }
 
 // This function adds new root certificate to the Intel® AMT CertStore. A certificate cannot be removed if it is referenced (for example, used by TLS, 802.1X or EAC).
-func (managementService ManagementService) AddTrustedRootCertificate(certificateBlob string) (response Response, err error) {
-	header := managementService.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTPublicKeyManagementService, AddTrustedRootCertificate), AMTPublicKeyManagementService, nil, "", "")
+func (managementService ManagementService) AddTrustedRootCertificate(certificateBlob string, opts ...base.HeaderOption) (response Response, err error) {
+	header := managementService.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTPublicKeyManagementService, AddTrustedRootCertificate), AMTPublicKeyManagementService, nil, "", "", opts...)
 	trustedRootCert := AddTrustedRootCertificate_INPUT{
 		H:               fmt.Sprintf("%s%s", message.AMTSchema, AMTPublicKeyManagementService),
 		CertificateBlob: certificateBlob,
@@ -152,8 +152,8 @@ func (managementService ManagementService) AddTrustedRootCertificate(certificate
 }
 
 // This API is used to generate a key in the FW.
-func (managementService ManagementService) GenerateKeyPair(keyAlgorithm KeyAlgorithm, keyLength KeyLength) (response Response, err error) {
-	header := managementService.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTPublicKeyManagementService, GenerateKeyPair), AMTPublicKeyManagementService, nil, "", "")
+func (managementService ManagementService) GenerateKeyPair(keyAlgorithm KeyAlgorithm, keyLength KeyLength, opts ...base.HeaderOption) (response Response, err error) {
+	header := managementService.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTPublicKeyManagementService, GenerateKeyPair), AMTPublicKeyManagementService, nil, "", "", opts...)
 	generateKeyPair := GenerateKeyPair_INPUT{
 		H:            fmt.Sprintf("%s%s", message.AMTSchema, AMTPublicKeyManagementService),
 		KeyAlgorithm: keyAlgorithm,
@@ -186,8 +186,8 @@ func (managementService ManagementService) GenerateKeyPair(keyAlgorithm KeyAlgor
 }
 
 // This API is used to create a PKCS#10 certificate signing request based on a key from the key store.
-func (managementService ManagementService) GeneratePKCS10RequestEx(keyPair, nullSignedCertificateRequest string, signingAlgorithm SigningAlgorithm) (response Response, err error) {
-	header := managementService.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTPublicKeyManagementService, GeneratePKCS10RequestEx), AMTPublicKeyManagementService, nil, "", "")
+func (managementService ManagementService) GeneratePKCS10RequestEx(keyPair, nullSignedCertificateRequest string, signingAlgorithm SigningAlgorithm, opts ...base.HeaderOption) (response Response, err error) {
+	header := managementService.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTPublicKeyManagementService, GeneratePKCS10RequestEx), AMTPublicKeyManagementService, nil, "", "", opts...)
 	pkcs10Request := PKCS10Request{
 		H: fmt.Sprintf("%s%s", message.AMTSchema, AMTPublicKeyManagementService),
 		KeyPair: KeyPair{
@@ -233,8 +233,8 @@ func (managementService ManagementService) GeneratePKCS10RequestEx(keyPair, null
 // After the method succeeds, a new instance of AMT_PublicPrivateKeyPair will be created.
 // Possible return values are: PT_STATUS_SUCCESS(0), PT_STATUS_INTERNAL_ERROR(1), PT_STATUS_MAX_LIMIT_REACHED(23),
 // PT_STATUS_FLASH_WRITE_LIMIT_EXCEEDED(38), PT_STATUS_DUPLICATE(2068), PT_STATUS_INVALID_KEY(2062).
-func (managementService ManagementService) AddKey(keyBlob string) (response Response, err error) {
-	header := managementService.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTPublicKeyManagementService, AddKey), AMTPublicKeyManagementService, nil, "", "")
+func (managementService ManagementService) AddKey(keyBlob string, opts ...base.HeaderOption) (response Response, err error) {
+	header := managementService.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTPublicKeyManagementService, AddKey), AMTPublicKeyManagementService, nil, "", "", opts...)
 	params := &AddKey_INPUT{
 		H:       fmt.Sprintf("%s%s", message.AMTSchema, AMTPublicKeyManagementService),
 		KeyBlob: keyBlob,
@@ -260,3 +260,38 @@ func (managementService ManagementService) AddKey(keyBlob string) (response Resp
 
 	return response, nil
 }
+
+// AddCRL adds a certificate revocation list to the Intel® AMT CRL store. The url identifies the list and the serial
+// numbers, in uppercase hexadecimal, are those of the revoked certificates. See AddRevocationList to add a list parsed
+// from its X.509 encoding.
+func (managementService ManagementService) AddCRL(url string, serialNumbers []string, opts ...base.HeaderOption) (response Response, err error) {
+	header := managementService.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTPublicKeyManagementService, AddCRL), AMTPublicKeyManagementService, nil, "", "", opts...)
+	crl := AddCRL_INPUT{
+		H:             fmt.Sprintf("%s%s", message.AMTSchema, AMTPublicKeyManagementService),
+		Url:           url,
+		SerialNumbers: serialNumbers,
+	}
+	body := managementService.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(AddCRL), AMTPublicKeyManagementService, &crl)
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: managementService.Base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	// send the message to AMT
+	err = managementService.Base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	err = checkReturnValue(int(response.Body.AddCRL_OUTPUT.ReturnValue), "CRL")
+
+	return response, err
+}
@@ -18,6 +18,10 @@ type Certificate struct {
 	base.WSManService[Response]
 }
 
+type CRL struct {
+	base.WSManService[Response]
+}
+
 // OUTPUTS
 // Response Types.
 type (
@@ -34,8 +38,10 @@ type (
 		AddKey_OUTPUT                         AddKey_OUTPUT                    `xml:"AddKey_OUTPUT,omitempty"`
 		GenerateKeyPair_OUTPUT                GenerateKeyPair_OUTPUT           `xml:"GenerateKeyPair_OUTPUT,omitempty"`
 		GeneratePKCS10RequestEx_OUTPUT        GeneratePKCS10RequestEx_OUTPUT   `xml:"GeneratePKCS10RequestEx_OUTPUT,omitempty"`
+		AddCRL_OUTPUT                         AddCRL_OUTPUT                    `xml:"AddCRL_OUTPUT,omitempty"`
 		KeyManagementGetResponse              KeyManagementResponse            `xml:"AMT_PublicKeyManagementService,omitempty"`
 		PublicKeyCertificateGetAndPutResponse PublicKeyCertificateResponse     `xml:"AMT_PublicKeyCertificate,omitempty"`
+		CRLGetResponse                        CRLResponse                      `xml:"AMT_CRL,omitempty"`
 		EnumerateResponse                     common.EnumerateResponse
 		PullResponse                          PullResponse
 		RefinedPullResponse                   RefinedPullResponse
@@ -48,6 +54,7 @@ type (
 		XMLName                   xml.Name                       `xml:"PullResponse,omitempty"`
 		KeyManagementItems        []KeyManagementResponse        `xml:"Items>AMT_PublicKeyManagementService,omitempty"`
 		PublicKeyCertificateItems []PublicKeyCertificateResponse `xml:"Items>AMT_PublicKeyCertificate,omitempty"`
+		CRLItems                  []CRLResponse                  `xml:"Items>AMT_CRL,omitempty"`
 	}
 	KeyManagementResponse struct {
 		XMLName                 xml.Name            `xml:"AMT_PublicKeyManagementService,omitempty"`
@@ -94,6 +101,13 @@ type (
 		PublicKeyHandle        string   `json:"PublicKeyHandle,omitempty"`
 		AssociatedProfiles     []string `json:"AssociatedProfiles,omitempty"`
 	}
+	// CRLResponse is a certificate revocation list in the firmware CRL store.
+	CRLResponse struct {
+		XMLName       xml.Name `xml:"AMT_CRL,omitempty"`
+		ElementName   string   `xml:"ElementName,omitempty"` // A user-friendly name for the object.
+		Url           string   `xml:"Url,omitempty"`         // The URL of the CRL, which identifies it in the store.
+		SerialNumbers []string `xml:"SerialNumbers"`         // The serial numbers of the revoked certificates, in uppercase hexadecimal.
+	}
 	AddTrustedRootCertificate_OUTPUT struct {
 		XMLName            xml.Name                   `xml:"AddTrustedRootCertificate_OUTPUT"`
 		CreatedCertificate CreatedCertificateResponse `xml:"CreatedCertificate,omitempty"`
@@ -114,6 +128,11 @@ type (
 		KeyPair     KeyPairResponse `xml:"KeyPair,omitempty"`
 		ReturnValue ReturnValue     `xml:"ReturnValue,omitempty"`
 	}
+	AddCRL_OUTPUT struct {
+		XMLName     xml.Name           `xml:"AddCRL_OUTPUT"`
+		Crl         CreatedCRLResponse `xml:"Crl,omitempty"`
+		ReturnValue ReturnValue        `xml:"ReturnValue,omitempty"`
+	}
 	GeneratePKCS10RequestEx_OUTPUT struct {
 		XMLName                  xml.Name    `xml:"GeneratePKCS10RequestEx_OUTPUT,omitempty"`
 		SignedCertificateRequest string      `xml:"SignedCertificateRequest,omitempty"`
@@ -129,6 +148,11 @@ type (
 		Address             string                      `xml:"Address,omitempty"`
 		ReferenceParameters ReferenceParametersResponse `xml:"ReferenceParameters,omitempty"`
 	}
+	CreatedCRLResponse struct {
+		XMLName             xml.Name                    `xml:"Crl,omitempty"`
+		Address             string                      `xml:"Address,omitempty"`
+		ReferenceParameters ReferenceParametersResponse `xml:"ReferenceParameters,omitempty"`
+	}
 	CreatedCertificateResponse struct {
 		XMLName             xml.Name                    `xml:"CreatedCertificate,omitempty"`
 		Address             string                      `xml:"Address,omitempty"`
@@ -194,6 +218,12 @@ type (
 		H       string   `xml:"xmlns:h,attr"`
 		KeyBlob string   `xml:"h:KeyBlob"` // The use of ECC 192/224 is blocked starting from Intel® CSME 18.0.
 	}
+	AddCRL_INPUT struct {
+		XMLName       xml.Name `xml:"h:AddCRL_INPUT"`
+		H             string   `xml:"xmlns:h,attr"`
+		Url           string   `xml:"h:Url"`           // The URL of the CRL.
+		SerialNumbers []string `xml:"h:SerialNumbers"` // The serial numbers of the revoked certificates, in uppercase hexadecimal.
+	}
 	GenerateKeyPair_INPUT struct {
 		XMLName      xml.Name     `xml:"h:GenerateKeyPair_INPUT"`
 		H            string       `xml:"xmlns:h,attr"`
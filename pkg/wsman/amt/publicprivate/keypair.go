@@ -25,18 +25,18 @@ func NewPublicPrivateKeyPairWithClient(wsmanMessageCreator *message.WSManMessage
 
 // Get retrieves the representation of the instance identified by the InstanceID
 // selector. Shadows the generic parameterless Get to preserve the public API.
-func (keyPair KeyPair) Get(instanceID string) (response Response, err error) {
-	return keyPair.GetByInstanceID(instanceID)
+func (keyPair KeyPair) Get(instanceID string, opts ...base.HeaderOption) (response Response, err error) {
+	return keyPair.GetByInstanceID(instanceID, opts...)
 }
 
 // Pull overrides the generic Pull to post-process the response into the
 // RefinedPullResponse shape used by callers.
-func (keyPair KeyPair) Pull(enumerationContext string) (response Response, err error) {
+func (keyPair KeyPair) Pull(enumerationContext string, opts ...base.HeaderOption) (response Response, err error) {
 	var refinedOutput []RefinedPublicPrivateKeyPair
 
 	response = Response{
 		Message: &client.Message{
-			XMLInput: keyPair.Base.Pull(enumerationContext),
+			XMLInput: keyPair.Base.Pull(enumerationContext, opts...),
 		},
 	}
 
@@ -68,14 +68,14 @@ func (keyPair KeyPair) Pull(enumerationContext string) (response Response, err e
 }
 
 // Deletes an instance of a key pair.
-func (keyPair KeyPair) Delete(handle string) (response Response, err error) {
+func (keyPair KeyPair) Delete(handle string, opts ...base.HeaderOption) (response Response, err error) {
 	selector := message.Selector{
 		Name:  "InstanceID",
 		Value: handle,
 	}
 	response = Response{
 		Message: &client.Message{
-			XMLInput: keyPair.Base.Delete(selector),
+			XMLInput: keyPair.Base.Delete(selector, opts...),
 		},
 	}
 	// send the message to AMT
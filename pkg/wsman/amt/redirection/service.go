@@ -33,10 +33,10 @@ func NewRedirectionServiceWithClient(wsmanMessageCreator *message.WSManMessageCr
 // If 0 is returned, then the task completed successfully and the use of ConcreteJob was not required.
 // If 4096 (0x1000) is returned, then the task will take some time to complete, ConcreteJob will be created, and its reference returned in the output parameter Job.
 // Any other return code indicates an error condition.
-func (service Service) RequestStateChange(requestedState RequestedState) (response Response, err error) {
+func (service Service) RequestStateChange(requestedState RequestedState, opts ...base.HeaderOption) (response Response, err error) {
 	response = Response{
 		Message: &client.Message{
-			XMLInput: service.Base.RequestStateChange(methods.GenerateAction(AMTRedirectionService, RequestStateChange), int(requestedState)),
+			XMLInput: service.Base.RequestStateChange(methods.GenerateAction(AMTRedirectionService, RequestStateChange), int(requestedState), opts...),
 		},
 	}
 	// send the message to AMT
@@ -37,7 +37,7 @@ func NewRemoteAccessPolicyAppliesToMPSWithClient(wsmanMessageCreator *message.WS
 // Put overrides the generic Put because the target instance must be addressed
 // via two EPR selectors (ManagedElement and PolicySet), which the generic Put
 // does not provide.
-func (policyAppliesToMPS PolicyAppliesToMPS) Put(remoteAccessPolicyAppliesToMPS *RemoteAccessPolicyAppliesToMPSRequest) (response Response, err error) {
+func (policyAppliesToMPS PolicyAppliesToMPS) Put(remoteAccessPolicyAppliesToMPS *RemoteAccessPolicyAppliesToMPSRequest, opts ...base.HeaderOption) (response Response, err error) {
 	selectors := []message.Selector{
 		{
 			Name:  "ManagedElement",
@@ -51,7 +51,7 @@ func (policyAppliesToMPS PolicyAppliesToMPS) Put(remoteAccessPolicyAppliesToMPS
 
 	response = Response{
 		Message: &client.Message{
-			XMLInput: policyAppliesToMPS.Base.Put(remoteAccessPolicyAppliesToMPS, true, selectors),
+			XMLInput: policyAppliesToMPS.Base.Put(remoteAccessPolicyAppliesToMPS, true, selectors, opts...),
 		},
 	}
 	// send the message to AMT
@@ -70,11 +70,11 @@ func (policyAppliesToMPS PolicyAppliesToMPS) Put(remoteAccessPolicyAppliesToMPS
 }
 
 // Delete removes the specified instance.
-func (policyAppliesToMPS PolicyAppliesToMPS) Delete(handle string) (response Response, err error) {
+func (policyAppliesToMPS PolicyAppliesToMPS) Delete(handle string, opts ...base.HeaderOption) (response Response, err error) {
 	selector := message.Selector{Name: "Name", Value: handle}
 	response = Response{
 		Message: &client.Message{
-			XMLInput: policyAppliesToMPS.Base.Delete(selector),
+			XMLInput: policyAppliesToMPS.Base.Delete(selector, opts...),
 		},
 	}
 	// send the message to AMT
@@ -25,11 +25,11 @@ func NewPolicyRuleWithClient(wsmanMessageCreator *message.WSManMessageCreator, c
 }
 
 // Delete removes a the specified instance.
-func (policyRule PolicyRule) Delete(handle string) (response Response, err error) {
+func (policyRule PolicyRule) Delete(handle string, opts ...base.HeaderOption) (response Response, err error) {
 	selector := message.Selector{Name: "PolicyRuleName", Value: handle}
 	response = Response{
 		Message: &client.Message{
-			XMLInput: policyRule.Base.Delete(selector),
+			XMLInput: policyRule.Base.Delete(selector, opts...),
 		},
 	}
 	// send the message to AMT
@@ -30,10 +30,10 @@ func NewRemoteAccessServiceWithClient(wsmanMessageCreator *message.WSManMessageC
 // Creates an AMT_ManagementPresenceRemoteSAP instance and an AMT_RemoteAccessCredentialContext association to a credential.
 // This credential may be an existing AMT_PublicKeyCertificate instance (if the created MPS is configured to use mutual authentication).
 // If the created MpServer is configured to use username password authentication, an AMT_MPSUsernamePassword instance is created and used as the associated credential.
-func (service Service) AddMPS(mpServer AddMpServerRequest) (response Response, err error) {
+func (service Service) AddMPS(mpServer AddMpServerRequest, opts ...base.HeaderOption) (response Response, err error) {
 	mpServer.H = fmt.Sprintf("%s%s", message.AMTSchema, AMTRemoteAccessService)
 
-	header := service.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTRemoteAccessService, AddMps), AMTRemoteAccessService, nil, "", "")
+	header := service.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTRemoteAccessService, AddMps), AMTRemoteAccessService, nil, "", "", opts...)
 
 	body := service.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(AddMps), AMTRemoteAccessService, mpServer)
 
@@ -62,14 +62,14 @@ func (service Service) AddMPS(mpServer AddMpServerRequest) (response Response, e
 // The policy defines an event that will trigger an establishment of a tunnel between AMT and a pre-configured MPS.
 // Creates an AMT_RemoteAccessPolicyRule instance and associates it to a given list of AMT_ManagementPresenceRemoteSAP instances with AMT_PolicySetAppliesToElement association instances.
 // Returns an XML string representing the WS-Management message to be sent to the Intel® AMT subsystem.
-func (service Service) AddRemoteAccessPolicyRule(remoteAccessPolicyRule RemoteAccessPolicyRuleRequest, name string) (response Response, err error) {
+func (service Service) AddRemoteAccessPolicyRule(remoteAccessPolicyRule RemoteAccessPolicyRuleRequest, name string, opts ...base.HeaderOption) (response Response, err error) {
 	selector := message.Selector{
 		Name:  "Name",
 		Value: name,
 	}
 	addRemotePolicyRuleNamespace := service.Base.WSManMessageCreator.ResourceURIBase + AMTRemoteAccessService
 
-	header := service.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTRemoteAccessService, AddRemoteAccessPolicyRule), AMTRemoteAccessService, nil, "", "")
+	header := service.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTRemoteAccessService, AddRemoteAccessPolicyRule), AMTRemoteAccessService, nil, "", "", opts...)
 
 	body := fmt.Sprintf(`<Body><h:AddRemoteAccessPolicyRule_INPUT xmlns:h=%q><h:Trigger>%d</h:Trigger><h:TunnelLifeTime>%d</h:TunnelLifeTime><h:ExtendedData>%s</h:ExtendedData><h:MpServer><Address xmlns="http://schemas.xmlsoap.org/ws/2004/08/addressing">http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous</Address><ReferenceParameters xmlns="http://schemas.xmlsoap.org/ws/2004/08/addressing"><ResourceURI xmlns="http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd">%s%s</ResourceURI><SelectorSet xmlns="http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd"><Selector Name=%q>%s</Selector></SelectorSet></ReferenceParameters></h:MpServer></h:AddRemoteAccessPolicyRule_INPUT></Body>`,
 		addRemotePolicyRuleNamespace,
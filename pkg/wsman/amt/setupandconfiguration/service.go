@@ -81,8 +81,8 @@ func NewSetupAndConfigurationServiceWithClient(wsmanMessageCreator *message.WSMa
 // ValueMap={0, 1, 38, 2057}
 //
 // Values={PT_STATUS_SUCCESS, PT_STATUS_INTERNAL_ERROR, PT_STATUS_FLASH_WRITE_LIMIT_EXCEEDED, PT_STATUS_DATA_MISSING}.
-func (s Service) CommitChanges() (response Response, err error) {
-	header := s.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTSetupAndConfigurationService, CommitChanges), AMTSetupAndConfigurationService, nil, "", "")
+func (s Service) CommitChanges(opts ...base.HeaderOption) (response Response, err error) {
+	header := s.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTSetupAndConfigurationService, CommitChanges), AMTSetupAndConfigurationService, nil, "", "", opts...)
 	body := s.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(CommitChanges), AMTSetupAndConfigurationService, nil)
 
 	response = Response{
@@ -115,8 +115,8 @@ func (s Service) CommitChanges() (response Response, err error) {
 // ValueMap={0, 1}
 //
 // Values={PT_STATUS_SUCCESS, PT_STATUS_INTERNAL_ERROR}.
-func (s Service) GetUUID() (response Response, err error) {
-	header := s.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTSetupAndConfigurationService, GetUUID), AMTSetupAndConfigurationService, nil, "", "")
+func (s Service) GetUUID(opts ...base.HeaderOption) (response Response, err error) {
+	header := s.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTSetupAndConfigurationService, GetUUID), AMTSetupAndConfigurationService, nil, "", "", opts...)
 	body := s.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(GetUUID), AMTSetupAndConfigurationService, nil)
 
 	response = Response{
@@ -155,8 +155,8 @@ func (s Service) GetUUID() (response Response, err error) {
 // ValueMap={0, 1, 16, 2054}
 //
 // Values={PT_STATUS_SUCCESS, PT_STATUS_INTERNAL_ERROR, PT_STATUS_NOT_PERMITTED, PT_STATUS_INVALID_PASSWORD}.
-func (s Service) SetMEBXPassword(password string) (response Response, err error) {
-	header := s.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTSetupAndConfigurationService, SetMEBxPassword), AMTSetupAndConfigurationService, nil, "", "")
+func (s Service) SetMEBXPassword(password string, opts ...base.HeaderOption) (response Response, err error) {
+	header := s.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTSetupAndConfigurationService, SetMEBxPassword), AMTSetupAndConfigurationService, nil, "", "", opts...)
 
 	mebxPassword := MEBXPassword{
 		Password: password,
@@ -204,8 +204,8 @@ func (s Service) SetMEBXPassword(password string) (response Response, err error)
 // -------------
 // ValueMap={0, 1, 16, 2076}
 // Values={PT_STATUS_SUCCESS, PT_STATUS_INTERNAL_ERROR, PT_STATUS_NOT_PERMITTED, PT_STATUS_BLOCKING_COMPONENT}.
-func (s Service) PartialUnprovision() (response Response, err error) {
-	header := s.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTSetupAndConfigurationService, PartialUnprovision), AMTSetupAndConfigurationService, nil, "", "")
+func (s Service) PartialUnprovision(opts ...base.HeaderOption) (response Response, err error) {
+	header := s.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTSetupAndConfigurationService, PartialUnprovision), AMTSetupAndConfigurationService, nil, "", "", opts...)
 	body := s.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(PartialUnprovision), AMTSetupAndConfigurationService, nil)
 
 	response = Response{
@@ -240,7 +240,7 @@ func (s Service) PartialUnprovision() (response Response, err error) {
 // ValueMap={0, 1, 16, 36, 2076}
 //
 // Values={PT_STATUS_SUCCESS, PT_STATUS_INTERNAL_ERROR, PT_STATUS_NOT_PERMITTED, PT_STATUS_INVALID_PARAMETER, PT_STATUS_BLOCKING_COMPONENT}.
-func (s Service) Unprovision(provisioningMode ProvisioningModeValue) (response Response, err error) {
+func (s Service) Unprovision(provisioningMode ProvisioningModeValue, opts ...base.HeaderOption) (response Response, err error) {
 	if provisioningMode == 0 {
 		provisioningMode = 1
 	}
@@ -249,7 +249,7 @@ func (s Service) Unprovision(provisioningMode ProvisioningModeValue) (response R
 		ProvisioningMode: provisioningMode,
 	}
 
-	header := s.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTSetupAndConfigurationService, Unprovision), AMTSetupAndConfigurationService, nil, "", "")
+	header := s.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTSetupAndConfigurationService, Unprovision), AMTSetupAndConfigurationService, nil, "", "", opts...)
 	body := s.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(Unprovision), AMTSetupAndConfigurationService, &pMode)
 
 	response = Response{
@@ -0,0 +1,26 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import (
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/base"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewCapabilitiesWithClient instantiates a new General System Defense Capabilities service.
+func NewCapabilitiesWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) Capabilities {
+	return Capabilities{
+		base.NewService[Response](wsmanMessageCreator, AMTGeneralSystemDefenseCapabilities, client),
+	}
+}
+
+// NewActiveFilterStatisticsWithClient instantiates a new Active Filter Statistics service.
+func NewActiveFilterStatisticsWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) ActiveFilterStatistics {
+	return ActiveFilterStatistics{
+		base.NewService[Response](wsmanMessageCreator, AMTActiveFilterStatistics, client),
+	}
+}
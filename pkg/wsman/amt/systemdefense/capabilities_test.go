@@ -0,0 +1,98 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+func TestPositiveAMT_GeneralSystemDefenseCapabilities(t *testing.T) {
+	wsmanMessageCreator := message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/systemdefense/capabilities",
+	}
+	elementUnderTest := NewCapabilitiesWithClient(wsmanMessageCreator, &client)
+
+	expected := CapabilitiesResponse{
+		XMLName:                        xml.Name{Space: message.AMTSchema + AMTGeneralSystemDefenseCapabilities, Local: AMTGeneralSystemDefenseCapabilities},
+		ElementName:                    "Intel(r) AMT General System Defense Capabilities",
+		InstanceID:                     "Intel(r) AMT General System Defense Capabilities",
+		GlobalMaxSupportedFilters:      64,
+		GlobalMaxSupportedPolicies:     16,
+		GlobalMaxActivePolicies:        4,
+		GlobalMaxSupportedCounters:     32,
+		GlobalMaxSupportedRateLimiters: 8,
+	}
+
+	client.CurrentMessage = wsmantesting.CurrentMessageGet
+	response, err := elementUnderTest.Get()
+	assert.NoError(t, err)
+	assert.Contains(t, response.XMLInput, AMTGeneralSystemDefenseCapabilities)
+	assert.Equal(t, expected, response.Body.CapabilitiesGetResponse)
+
+	client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+	response, err = elementUnderTest.Enumerate()
+	assert.NoError(t, err)
+	assert.Equal(t, "D5000000-0000-0000-0000-000000000000", response.Body.EnumerateResponse.EnumerationContext)
+
+	client.CurrentMessage = wsmantesting.CurrentMessagePull
+	response, err = elementUnderTest.Pull(wsmantesting.EnumerationContext)
+	assert.NoError(t, err)
+	assert.Equal(t, []CapabilitiesResponse{expected}, response.Body.PullResponse.CapabilitiesItems)
+}
+
+func TestPositiveAMT_ActiveFilterStatistics(t *testing.T) {
+	wsmanMessageCreator := message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/systemdefense/activefilterstatistics",
+	}
+	elementUnderTest := NewActiveFilterStatisticsWithClient(wsmanMessageCreator, &client)
+
+	expected := ActiveFilterStatisticsResponse{
+		XMLName:        xml.Name{Space: message.AMTSchema + AMTActiveFilterStatistics, Local: AMTActiveFilterStatistics},
+		ElementName:    "Intel(r) AMT Active Filter Statistics",
+		InstanceID:     "Intel(r) AMT:Active Filter Statistics 1",
+		ActivationTime: "2026-01-02T03:04:05Z",
+		LastResetTime:  "2026-01-02T03:04:05Z",
+		ReadCount:      42,
+		FilterMatched:  true,
+	}
+
+	client.CurrentMessage = wsmantesting.CurrentMessageGet
+	response, err := elementUnderTest.Get()
+	assert.NoError(t, err)
+	assert.Contains(t, response.XMLInput, AMTActiveFilterStatistics)
+	assert.Equal(t, expected, response.Body.ActiveFilterStatisticsGetResponse)
+
+	client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+	_, err = elementUnderTest.Enumerate()
+	assert.NoError(t, err)
+
+	client.CurrentMessage = wsmantesting.CurrentMessagePull
+	response, err = elementUnderTest.Pull(wsmantesting.EnumerationContext)
+	assert.NoError(t, err)
+	assert.Equal(t, []ActiveFilterStatisticsResponse{expected}, response.Body.PullResponse.ActiveFilterStatisticsItems)
+}
+
+func TestNegativeAMT_SystemDefenseReadOnly(t *testing.T) {
+	wsmanMessageCreator := message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase)
+	client := wsmantesting.MockClient{CurrentMessage: wsmantesting.CurrentMessageError}
+
+	_, err := NewCapabilitiesWithClient(wsmanMessageCreator, &client).Get()
+	assert.Error(t, err)
+
+	_, err = NewActiveFilterStatisticsWithClient(wsmanMessageCreator, &client).Pull(wsmantesting.EnumerationContext)
+	assert.Error(t, err)
+
+	_, err = NewNetworkPortDefensePolicyWithClient(wsmanMessageCreator, &client).Enumerate()
+	assert.Error(t, err)
+}
@@ -0,0 +1,136 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+// INPUTS Constants.
+const (
+	AMTSystemDefensePolicy              string = "AMT_SystemDefensePolicy"
+	AMTIPHeadersFilter                  string = "AMT_IPHeadersFilter"
+	AMTNetworkPortSystemDefensePolicy   string = "AMT_NetworkPortSystemDefensePolicy"
+	AMTNetworkPortDefensePolicy         string = "AMT_NetworkPortDefensePolicy"
+	AMTActiveFilterStatistics           string = "AMT_ActiveFilterStatistics"
+	AMTGeneralSystemDefenseCapabilities string = "AMT_GeneralSystemDefenseCapabilities"
+	CIMEthernetPort                     string = "CIM_EthernetPort"
+	ValueNotFound                       string = "Value not found in map"
+)
+
+// Network ports that System Defense policies can be applied to.
+const (
+	WiredPort    string = "Intel(r) AMT Ethernet Port 0"
+	WirelessPort string = "Intel(r) AMT Ethernet Port 1"
+)
+
+const (
+	FilterDirectionTransmit FilterDirection = iota // Outgoing packets
+	FilterDirectionReceive                         // Incoming packets
+)
+
+// filterDirectionToString is a map of FilterDirection values to their string representation.
+var filterDirectionToString = map[FilterDirection]string{
+	FilterDirectionTransmit: "Transmit",
+	FilterDirectionReceive:  "Receive",
+}
+
+// String returns the string representation of the FilterDirection value.
+func (d FilterDirection) String() string {
+	if value, exists := filterDirectionToString[d]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+const (
+	FilterProfilePassWithStatistics FilterProfile = iota // Matching packets pass and are counted
+	FilterProfileDropWithStatistics                      // Matching packets are dropped and counted
+	FilterProfileRateLimit                               // Matching packets pass up to the rate in FilterProfileData
+	FilterProfileStatistics                              // Matching packets are counted, the policy default applies
+)
+
+// filterProfileToString is a map of FilterProfile values to their string representation.
+var filterProfileToString = map[FilterProfile]string{
+	FilterProfilePassWithStatistics: "PassWithStatistics",
+	FilterProfileDropWithStatistics: "DropWithStatistics",
+	FilterProfileRateLimit:          "RateLimit",
+	FilterProfileStatistics:         "Statistics",
+}
+
+// String returns the string representation of the FilterProfile value.
+func (p FilterProfile) String() string {
+	if value, exists := filterProfileToString[p]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+const (
+	IPVersion4 IPVersion = 4
+	IPVersion6 IPVersion = 6
+)
+
+// ipVersionToString is a map of IPVersion values to their string representation.
+var ipVersionToString = map[IPVersion]string{
+	IPVersion4: "IPv4",
+	IPVersion6: "IPv6",
+}
+
+// String returns the string representation of the IPVersion value.
+func (v IPVersion) String() string {
+	if value, exists := ipVersionToString[v]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+const (
+	ProtocolICMP   Protocol = 1
+	ProtocolTCP    Protocol = 6
+	ProtocolUDP    Protocol = 17
+	ProtocolICMPv6 Protocol = 58
+)
+
+// protocolToString is a map of Protocol values to their string representation.
+var protocolToString = map[Protocol]string{
+	ProtocolICMP:   "ICMP",
+	ProtocolTCP:    "TCP",
+	ProtocolUDP:    "UDP",
+	ProtocolICMPv6: "ICMPv6",
+}
+
+// String returns the string representation of the Protocol value.
+func (p Protocol) String() string {
+	if value, exists := protocolToString[p]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+const (
+	AntiSpoofingOff           AntiSpoofing = iota // Spoofed packets are not checked
+	AntiSpoofingEventOnMatch                      // An event is generated for spoofed packets
+	AntiSpoofingCount                             // Spoofed packets are counted
+	AntiSpoofingEventAndCount                     // Spoofed packets are counted and an event is generated
+)
+
+// antiSpoofingToString is a map of AntiSpoofing values to their string representation.
+var antiSpoofingToString = map[AntiSpoofing]string{
+	AntiSpoofingOff:           "Off",
+	AntiSpoofingEventOnMatch:  "EventOnMatch",
+	AntiSpoofingCount:         "Count",
+	AntiSpoofingEventAndCount: "EventAndCount",
+}
+
+// String returns the string representation of the AntiSpoofing value.
+func (a AntiSpoofing) String() string {
+	if value, exists := antiSpoofingToString[a]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
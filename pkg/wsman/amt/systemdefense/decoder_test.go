@@ -0,0 +1,85 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterDirection_String(t *testing.T) {
+	assert.Equal(t, "Transmit", FilterDirectionTransmit.String())
+	assert.Equal(t, "Receive", FilterDirectionReceive.String())
+	assert.Equal(t, ValueNotFound, FilterDirection(999).String())
+}
+
+func TestFilterProfile_String(t *testing.T) {
+	tests := []struct {
+		profile  FilterProfile
+		expected string
+	}{
+		{FilterProfilePassWithStatistics, "PassWithStatistics"},
+		{FilterProfileDropWithStatistics, "DropWithStatistics"},
+		{FilterProfileRateLimit, "RateLimit"},
+		{FilterProfileStatistics, "Statistics"},
+		{FilterProfile(999), ValueNotFound},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, test.profile.String())
+	}
+}
+
+func TestIPVersion_String(t *testing.T) {
+	assert.Equal(t, "IPv4", IPVersion4.String())
+	assert.Equal(t, "IPv6", IPVersion6.String())
+	assert.Equal(t, ValueNotFound, IPVersion(5).String())
+}
+
+func TestProtocol_String(t *testing.T) {
+	tests := []struct {
+		protocol Protocol
+		expected string
+	}{
+		{ProtocolICMP, "ICMP"},
+		{ProtocolTCP, "TCP"},
+		{ProtocolUDP, "UDP"},
+		{ProtocolICMPv6, "ICMPv6"},
+		{Protocol(999), ValueNotFound},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, test.protocol.String())
+	}
+}
+
+func TestAntiSpoofing_String(t *testing.T) {
+	tests := []struct {
+		antiSpoofing AntiSpoofing
+		expected     string
+	}{
+		{AntiSpoofingOff, "Off"},
+		{AntiSpoofingEventOnMatch, "EventOnMatch"},
+		{AntiSpoofingCount, "Count"},
+		{AntiSpoofingEventAndCount, "EventAndCount"},
+		{AntiSpoofing(999), ValueNotFound},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, test.antiSpoofing.String())
+	}
+}
+
+func TestResponseMarshaling(t *testing.T) {
+	response := Response{Body: Body{PolicyGetResponse: quarantinePolicy}}
+
+	json := response.JSON()
+	assert.Contains(t, json, `"PolicyRuleName":"Quarantine"`)
+
+	yaml := response.YAML()
+	assert.Contains(t, yaml, "policyrulename: Quarantine")
+}
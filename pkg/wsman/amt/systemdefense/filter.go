@@ -0,0 +1,198 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/base"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+var (
+	ErrInvalidFilter = errors.New("invalid system defense filter")
+	ErrInvalidHandle = errors.New("no creation handle in name")
+)
+
+// NewIPHeadersFilterWithClient instantiates a new IP Headers Filter service.
+func NewIPHeadersFilterWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) IPHeadersFilter {
+	return IPHeadersFilter{
+		base.NewService[Response](wsmanMessageCreator, AMTIPHeadersFilter, client),
+	}
+}
+
+// Create adds a filter. The Name selector of Body.CreateResponse identifies the new filter, see Handle.
+func (filter IPHeadersFilter) Create(request IPHeadersFilterRequest, opts ...base.HeaderOption) (response Response, err error) {
+	return execute(&filter.Base, filter.Base.Create(&request, nil, opts...))
+}
+
+// Delete removes the filter with the given name. A filter can't be deleted while a policy references it.
+func (filter IPHeadersFilter) Delete(name string, opts ...base.HeaderOption) (response Response, err error) {
+	selector := message.Selector{Name: "Name", Value: name}
+
+	return execute(&filter.Base, filter.Base.Delete(selector, opts...))
+}
+
+// Handle returns the creation handle of a filter, which AMT appends to the filter name, e.g. 3 for
+// "Intel(r) AMT:IP Filter 3". Policies reference their filters by these handles.
+func Handle(name string) (int, error) {
+	start := strings.LastIndexFunc(name, func(r rune) bool { return r < '0' || r > '9' }) + 1
+
+	handle, err := strconv.Atoi(name[start:])
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidHandle, name)
+	}
+
+	return handle, nil
+}
+
+// FilterOption configures a filter built by NewIPFilter.
+type FilterOption func(*IPHeadersFilterRequest) error
+
+// NewIPFilter builds an IP headers filter. Without options the filter matches every IPv4 packet in the given direction.
+func NewIPFilter(name string, direction FilterDirection, profile FilterProfile, opts ...FilterOption) (IPHeadersFilterRequest, error) {
+	request := IPHeadersFilterRequest{
+		ElementName:     name,
+		FilterDirection: direction,
+		FilterProfile:   profile,
+	}
+
+	for _, opt := range opts {
+		if err := opt(&request); err != nil {
+			return IPHeadersFilterRequest{}, err
+		}
+	}
+
+	if request.HdrIPVersion == 0 {
+		request.HdrIPVersion = IPVersion4
+	}
+
+	ports := request.HdrSrcPortStart != 0 || request.HdrDestPortStart != 0
+	if ports && request.HdrProtocolID != ProtocolTCP && request.HdrProtocolID != ProtocolUDP {
+		return IPHeadersFilterRequest{}, fmt.Errorf("%w: ports require TCP or UDP", ErrInvalidFilter)
+	}
+
+	if request.FilterProfile == FilterProfileRateLimit && request.FilterProfileData == 0 {
+		return IPHeadersFilterRequest{}, fmt.Errorf("%w: rate limit requires WithRateLimit", ErrInvalidFilter)
+	}
+
+	return request, nil
+}
+
+// WithSource matches packets from the addresses in prefix.
+func WithSource(prefix netip.Prefix) FilterOption {
+	return func(request *IPHeadersFilterRequest) error {
+		address, mask, err := encodePrefix(request, prefix)
+		request.HdrSrcAddress, request.HdrSrcMask = address, mask
+
+		return err
+	}
+}
+
+// WithDestination matches packets to the addresses in prefix.
+func WithDestination(prefix netip.Prefix) FilterOption {
+	return func(request *IPHeadersFilterRequest) error {
+		address, mask, err := encodePrefix(request, prefix)
+		request.HdrDestAddress, request.HdrDestMask = address, mask
+
+		return err
+	}
+}
+
+// WithProtocol matches packets of the given IP protocol.
+func WithProtocol(protocol Protocol) FilterOption {
+	return func(request *IPHeadersFilterRequest) error {
+		request.HdrProtocolID = protocol
+
+		return nil
+	}
+}
+
+// WithSourcePorts matches TCP or UDP packets from the ports start to end, inclusive.
+func WithSourcePorts(start, end uint16) FilterOption {
+	return func(request *IPHeadersFilterRequest) error {
+		if start == 0 || start > end {
+			return fmt.Errorf("%w: source ports %d-%d", ErrInvalidFilter, start, end)
+		}
+
+		request.HdrSrcPortStart, request.HdrSrcPortEnd = int(start), int(end)
+
+		return nil
+	}
+}
+
+// WithDestinationPorts matches TCP or UDP packets to the ports start to end, inclusive.
+func WithDestinationPorts(start, end uint16) FilterOption {
+	return func(request *IPHeadersFilterRequest) error {
+		if start == 0 || start > end {
+			return fmt.Errorf("%w: destination ports %d-%d", ErrInvalidFilter, start, end)
+		}
+
+		request.HdrDestPortStart, request.HdrDestPortEnd = int(start), int(end)
+
+		return nil
+	}
+}
+
+// WithRateLimit lets matching packets pass up to packetsPerSecond and drops the rest.
+func WithRateLimit(packetsPerSecond uint32) FilterOption {
+	return func(request *IPHeadersFilterRequest) error {
+		if packetsPerSecond == 0 {
+			return fmt.Errorf("%w: rate limit of 0 packets per second", ErrInvalidFilter)
+		}
+
+		request.FilterProfile = FilterProfileRateLimit
+		request.FilterProfileData = int(packetsPerSecond)
+
+		return nil
+	}
+}
+
+// WithEventOnMatch generates an event when a packet matches the filter.
+func WithEventOnMatch() FilterOption {
+	return func(request *IPHeadersFilterRequest) error {
+		request.ActionEventOnMatch = true
+
+		return nil
+	}
+}
+
+// encodePrefix returns the base64 encoded address and mask of prefix, and sets the IP version of the filter, which
+// must be the same for its source and destination.
+func encodePrefix(request *IPHeadersFilterRequest, prefix netip.Prefix) (address, mask string, err error) {
+	if !prefix.IsValid() {
+		return "", "", fmt.Errorf("%w: prefix %s", ErrInvalidFilter, prefix)
+	}
+
+	addr, bits := prefix.Addr(), prefix.Bits()
+	if addr.Is4In6() {
+		addr, bits = addr.Unmap(), max(bits-96, 0)
+	}
+
+	prefix = netip.PrefixFrom(addr, bits).Masked()
+	addr = prefix.Addr()
+
+	version := IPVersion6
+	if addr.Is4() {
+		version = IPVersion4
+	}
+
+	if request.HdrIPVersion != 0 && request.HdrIPVersion != version {
+		return "", "", fmt.Errorf("%w: source and destination must both be %s", ErrInvalidFilter, request.HdrIPVersion)
+	}
+
+	request.HdrIPVersion = version
+
+	return base64.StdEncoding.EncodeToString(addr.AsSlice()),
+		base64.StdEncoding.EncodeToString(net.CIDRMask(bits, addr.BitLen())), nil
+}
@@ -0,0 +1,174 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import (
+	"encoding/xml"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+func TestPositiveAMT_IPHeadersFilter(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/systemdefense/ipheadersfilter",
+	}
+	elementUnderTest := NewIPHeadersFilterWithClient(wsmanMessageCreator, &client)
+
+	filter, err := NewIPFilter("Quarantine 192.168.1.0/24 out", FilterDirectionTransmit, FilterProfilePassWithStatistics,
+		WithDestination(netip.MustParsePrefix("192.168.1.0/24")))
+	require.NoError(t, err)
+
+	t.Run("amt_IPHeadersFilter Tests", func(t *testing.T) {
+		client.CurrentMessage = wsmantesting.CurrentMessageGet
+		response, err := elementUnderTest.Get()
+		assert.NoError(t, err)
+		assert.Equal(t, wsmantesting.ExpectedResponse(messageID, resourceURIBase, AMTIPHeadersFilter, wsmantesting.Get, "", ""), response.XMLInput)
+		assert.Equal(t, IPHeadersFilterResponse{
+			XMLName:                 xml.Name{Space: message.AMTSchema + AMTIPHeadersFilter, Local: AMTIPHeadersFilter},
+			CreationClassName:       AMTIPHeadersFilter,
+			ElementName:             "Quarantine 192.168.1.0/24 out",
+			Name:                    "Intel(r) AMT:IP Filter 1",
+			SystemCreationClassName: "CIM_ComputerSystem",
+			SystemName:              "Intel(r) AMT",
+			FilterDirection:         FilterDirectionTransmit,
+			FilterProfile:           FilterProfilePassWithStatistics,
+			HdrIPVersion:            IPVersion4,
+			HdrDestAddress:          filter.HdrDestAddress,
+			HdrDestMask:             filter.HdrDestMask,
+		}, response.Body.IPHeadersFilterGetResponse)
+
+		messageID++
+
+		client.CurrentMessage = wsmantesting.CurrentMessagePull
+		response, err = elementUnderTest.Pull(wsmantesting.EnumerationContext)
+		assert.NoError(t, err)
+		assert.Len(t, response.Body.PullResponse.IPHeadersFilterItems, 1)
+
+		messageID++
+
+		client.CurrentMessage = wsmantesting.CurrentMessageCreate
+		response, err = elementUnderTest.Create(filter)
+		assert.NoError(t, err)
+		assert.Equal(t, wsmantesting.ExpectedResponse(messageID, resourceURIBase, AMTIPHeadersFilter, wsmantesting.Create, "",
+			`<h:AMT_IPHeadersFilter xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_IPHeadersFilter"><h:ElementName>Quarantine 192.168.1.0/24 out</h:ElementName><h:ActionEventOnMatch>false</h:ActionEventOnMatch><h:FilterDirection>0</h:FilterDirection><h:FilterProfile>0</h:FilterProfile><h:HdrIPVersion>4</h:HdrIPVersion><h:HdrDestAddress>wKgBAA==</h:HdrDestAddress><h:HdrDestMask>////AA==</h:HdrDestMask></h:AMT_IPHeadersFilter>`),
+			response.XMLInput)
+		assert.Equal(t, "Intel(r) AMT:IP Filter 1", response.Body.CreateResponse.Selector("Name"))
+
+		messageID++
+
+		client.CurrentMessage = wsmantesting.CurrentMessageDelete
+		response, err = elementUnderTest.Delete("Intel(r) AMT:IP Filter 1")
+		assert.NoError(t, err)
+		assert.Equal(t, wsmantesting.ExpectedResponse(messageID, resourceURIBase, AMTIPHeadersFilter, wsmantesting.Delete,
+			`<w:SelectorSet><w:Selector Name="Name">Intel(r) AMT:IP Filter 1</w:Selector></w:SelectorSet>`, ""), response.XMLInput)
+	})
+}
+
+func TestNegativeAMT_IPHeadersFilter(t *testing.T) {
+	wsmanMessageCreator := message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/systemdefense/ipheadersfilter",
+		CurrentMessage:   wsmantesting.CurrentMessageError,
+	}
+	elementUnderTest := NewIPHeadersFilterWithClient(wsmanMessageCreator, &client)
+
+	_, err := elementUnderTest.Create(IPHeadersFilterRequest{})
+	assert.Error(t, err)
+
+	_, err = elementUnderTest.Delete("Intel(r) AMT:IP Filter 1")
+	assert.Error(t, err)
+}
+
+func TestNewIPFilter(t *testing.T) {
+	filter, err := NewIPFilter("web", FilterDirectionReceive, FilterProfileDropWithStatistics,
+		WithSource(netip.MustParsePrefix("2001:db8::1/32")),
+		WithDestination(netip.MustParsePrefix("2001:db8:1::/48")),
+		WithProtocol(ProtocolTCP),
+		WithSourcePorts(1024, 65535),
+		WithDestinationPorts(443, 443),
+		WithEventOnMatch())
+	require.NoError(t, err)
+
+	assert.Equal(t, IPHeadersFilterRequest{
+		ElementName:        "web",
+		ActionEventOnMatch: true,
+		FilterDirection:    FilterDirectionReceive,
+		FilterProfile:      FilterProfileDropWithStatistics,
+		HdrIPVersion:       IPVersion6,
+		HdrSrcAddress:      "IAENuAAAAAAAAAAAAAAAAA==",
+		HdrSrcMask:         "/////wAAAAAAAAAAAAAAAA==",
+		HdrDestAddress:     "IAENuAABAAAAAAAAAAAAAA==",
+		HdrDestMask:        "////////AAAAAAAAAAAAAA==",
+		HdrProtocolID:      ProtocolTCP,
+		HdrSrcPortStart:    1024,
+		HdrSrcPortEnd:      65535,
+		HdrDestPortStart:   443,
+		HdrDestPortEnd:     443,
+	}, filter)
+
+	t.Run("defaults to IPv4", func(t *testing.T) {
+		filter, err := NewIPFilter("all", FilterDirectionTransmit, FilterProfilePassWithStatistics)
+		require.NoError(t, err)
+		assert.Equal(t, IPVersion4, filter.HdrIPVersion)
+	})
+
+	t.Run("IPv4-mapped prefix", func(t *testing.T) {
+		filter, err := NewIPFilter("mapped", FilterDirectionTransmit, FilterProfilePassWithStatistics,
+			WithDestination(netip.MustParsePrefix("::ffff:10.0.0.1/104")))
+		require.NoError(t, err)
+		assert.Equal(t, IPVersion4, filter.HdrIPVersion)
+		assert.Equal(t, "CgAAAA==", filter.HdrDestAddress)
+		assert.Equal(t, "/wAAAA==", filter.HdrDestMask)
+	})
+
+	t.Run("rate limit", func(t *testing.T) {
+		filter, err := NewIPFilter("icmp", FilterDirectionReceive, FilterProfilePassWithStatistics,
+			WithProtocol(ProtocolICMP), WithRateLimit(10))
+		require.NoError(t, err)
+		assert.Equal(t, FilterProfileRateLimit, filter.FilterProfile)
+		assert.Equal(t, 10, filter.FilterProfileData)
+	})
+
+	invalid := map[string][]FilterOption{
+		"mixed IP versions":     {WithSource(netip.MustParsePrefix("10.0.0.0/8")), WithDestination(netip.MustParsePrefix("2001:db8::/32"))},
+		"invalid prefix":        {WithSource(netip.Prefix{})},
+		"reversed port range":   {WithProtocol(ProtocolTCP), WithDestinationPorts(443, 80)},
+		"port 0":                {WithProtocol(ProtocolUDP), WithSourcePorts(0, 10)},
+		"ports without TCP":     {WithProtocol(ProtocolICMP), WithDestinationPorts(80, 80)},
+		"rate limit of 0":       {WithRateLimit(0)},
+		"ports and no protocol": {WithSourcePorts(80, 80)},
+	}
+
+	for name, opts := range invalid {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewIPFilter(name, FilterDirectionTransmit, FilterProfilePassWithStatistics, opts...)
+			assert.ErrorIs(t, err, ErrInvalidFilter)
+		})
+	}
+
+	t.Run("rate limit profile without rate", func(t *testing.T) {
+		_, err := NewIPFilter("rate", FilterDirectionTransmit, FilterProfileRateLimit)
+		assert.ErrorIs(t, err, ErrInvalidFilter)
+	})
+}
+
+func TestHandle(t *testing.T) {
+	handle, err := Handle("Intel(r) AMT:IP Filter 12")
+	require.NoError(t, err)
+	assert.Equal(t, 12, handle)
+
+	_, err = Handle("Intel(r) AMT:IP Filter")
+	assert.ErrorIs(t, err, ErrInvalidHandle)
+}
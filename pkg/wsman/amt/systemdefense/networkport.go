@@ -0,0 +1,96 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import (
+	"fmt"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/base"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewNetworkPortSystemDefensePolicyWithClient instantiates a new Network Port System Defense Policy service.
+func NewNetworkPortSystemDefensePolicyWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) NetworkPortSystemDefensePolicy {
+	return NetworkPortSystemDefensePolicy{
+		base.NewService[Response](wsmanMessageCreator, AMTNetworkPortSystemDefensePolicy, client),
+	}
+}
+
+// NewNetworkPortDefensePolicyWithClient instantiates a new Network Port Defense Policy service.
+func NewNetworkPortDefensePolicyWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) NetworkPortDefensePolicy {
+	return NetworkPortDefensePolicy{
+		base.NewService[Response](wsmanMessageCreator, AMTNetworkPortDefensePolicy, client),
+	}
+}
+
+// Create activates the named policy on a network port, WiredPort or WirelessPort.
+func (portPolicy NetworkPortSystemDefensePolicy) Create(policyRuleName, port string, opts ...base.HeaderOption) (response Response, err error) {
+	request := NetworkPortSystemDefensePolicyRequest{
+		PolicySet: PolicyReference(policyRuleName),
+		ManagedElement: EndpointReferenceRequest{
+			Address:             "/wsman",
+			ReferenceParameters: newReferenceParameters(message.CIMSchema+CIMEthernetPort, portSelectors(port)),
+		},
+	}
+
+	return execute(&portPolicy.Base, portPolicy.Base.Create(&request, nil, opts...))
+}
+
+// Delete deactivates the named policy on a network port.
+func (portPolicy NetworkPortSystemDefensePolicy) Delete(policyRuleName, port string, opts ...base.HeaderOption) (response Response, err error) {
+	selectors := []message.Selector{
+		{Name: "PolicySet", Value: endpointReference(message.AMTSchema+AMTSystemDefensePolicy, policySelectors(policyRuleName))},
+		{Name: "ManagedElement", Value: endpointReference(message.CIMSchema+CIMEthernetPort, portSelectors(port))},
+	}
+	header := portPolicy.Base.WSManMessageCreator.CreateHeader(message.BaseActionsDelete, AMTNetworkPortSystemDefensePolicy, selectors, "", "", opts...)
+
+	return execute(&portPolicy.Base, portPolicy.Base.WSManMessageCreator.CreateXML(header, message.DeleteBody))
+}
+
+// PolicyReference returns a reference to the named policy, for example to apply it from an Agent Presence watchdog
+// action.
+func PolicyReference(policyRuleName string) EndpointReferenceRequest {
+	return EndpointReferenceRequest{
+		Address:             "/wsman",
+		ReferenceParameters: newReferenceParameters(message.AMTSchema+AMTSystemDefensePolicy, policySelectors(policyRuleName)),
+	}
+}
+
+func policySelectors(policyRuleName string) []SelectorRequest {
+	return []SelectorRequest{
+		{Name: "CreationClassName", Text: AMTSystemDefensePolicy},
+		{Name: "PolicyRuleName", Text: policyRuleName},
+		{Name: "SystemCreationClassName", Text: "CIM_ComputerSystem"},
+		{Name: "SystemName", Text: "Intel(r) AMT"},
+	}
+}
+
+func portSelectors(port string) []SelectorRequest {
+	return []SelectorRequest{
+		{Name: "CreationClassName", Text: CIMEthernetPort},
+		{Name: "DeviceID", Text: port},
+		{Name: "SystemCreationClassName", Text: "CIM_ComputerSystem"},
+		{Name: "SystemName", Text: "ManagedSystem"},
+	}
+}
+
+func newReferenceParameters(resourceURI string, selectors []SelectorRequest) ReferenceParametersRequest {
+	return ReferenceParametersRequest{
+		ResourceURI: resourceURI,
+		SelectorSet: SelectorSetRequest{Selectors: selectors},
+	}
+}
+
+// endpointReference returns an endpoint reference to be used as the value of a header selector.
+func endpointReference(resourceURI string, selectors []SelectorRequest) string {
+	var selectorSet string
+	for _, selector := range selectors {
+		selectorSet += fmt.Sprintf(`<Selector Name="%s">%s</Selector>`, selector.Name, selector.Text)
+	}
+
+	return fmt.Sprintf(`<EndpointReference xmlns="http://schemas.xmlsoap.org/ws/2004/08/addressing"><Address>http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous</Address><ReferenceParameters><ResourceURI xmlns="http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd">%s</ResourceURI><SelectorSet xmlns="http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd">%s</SelectorSet></ReferenceParameters></EndpointReference>`, resourceURI, selectorSet)
+}
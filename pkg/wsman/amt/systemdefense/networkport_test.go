@@ -0,0 +1,106 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+const (
+	policyReference = `<a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>http://intel.com/wbem/wscim/1/amt-schema/1/AMT_SystemDefensePolicy</w:ResourceURI><w:SelectorSet><w:Selector Name="CreationClassName">AMT_SystemDefensePolicy</w:Selector><w:Selector Name="PolicyRuleName">Quarantine</w:Selector><w:Selector Name="SystemCreationClassName">CIM_ComputerSystem</w:Selector><w:Selector Name="SystemName">Intel(r) AMT</w:Selector></w:SelectorSet></a:ReferenceParameters>`
+	portReference   = `<a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_EthernetPort</w:ResourceURI><w:SelectorSet><w:Selector Name="CreationClassName">CIM_EthernetPort</w:Selector><w:Selector Name="DeviceID">Intel(r) AMT Ethernet Port 0</w:Selector><w:Selector Name="SystemCreationClassName">CIM_ComputerSystem</w:Selector><w:Selector Name="SystemName">ManagedSystem</w:Selector></w:SelectorSet></a:ReferenceParameters>`
+)
+
+func TestPositiveAMT_NetworkPortSystemDefensePolicy(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/systemdefense/networkportsystemdefensepolicy",
+	}
+	elementUnderTest := NewNetworkPortSystemDefensePolicyWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_NetworkPortSystemDefensePolicy Tests", func(t *testing.T) {
+		client.CurrentMessage = wsmantesting.CurrentMessageGet
+		response, err := elementUnderTest.Get()
+		assert.NoError(t, err)
+		assert.Equal(t, wsmantesting.ExpectedResponse(messageID, resourceURIBase, AMTNetworkPortSystemDefensePolicy, wsmantesting.Get, "", ""), response.XMLInput)
+
+		association := response.Body.NetworkPortSystemDefensePolicyGetResponse
+		assert.Equal(t, message.AMTSchema+AMTSystemDefensePolicy, association.PolicySet.ReferenceParameters.ResourceURI)
+		assert.Equal(t, "Quarantine", association.PolicySet.ReferenceParameters.SelectorSet.Selectors[1].Text)
+		assert.Equal(t, WiredPort, association.ManagedElement.ReferenceParameters.SelectorSet.Selectors[1].Text)
+
+		messageID++
+
+		client.CurrentMessage = wsmantesting.CurrentMessagePull
+		response, err = elementUnderTest.Pull(wsmantesting.EnumerationContext)
+		assert.NoError(t, err)
+		assert.Equal(t, []NetworkPortPolicyResponse{association}, response.Body.PullResponse.NetworkPortSystemDefensePolicyItems)
+
+		messageID++
+
+		client.CurrentMessage = wsmantesting.CurrentMessageCreate
+		response, err = elementUnderTest.Create("Quarantine", WiredPort)
+		assert.NoError(t, err)
+		assert.Equal(t, wsmantesting.ExpectedResponse(messageID, resourceURIBase, AMTNetworkPortSystemDefensePolicy, wsmantesting.Create, "",
+			`<h:AMT_NetworkPortSystemDefensePolicy xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_NetworkPortSystemDefensePolicy"><h:PolicySet>`+policyReference+`</h:PolicySet><h:ManagedElement>`+portReference+`</h:ManagedElement></h:AMT_NetworkPortSystemDefensePolicy>`),
+			response.XMLInput)
+
+		messageID++
+
+		client.CurrentMessage = wsmantesting.CurrentMessageDelete
+		response, err = elementUnderTest.Delete("Quarantine", WiredPort)
+		assert.NoError(t, err)
+		assert.Equal(t, wsmantesting.ExpectedResponse(messageID, resourceURIBase, AMTNetworkPortSystemDefensePolicy, wsmantesting.Delete,
+			`<w:SelectorSet><w:Selector Name="PolicySet"><EndpointReference xmlns="http://schemas.xmlsoap.org/ws/2004/08/addressing"><Address>http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous</Address><ReferenceParameters><ResourceURI xmlns="http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd">http://intel.com/wbem/wscim/1/amt-schema/1/AMT_SystemDefensePolicy</ResourceURI><SelectorSet xmlns="http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd"><Selector Name="CreationClassName">AMT_SystemDefensePolicy</Selector><Selector Name="PolicyRuleName">Quarantine</Selector><Selector Name="SystemCreationClassName">CIM_ComputerSystem</Selector><Selector Name="SystemName">Intel(r) AMT</Selector></SelectorSet></ReferenceParameters></EndpointReference></w:Selector>`+
+				`<w:Selector Name="ManagedElement"><EndpointReference xmlns="http://schemas.xmlsoap.org/ws/2004/08/addressing"><Address>http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous</Address><ReferenceParameters><ResourceURI xmlns="http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd">http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_EthernetPort</ResourceURI><SelectorSet xmlns="http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd"><Selector Name="CreationClassName">CIM_EthernetPort</Selector><Selector Name="DeviceID">Intel(r) AMT Ethernet Port 0</Selector><Selector Name="SystemCreationClassName">CIM_ComputerSystem</Selector><Selector Name="SystemName">ManagedSystem</Selector></SelectorSet></ReferenceParameters></EndpointReference></w:Selector></w:SelectorSet>`,
+			""), response.XMLInput)
+	})
+}
+
+func TestNegativeAMT_NetworkPortSystemDefensePolicy(t *testing.T) {
+	wsmanMessageCreator := message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/systemdefense/networkportsystemdefensepolicy",
+		CurrentMessage:   wsmantesting.CurrentMessageError,
+	}
+	elementUnderTest := NewNetworkPortSystemDefensePolicyWithClient(wsmanMessageCreator, &client)
+
+	_, err := elementUnderTest.Create("Quarantine", WiredPort)
+	assert.Error(t, err)
+
+	_, err = elementUnderTest.Delete("Quarantine", WiredPort)
+	assert.Error(t, err)
+}
+
+func TestPositiveAMT_NetworkPortDefensePolicy(t *testing.T) {
+	wsmanMessageCreator := message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/systemdefense/networkportdefensepolicy",
+	}
+	elementUnderTest := NewNetworkPortDefensePolicyWithClient(wsmanMessageCreator, &client)
+
+	client.CurrentMessage = wsmantesting.CurrentMessageGet
+	response, err := elementUnderTest.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, WiredPort, response.Body.NetworkPortDefensePolicyGetResponse.ManagedElement.ReferenceParameters.SelectorSet.Selectors[1].Text)
+
+	client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+	response, err = elementUnderTest.Enumerate()
+	assert.NoError(t, err)
+	assert.Equal(t, "D5000000-0000-0000-0000-000000000000", response.Body.EnumerateResponse.EnumerationContext)
+
+	client.CurrentMessage = wsmantesting.CurrentMessagePull
+	response, err = elementUnderTest.Pull(wsmantesting.EnumerationContext)
+	assert.NoError(t, err)
+	assert.Len(t, response.Body.PullResponse.NetworkPortDefensePolicyItems, 1)
+}
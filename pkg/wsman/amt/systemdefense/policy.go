@@ -0,0 +1,81 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package systemdefense facilitates communication with Intel® AMT devices to isolate hosts with System Defense network filters.
+//
+// System Defense Policy:
+// A set of filters and the default actions for the packets that match none of them.
+// A policy takes effect once it is applied to a network port with AMT_NetworkPortSystemDefensePolicy.
+//
+// IP Headers Filter:
+// Matches IP packets by address, protocol and port; see NewIPFilter.
+// 802.1 filters are created with the hdr8021filter package and can be added to the same policies.
+//
+// Network Port System Defense Policy:
+// Applies a policy to a network port. Creating an instance activates the policy and deleting it deactivates the policy.
+//
+// Network Port Defense Policy, Active Filter Statistics and General System Defense Capabilities:
+// Read only classes reporting the policies in effect, the filter counters and the limits of the device.
+//
+// Isolation.Quarantine combines these to cut a host off the network except for its management traffic.
+package systemdefense
+
+import (
+	"encoding/xml"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/base"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewPolicyWithClient instantiates a new System Defense Policy service.
+func NewPolicyWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) Policy {
+	return Policy{
+		base.NewService[Response](wsmanMessageCreator, AMTSystemDefensePolicy, client),
+	}
+}
+
+// Create adds a policy. The filters must be created first, as the policy references them by handle.
+func (policy Policy) Create(request PolicyRequest, opts ...base.HeaderOption) (response Response, err error) {
+	return execute(&policy.Base, policy.Base.Create(&request, nil, opts...))
+}
+
+// Delete removes the policy with the given name. An active policy must be removed from its ports first.
+func (policy Policy) Delete(policyRuleName string, opts ...base.HeaderOption) (response Response, err error) {
+	selector := message.Selector{Name: "PolicyRuleName", Value: policyRuleName}
+
+	return execute(&policy.Base, policy.Base.Delete(selector, opts...))
+}
+
+// Selector returns the value of the named selector of the created instance, or "" when there is none.
+func (r ResourceCreated) Selector(name string) string {
+	for _, selector := range r.ReferenceParameters.SelectorSet.Selectors {
+		if selector.Name == name {
+			return selector.Text
+		}
+	}
+
+	return ""
+}
+
+func execute(b *message.Base, xmlInput string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: xmlInput,
+		},
+	}
+
+	err = b.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
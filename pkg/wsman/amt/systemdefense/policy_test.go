@@ -0,0 +1,187 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+var quarantinePolicy = PolicyResponse{
+	XMLName:                 xml.Name{Space: message.AMTSchema + AMTSystemDefensePolicy, Local: AMTSystemDefensePolicy},
+	CreationClassName:       AMTSystemDefensePolicy,
+	ElementName:             "Quarantine",
+	PolicyRuleName:          "Quarantine",
+	SystemCreationClassName: "CIM_ComputerSystem",
+	SystemName:              "Intel(r) AMT",
+	PolicyPrecedence:        10,
+	AntiSpoofingSupport:     AntiSpoofingOff,
+	FilterCreationHandles:   []int{1, 2},
+	TxDefaultDrop:           true,
+	TxDefaultCount:          true,
+	RxDefaultDrop:           true,
+	RxDefaultCount:          true,
+}
+
+func TestPositiveAMT_SystemDefensePolicy(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/systemdefense/policy",
+	}
+	elementUnderTest := NewPolicyWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_SystemDefensePolicy Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			body             string
+			extraHeader      string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			{
+				"should create a valid AMT_SystemDefensePolicy Get wsman message",
+				AMTSystemDefensePolicy,
+				wsmantesting.Get,
+				"",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get()
+				},
+				Body{
+					XMLName:           xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PolicyGetResponse: quarantinePolicy,
+				},
+			},
+			{
+				"should create a valid AMT_SystemDefensePolicy Enumerate wsman message",
+				AMTSystemDefensePolicy,
+				wsmantesting.Enumerate,
+				wsmantesting.EnumerateBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "D5000000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			{
+				"should create a valid AMT_SystemDefensePolicy Pull wsman message",
+				AMTSystemDefensePolicy,
+				wsmantesting.Pull,
+				wsmantesting.PullBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName:     xml.Name{Space: message.XMLPullResponseSpace, Local: "PullResponse"},
+						PolicyItems: []PolicyResponse{quarantinePolicy},
+					},
+				},
+			},
+			{
+				"should create a valid AMT_SystemDefensePolicy Create wsman message",
+				AMTSystemDefensePolicy,
+				wsmantesting.Create,
+				`<h:AMT_SystemDefensePolicy xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_SystemDefensePolicy"><h:PolicyRuleName>Quarantine</h:PolicyRuleName><h:PolicyPrecedence>10</h:PolicyPrecedence><h:AntiSpoofingSupport>0</h:AntiSpoofingSupport><h:FilterCreationHandles>1</h:FilterCreationHandles><h:FilterCreationHandles>2</h:FilterCreationHandles><h:TxDefaultDrop>true</h:TxDefaultDrop><h:TxDefaultMatchEvent>false</h:TxDefaultMatchEvent><h:TxDefaultCount>true</h:TxDefaultCount><h:RxDefaultDrop>true</h:RxDefaultDrop><h:RxDefaultMatchEvent>false</h:RxDefaultMatchEvent><h:RxDefaultCount>true</h:RxDefaultCount></h:AMT_SystemDefensePolicy>`,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageCreate
+
+					return elementUnderTest.Create(PolicyRequest{
+						PolicyRuleName:        "Quarantine",
+						PolicyPrecedence:      10,
+						FilterCreationHandles: []int{1, 2},
+						TxDefaultDrop:         true,
+						TxDefaultCount:        true,
+						RxDefaultDrop:         true,
+						RxDefaultCount:        true,
+					})
+				},
+				"Quarantine",
+			},
+			{
+				"should create a valid AMT_SystemDefensePolicy Delete wsman message",
+				AMTSystemDefensePolicy,
+				wsmantesting.Delete,
+				"",
+				`<w:SelectorSet><w:Selector Name="PolicyRuleName">Quarantine</w:Selector></w:SelectorSet>`,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageDelete
+
+					return elementUnderTest.Delete("Quarantine")
+				},
+				Body{XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"}},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+
+				if policyRuleName, ok := test.expectedResponse.(string); ok {
+					assert.Equal(t, policyRuleName, response.Body.CreateResponse.Selector("PolicyRuleName"))
+				} else {
+					assert.Equal(t, test.expectedResponse, response.Body)
+				}
+			})
+		}
+	})
+}
+
+func TestNegativeAMT_SystemDefensePolicy(t *testing.T) {
+	wsmanMessageCreator := message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/systemdefense/policy",
+		CurrentMessage:   wsmantesting.CurrentMessageError,
+	}
+	elementUnderTest := NewPolicyWithClient(wsmanMessageCreator, &client)
+
+	_, err := elementUnderTest.Create(PolicyRequest{PolicyRuleName: "Quarantine"})
+	assert.Error(t, err)
+
+	_, err = elementUnderTest.Delete("Quarantine")
+	assert.Error(t, err)
+}
+
+func TestResourceCreated_Selector(t *testing.T) {
+	created := ResourceCreated{
+		ReferenceParameters: ReferenceParametersResponse{
+			SelectorSet: SelectorSetResponse{
+				Selectors: []SelectorResponse{{Name: "Name", Text: "Intel(r) AMT:IP Filter 1"}},
+			},
+		},
+	}
+
+	assert.Equal(t, "Intel(r) AMT:IP Filter 1", created.Selector("Name"))
+	assert.Empty(t, created.Selector("InstanceID"))
+}
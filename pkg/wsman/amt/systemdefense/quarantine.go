@@ -0,0 +1,204 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/base"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// DefaultQuarantinePolicyName is the name of the policy created by Quarantine when QuarantineSettings.PolicyName is empty.
+const DefaultQuarantinePolicyName = "Quarantine"
+
+var ErrQuarantineFailed = errors.New("failed to quarantine host")
+
+// Isolation quarantines hosts with the System Defense services.
+type Isolation struct {
+	Filters  IPHeadersFilter
+	Policies Policy
+	Ports    NetworkPortSystemDefensePolicy
+}
+
+// QuarantineSettings describes the host traffic that is still allowed while the host is quarantined. The management
+// traffic of AMT itself is not subject to System Defense filters and always passes.
+type QuarantineSettings struct {
+	PolicyName        string         // Name of the created policy, DefaultQuarantinePolicyName when empty
+	PolicyPrecedence  int            // Precedence of the policy over other active policies
+	Port              string         // Port the policy is applied to, WiredPort when empty
+	ManagementServers []netip.Prefix // Networks the host can still exchange traffic with, e.g. the remediation server
+	AllowDHCP         bool           // Whether the host can still renew its address lease
+	AllowDNS          bool           // Whether the host can still resolve names
+}
+
+// Quarantine records what Isolation.Quarantine created, so that Isolation.Release can remove it.
+type Quarantine struct {
+	PolicyName string
+	Port       string
+	Filters    []string // Names of the created filters
+}
+
+// NewIsolationWithClient instantiates the services used to quarantine hosts.
+func NewIsolationWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) Isolation {
+	return Isolation{
+		Filters:  NewIPHeadersFilterWithClient(wsmanMessageCreator, client),
+		Policies: NewPolicyWithClient(wsmanMessageCreator, client),
+		Ports:    NewNetworkPortSystemDefensePolicyWithClient(wsmanMessageCreator, client),
+	}
+}
+
+// Quarantine cuts the host off the network: it creates pass filters for the traffic allowed by settings, a policy that
+// drops and counts all other packets in both directions, and activates the policy on the port. When a step fails,
+// whatever was created so far is removed again.
+func (isolation Isolation) Quarantine(settings QuarantineSettings, opts ...base.HeaderOption) (Quarantine, error) {
+	quarantine := Quarantine{PolicyName: settings.PolicyName, Port: settings.Port}
+	if quarantine.PolicyName == "" {
+		quarantine.PolicyName = DefaultQuarantinePolicyName
+	}
+
+	if quarantine.Port == "" {
+		quarantine.Port = WiredPort
+	}
+
+	filters, err := quarantineFilters(quarantine.PolicyName, settings)
+	if err != nil {
+		return Quarantine{}, fmt.Errorf("%w: %w", ErrQuarantineFailed, err)
+	}
+
+	policy := PolicyRequest{
+		PolicyRuleName:        quarantine.PolicyName,
+		PolicyPrecedence:      settings.PolicyPrecedence,
+		FilterCreationHandles: make([]int, 0, len(filters)),
+		TxDefaultDrop:         true,
+		TxDefaultCount:        true,
+		RxDefaultDrop:         true,
+		RxDefaultCount:        true,
+	}
+
+	for _, filter := range filters {
+		response, err := isolation.Filters.Create(filter, opts...)
+		if err != nil {
+			return Quarantine{}, isolation.rollback(quarantine, fmt.Errorf("creating filter %q: %w", filter.ElementName, err), opts)
+		}
+
+		name := response.Body.CreateResponse.Selector("Name")
+
+		handle, err := Handle(name)
+		if err != nil {
+			return Quarantine{}, isolation.rollback(quarantine, err, opts)
+		}
+
+		quarantine.Filters = append(quarantine.Filters, name)
+		policy.FilterCreationHandles = append(policy.FilterCreationHandles, handle)
+	}
+
+	if _, err := isolation.Policies.Create(policy, opts...); err != nil {
+		return Quarantine{}, isolation.rollback(quarantine, fmt.Errorf("creating policy %q: %w", policy.PolicyRuleName, err), opts)
+	}
+
+	if _, err := isolation.Ports.Create(quarantine.PolicyName, quarantine.Port, opts...); err != nil {
+		err = fmt.Errorf("activating policy %q: %w", policy.PolicyRuleName, err)
+		if _, deleteErr := isolation.Policies.Delete(quarantine.PolicyName, opts...); deleteErr != nil {
+			err = errors.Join(err, deleteErr)
+		}
+
+		return Quarantine{}, isolation.rollback(quarantine, err, opts)
+	}
+
+	return quarantine, nil
+}
+
+// Release ends a quarantine: it deactivates and deletes the policy and deletes its filters. It carries on after a
+// failed step, so that as much as possible is removed, and returns all the errors.
+func (isolation Isolation) Release(quarantine Quarantine, opts ...base.HeaderOption) error {
+	var errs []error
+
+	if _, err := isolation.Ports.Delete(quarantine.PolicyName, quarantine.Port, opts...); err != nil {
+		errs = append(errs, fmt.Errorf("deactivating policy %q: %w", quarantine.PolicyName, err))
+	}
+
+	if _, err := isolation.Policies.Delete(quarantine.PolicyName, opts...); err != nil {
+		errs = append(errs, fmt.Errorf("deleting policy %q: %w", quarantine.PolicyName, err))
+	}
+
+	errs = append(errs, isolation.deleteFilters(quarantine.Filters, opts))
+
+	return errors.Join(errs...)
+}
+
+// rollback deletes the filters created so far and returns err wrapped in ErrQuarantineFailed.
+func (isolation Isolation) rollback(quarantine Quarantine, err error, opts []base.HeaderOption) error {
+	return fmt.Errorf("%w: %w", ErrQuarantineFailed, errors.Join(err, isolation.deleteFilters(quarantine.Filters, opts)))
+}
+
+func (isolation Isolation) deleteFilters(names []string, opts []base.HeaderOption) error {
+	var errs []error
+
+	for _, name := range names {
+		if _, err := isolation.Filters.Delete(name, opts...); err != nil {
+			errs = append(errs, fmt.Errorf("deleting filter %q: %w", name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// quarantineFilters returns the pass filters for the traffic allowed by settings, in both directions.
+func quarantineFilters(policyName string, settings QuarantineSettings) ([]IPHeadersFilterRequest, error) {
+	type rule struct {
+		name     string
+		transmit []FilterOption
+		receive  []FilterOption
+	}
+
+	var rules []rule
+
+	for _, server := range settings.ManagementServers {
+		rules = append(rules, rule{
+			name:     server.String(),
+			transmit: []FilterOption{WithDestination(server)},
+			receive:  []FilterOption{WithSource(server)},
+		})
+	}
+
+	if settings.AllowDHCP {
+		rules = append(rules, rule{
+			name:     "DHCP",
+			transmit: []FilterOption{WithProtocol(ProtocolUDP), WithSourcePorts(68, 68), WithDestinationPorts(67, 67)},
+			receive:  []FilterOption{WithProtocol(ProtocolUDP), WithSourcePorts(67, 67), WithDestinationPorts(68, 68)},
+		})
+	}
+
+	if settings.AllowDNS {
+		rules = append(rules, rule{
+			name:     "DNS",
+			transmit: []FilterOption{WithProtocol(ProtocolUDP), WithDestinationPorts(53, 53)},
+			receive:  []FilterOption{WithProtocol(ProtocolUDP), WithSourcePorts(53, 53)},
+		})
+	}
+
+	filters := make([]IPHeadersFilterRequest, 0, 2*len(rules))
+
+	for _, rule := range rules {
+		transmit, err := NewIPFilter(policyName+" "+rule.name+" out", FilterDirectionTransmit, FilterProfilePassWithStatistics, rule.transmit...)
+		if err != nil {
+			return nil, err
+		}
+
+		receive, err := NewIPFilter(policyName+" "+rule.name+" in", FilterDirectionReceive, FilterProfilePassWithStatistics, rule.receive...)
+		if err != nil {
+			return nil, err
+		}
+
+		filters = append(filters, transmit, receive)
+	}
+
+	return filters, nil
+}
@@ -0,0 +1,170 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+var (
+	actionPattern      = regexp.MustCompile(`<a:Action>[^<]*/([A-Za-z]+)</a:Action>`)
+	resourceURIPattern = regexp.MustCompile(`<w:ResourceURI>[^<]*/([A-Za-z_]+)</w:ResourceURI>`)
+
+	errUnavailable = errors.New("unavailable")
+)
+
+// systemDefenseClient records Create and Delete calls and answers them like AMT would, failing the call numbered
+// failAt (starting from 1) when it is set.
+type systemDefenseClient struct {
+	client.WSMan
+
+	calls   []string
+	filters int
+	failAt  int
+}
+
+func (c *systemDefenseClient) Post(msg string) ([]byte, error) {
+	call := actionPattern.FindStringSubmatch(msg)[1] + " " + resourceURIPattern.FindStringSubmatch(msg)[1]
+	c.calls = append(c.calls, call)
+
+	if len(c.calls) == c.failAt {
+		return nil, errUnavailable
+	}
+
+	if call != "Create "+AMTIPHeadersFilter {
+		return []byte("<Envelope><Header></Header><Body></Body></Envelope>"), nil
+	}
+
+	c.filters++
+
+	return fmt.Appendf(nil, `<Envelope><Header></Header><Body><ResourceCreated><ReferenceParameters><SelectorSet><Selector Name="Name">Intel(r) AMT:IP Filter %d</Selector></SelectorSet></ReferenceParameters></ResourceCreated></Body></Envelope>`,
+		c.filters), nil
+}
+
+func TestIsolation_Quarantine(t *testing.T) {
+	settings := QuarantineSettings{
+		PolicyPrecedence:  20,
+		ManagementServers: []netip.Prefix{netip.MustParsePrefix("10.0.0.5/32")},
+		AllowDNS:          true,
+	}
+
+	t.Run("quarantines and releases the host", func(t *testing.T) {
+		wsmanClient := &systemDefenseClient{}
+		isolation := NewIsolationWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), wsmanClient)
+
+		quarantine, err := isolation.Quarantine(settings)
+		require.NoError(t, err)
+		assert.Equal(t, Quarantine{
+			PolicyName: DefaultQuarantinePolicyName,
+			Port:       WiredPort,
+			Filters:    []string{"Intel(r) AMT:IP Filter 1", "Intel(r) AMT:IP Filter 2", "Intel(r) AMT:IP Filter 3", "Intel(r) AMT:IP Filter 4"},
+		}, quarantine)
+		assert.Equal(t, []string{
+			"Create " + AMTIPHeadersFilter,
+			"Create " + AMTIPHeadersFilter,
+			"Create " + AMTIPHeadersFilter,
+			"Create " + AMTIPHeadersFilter,
+			"Create " + AMTSystemDefensePolicy,
+			"Create " + AMTNetworkPortSystemDefensePolicy,
+		}, wsmanClient.calls)
+
+		wsmanClient.calls = nil
+
+		require.NoError(t, isolation.Release(quarantine))
+		assert.Equal(t, []string{
+			"Delete " + AMTNetworkPortSystemDefensePolicy,
+			"Delete " + AMTSystemDefensePolicy,
+			"Delete " + AMTIPHeadersFilter,
+			"Delete " + AMTIPHeadersFilter,
+			"Delete " + AMTIPHeadersFilter,
+			"Delete " + AMTIPHeadersFilter,
+		}, wsmanClient.calls)
+	})
+
+	t.Run("policy references the created filters", func(t *testing.T) {
+		var policy string
+
+		wsmanClient := &recordingClient{systemDefenseClient: &systemDefenseClient{}, record: func(msg string) {
+			if strings.Contains(msg, "<h:"+AMTSystemDefensePolicy+" ") {
+				policy = msg
+			}
+		}}
+		isolation := NewIsolationWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), wsmanClient)
+
+		_, err := isolation.Quarantine(settings)
+		require.NoError(t, err)
+		assert.Contains(t, policy, "<h:PolicyPrecedence>20</h:PolicyPrecedence>")
+		assert.Contains(t, policy, "<h:FilterCreationHandles>1</h:FilterCreationHandles><h:FilterCreationHandles>2</h:FilterCreationHandles><h:FilterCreationHandles>3</h:FilterCreationHandles><h:FilterCreationHandles>4</h:FilterCreationHandles>")
+	})
+
+	t.Run("rolls back when a filter cannot be created", func(t *testing.T) {
+		wsmanClient := &systemDefenseClient{failAt: 3}
+		isolation := NewIsolationWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), wsmanClient)
+
+		_, err := isolation.Quarantine(settings)
+		assert.ErrorIs(t, err, ErrQuarantineFailed)
+		assert.ErrorIs(t, err, errUnavailable)
+		assert.Equal(t, []string{"Delete " + AMTIPHeadersFilter, "Delete " + AMTIPHeadersFilter}, wsmanClient.calls[3:])
+	})
+
+	t.Run("rolls back when the policy cannot be activated", func(t *testing.T) {
+		wsmanClient := &systemDefenseClient{failAt: 6}
+		isolation := NewIsolationWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), wsmanClient)
+
+		_, err := isolation.Quarantine(settings)
+		assert.ErrorIs(t, err, ErrQuarantineFailed)
+		assert.Equal(t, []string{
+			"Delete " + AMTSystemDefensePolicy,
+			"Delete " + AMTIPHeadersFilter,
+			"Delete " + AMTIPHeadersFilter,
+			"Delete " + AMTIPHeadersFilter,
+			"Delete " + AMTIPHeadersFilter,
+		}, wsmanClient.calls[6:])
+	})
+
+	t.Run("rejects invalid settings", func(t *testing.T) {
+		wsmanClient := &systemDefenseClient{}
+		isolation := NewIsolationWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), wsmanClient)
+
+		_, err := isolation.Quarantine(QuarantineSettings{ManagementServers: []netip.Prefix{{}}})
+		assert.ErrorIs(t, err, ErrInvalidFilter)
+		assert.Empty(t, wsmanClient.calls)
+	})
+}
+
+func TestIsolation_Release(t *testing.T) {
+	wsmanClient := &systemDefenseClient{failAt: 1}
+	isolation := NewIsolationWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), wsmanClient)
+
+	err := isolation.Release(Quarantine{PolicyName: "Quarantine", Port: WiredPort, Filters: []string{"Intel(r) AMT:IP Filter 1"}})
+	assert.ErrorIs(t, err, errUnavailable)
+	assert.Len(t, wsmanClient.calls, 3)
+}
+
+// recordingClient passes every message to record before answering it.
+type recordingClient struct {
+	*systemDefenseClient
+
+	record func(msg string)
+}
+
+func (c *recordingClient) Post(msg string) ([]byte, error) {
+	c.record(msg)
+
+	return c.systemDefenseClient.Post(msg)
+}
@@ -0,0 +1,234 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import (
+	"encoding/xml"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/base"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+)
+
+type Policy struct {
+	base.WSManService[Response]
+}
+
+type IPHeadersFilter struct {
+	base.WSManService[Response]
+}
+
+type NetworkPortSystemDefensePolicy struct {
+	base.WSManService[Response]
+}
+
+type NetworkPortDefensePolicy struct {
+	base.WSManService[Response]
+}
+
+type ActiveFilterStatistics struct {
+	base.WSManService[Response]
+}
+
+type Capabilities struct {
+	base.WSManService[Response]
+}
+
+// OUTPUTS
+// Response Types.
+type (
+	Response struct {
+		*client.Message
+		XMLName xml.Name       `xml:"Envelope"`
+		Header  message.Header `xml:"Header"`
+		Body    Body           `xml:"Body"`
+	}
+	Body struct {
+		XMLName                                   xml.Name `xml:"Body"`
+		PolicyGetResponse                         PolicyResponse
+		IPHeadersFilterGetResponse                IPHeadersFilterResponse
+		NetworkPortSystemDefensePolicyGetResponse NetworkPortPolicyResponse `xml:"AMT_NetworkPortSystemDefensePolicy"`
+		NetworkPortDefensePolicyGetResponse       NetworkPortPolicyResponse `xml:"AMT_NetworkPortDefensePolicy"`
+		ActiveFilterStatisticsGetResponse         ActiveFilterStatisticsResponse
+		CapabilitiesGetResponse                   CapabilitiesResponse
+		CreateResponse                            ResourceCreated
+		EnumerateResponse                         common.EnumerateResponse
+		PullResponse                              PullResponse
+	}
+	PullResponse struct {
+		XMLName                             xml.Name                         `xml:"PullResponse"`
+		PolicyItems                         []PolicyResponse                 `xml:"Items>AMT_SystemDefensePolicy"`
+		IPHeadersFilterItems                []IPHeadersFilterResponse        `xml:"Items>AMT_IPHeadersFilter"`
+		NetworkPortSystemDefensePolicyItems []NetworkPortPolicyResponse      `xml:"Items>AMT_NetworkPortSystemDefensePolicy"`
+		NetworkPortDefensePolicyItems       []NetworkPortPolicyResponse      `xml:"Items>AMT_NetworkPortDefensePolicy"`
+		ActiveFilterStatisticsItems         []ActiveFilterStatisticsResponse `xml:"Items>AMT_ActiveFilterStatistics"`
+		CapabilitiesItems                   []CapabilitiesResponse           `xml:"Items>AMT_GeneralSystemDefenseCapabilities"`
+	}
+	PolicyResponse struct {
+		XMLName                 xml.Name     `xml:"AMT_SystemDefensePolicy"`
+		CreationClassName       string       `xml:"CreationClassName,omitempty"`       // CreationClassName indicates the name of the class or the subclass used in the creation of an instance.
+		ElementName             string       `xml:"ElementName,omitempty"`             // A user-friendly name for the object.
+		PolicyRuleName          string       `xml:"PolicyRuleName,omitempty"`          // The name of the policy, which identifies it together with the other key properties.
+		SystemCreationClassName string       `xml:"SystemCreationClassName,omitempty"` // The scoping System's CreationClassName.
+		SystemName              string       `xml:"SystemName,omitempty"`              // The scoping System's Name.
+		PolicyPrecedence        int          `xml:"PolicyPrecedence"`                  // When several policies are active on a port, the one with the highest precedence is applied.
+		AntiSpoofingSupport     AntiSpoofing `xml:"AntiSpoofingSupport"`               // How packets with a spoofed source address are handled.
+		FilterCreationHandles   []int        `xml:"FilterCreationHandles"`             // The handles of the filters of the policy.
+		TxDefaultDrop           bool         `xml:"TxDefaultDrop"`                     // Whether outgoing packets that match no filter are dropped.
+		TxDefaultMatchEvent     bool         `xml:"TxDefaultMatchEvent"`               // Whether an event is generated for outgoing packets that match no filter.
+		TxDefaultCount          bool         `xml:"TxDefaultCount"`                    // Whether outgoing packets that match no filter are counted.
+		RxDefaultDrop           bool         `xml:"RxDefaultDrop"`                     // Whether incoming packets that match no filter are dropped.
+		RxDefaultMatchEvent     bool         `xml:"RxDefaultMatchEvent"`               // Whether an event is generated for incoming packets that match no filter.
+		RxDefaultCount          bool         `xml:"RxDefaultCount"`                    // Whether incoming packets that match no filter are counted.
+	}
+	IPHeadersFilterResponse struct {
+		XMLName                  xml.Name        `xml:"AMT_IPHeadersFilter"`
+		CreationClassName        string          `xml:"CreationClassName,omitempty"`        // CreationClassName indicates the name of the class or the subclass used in the creation of an instance.
+		ElementName              string          `xml:"ElementName,omitempty"`              // A user-friendly name for the object.
+		Name                     string          `xml:"Name,omitempty"`                     // The name of the filter, which ends with its creation handle.
+		SystemCreationClassName  string          `xml:"SystemCreationClassName,omitempty"`  // The scoping System's CreationClassName.
+		SystemName               string          `xml:"SystemName,omitempty"`               // The scoping System's Name.
+		ActionEventOnMatch       bool            `xml:"ActionEventOnMatch"`                 // Whether an event is generated when a packet matches the filter.
+		FilterDirection          FilterDirection `xml:"FilterDirection"`                    // Whether the filter applies to outgoing or incoming packets.
+		FilterProfile            FilterProfile   `xml:"FilterProfile"`                      // What happens to matching packets.
+		FilterProfileData        int             `xml:"FilterProfileData"`                  // The rate limit in packets per second when FilterProfile is RateLimit.
+		HdrIPVersion             IPVersion       `xml:"HdrIPVersion"`                       // The IP version of the addresses and masks.
+		HdrSrcAddress            string          `xml:"HdrSrcAddress,omitempty"`            // Base64 encoded source address.
+		HdrSrcMask               string          `xml:"HdrSrcMask,omitempty"`               // Base64 encoded source address mask.
+		HdrSrcAddressEndOfRange  string          `xml:"HdrSrcAddressEndOfRange,omitempty"`  // Base64 encoded end of a source address range.
+		HdrDestAddress           string          `xml:"HdrDestAddress,omitempty"`           // Base64 encoded destination address.
+		HdrDestMask              string          `xml:"HdrDestMask,omitempty"`              // Base64 encoded destination address mask.
+		HdrDestAddressEndOfRange string          `xml:"HdrDestAddressEndOfRange,omitempty"` // Base64 encoded end of a destination address range.
+		HdrProtocolID            Protocol        `xml:"HdrProtocolID"`                      // The IP protocol number.
+		HdrSrcPortStart          int             `xml:"HdrSrcPortStart"`                    // The first source port of the range.
+		HdrSrcPortEnd            int             `xml:"HdrSrcPortEnd"`                      // The last source port of the range.
+		HdrDestPortStart         int             `xml:"HdrDestPortStart"`                   // The first destination port of the range.
+		HdrDestPortEnd           int             `xml:"HdrDestPortEnd"`                     // The last destination port of the range.
+	}
+	// NetworkPortPolicyResponse is an association between a System Defense policy and a network port.
+	NetworkPortPolicyResponse struct {
+		PolicySet      EndpointReferenceResponse `xml:"PolicySet"`      // The AMT_SystemDefensePolicy.
+		ManagedElement EndpointReferenceResponse `xml:"ManagedElement"` // The CIM_EthernetPort.
+	}
+	ActiveFilterStatisticsResponse struct {
+		XMLName        xml.Name                  `xml:"AMT_ActiveFilterStatistics"`
+		ElementName    string                    `xml:"ElementName,omitempty"`    // A user-friendly name for the object.
+		InstanceID     string                    `xml:"InstanceID,omitempty"`     // Uniquely identifies the instance.
+		Filter         EndpointReferenceResponse `xml:"Filter"`                   // The filter the statistics are collected for.
+		ActivationTime string                    `xml:"ActivationTime,omitempty"` // When the filter was activated.
+		LastResetTime  string                    `xml:"LastResetTime,omitempty"`  // When the counter was last reset.
+		ReadCount      int                       `xml:"ReadCount"`                // The number of packets that matched the filter.
+		FilterMatched  bool                      `xml:"FilterMatched"`            // Whether any packet matched the filter.
+	}
+	CapabilitiesResponse struct {
+		XMLName                        xml.Name `xml:"AMT_GeneralSystemDefenseCapabilities"`
+		ElementName                    string   `xml:"ElementName,omitempty"`          // A user-friendly name for the object.
+		InstanceID                     string   `xml:"InstanceID,omitempty"`           // Uniquely identifies the instance.
+		GlobalMaxSupportedFilters      int      `xml:"GlobalMaxSupportedFilters"`      // The maximum number of filters.
+		GlobalMaxSupportedPolicies     int      `xml:"GlobalMaxSupportedPolicies"`     // The maximum number of policies.
+		GlobalMaxActivePolicies        int      `xml:"GlobalMaxActivePolicies"`        // The maximum number of policies active at the same time.
+		GlobalMaxSupportedCounters     int      `xml:"GlobalMaxSupportedCounters"`     // The maximum number of filter statistics counters.
+		GlobalMaxSupportedRateLimiters int      `xml:"GlobalMaxSupportedRateLimiters"` // The maximum number of rate limiting filters.
+	}
+	// ResourceCreated is the reference to an instance returned by Create.
+	ResourceCreated struct {
+		XMLName             xml.Name                    `xml:"ResourceCreated"`
+		Address             string                      `xml:"Address,omitempty"`
+		ReferenceParameters ReferenceParametersResponse `xml:"ReferenceParameters,omitempty"`
+	}
+	EndpointReferenceResponse struct {
+		Address             string                      `xml:"Address,omitempty"`
+		ReferenceParameters ReferenceParametersResponse `xml:"ReferenceParameters,omitempty"`
+	}
+	ReferenceParametersResponse struct {
+		XMLName     xml.Name            `xml:"ReferenceParameters"`
+		ResourceURI string              `xml:"ResourceURI,omitempty"`
+		SelectorSet SelectorSetResponse `xml:"SelectorSet,omitempty"`
+	}
+	SelectorSetResponse struct {
+		XMLName   xml.Name           `xml:"SelectorSet"`
+		Selectors []SelectorResponse `xml:"Selector"`
+	}
+	SelectorResponse struct {
+		XMLName xml.Name `xml:"Selector"`
+		Name    string   `xml:"Name,attr"`
+		Text    string   `xml:",chardata"`
+	}
+)
+
+// INPUTS
+// Request Types.
+type (
+	PolicyRequest struct {
+		XMLName               xml.Name     `xml:"h:AMT_SystemDefensePolicy"`
+		H                     string       `xml:"xmlns:h,attr"`
+		PolicyRuleName        string       `xml:"h:PolicyRuleName"`        // The name of the policy.
+		PolicyPrecedence      int          `xml:"h:PolicyPrecedence"`      // When several policies are active on a port, the one with the highest precedence is applied.
+		AntiSpoofingSupport   AntiSpoofing `xml:"h:AntiSpoofingSupport"`   // How packets with a spoofed source address are handled.
+		FilterCreationHandles []int        `xml:"h:FilterCreationHandles"` // The handles of the filters of the policy, see Handle.
+		TxDefaultDrop         bool         `xml:"h:TxDefaultDrop"`         // Whether outgoing packets that match no filter are dropped.
+		TxDefaultMatchEvent   bool         `xml:"h:TxDefaultMatchEvent"`   // Whether an event is generated for outgoing packets that match no filter.
+		TxDefaultCount        bool         `xml:"h:TxDefaultCount"`        // Whether outgoing packets that match no filter are counted.
+		RxDefaultDrop         bool         `xml:"h:RxDefaultDrop"`         // Whether incoming packets that match no filter are dropped.
+		RxDefaultMatchEvent   bool         `xml:"h:RxDefaultMatchEvent"`   // Whether an event is generated for incoming packets that match no filter.
+		RxDefaultCount        bool         `xml:"h:RxDefaultCount"`        // Whether incoming packets that match no filter are counted.
+	}
+	// IPHeadersFilterRequest is usually built with NewIPFilter.
+	IPHeadersFilterRequest struct {
+		XMLName            xml.Name        `xml:"h:AMT_IPHeadersFilter"`
+		H                  string          `xml:"xmlns:h,attr"`
+		ElementName        string          `xml:"h:ElementName,omitempty"`
+		ActionEventOnMatch bool            `xml:"h:ActionEventOnMatch"`
+		FilterDirection    FilterDirection `xml:"h:FilterDirection"`
+		FilterProfile      FilterProfile   `xml:"h:FilterProfile"`
+		FilterProfileData  int             `xml:"h:FilterProfileData,omitempty"`
+		HdrIPVersion       IPVersion       `xml:"h:HdrIPVersion"`
+		HdrSrcAddress      string          `xml:"h:HdrSrcAddress,omitempty"`
+		HdrSrcMask         string          `xml:"h:HdrSrcMask,omitempty"`
+		HdrDestAddress     string          `xml:"h:HdrDestAddress,omitempty"`
+		HdrDestMask        string          `xml:"h:HdrDestMask,omitempty"`
+		HdrProtocolID      Protocol        `xml:"h:HdrProtocolID,omitempty"`
+		HdrSrcPortStart    int             `xml:"h:HdrSrcPortStart,omitempty"`
+		HdrSrcPortEnd      int             `xml:"h:HdrSrcPortEnd,omitempty"`
+		HdrDestPortStart   int             `xml:"h:HdrDestPortStart,omitempty"`
+		HdrDestPortEnd     int             `xml:"h:HdrDestPortEnd,omitempty"`
+	}
+	NetworkPortSystemDefensePolicyRequest struct {
+		XMLName        xml.Name                 `xml:"h:AMT_NetworkPortSystemDefensePolicy"`
+		H              string                   `xml:"xmlns:h,attr"`
+		PolicySet      EndpointReferenceRequest `xml:"h:PolicySet"`
+		ManagedElement EndpointReferenceRequest `xml:"h:ManagedElement"`
+	}
+	EndpointReferenceRequest struct {
+		Address             string                     `xml:"a:Address"`
+		ReferenceParameters ReferenceParametersRequest `xml:"a:ReferenceParameters"`
+	}
+	ReferenceParametersRequest struct {
+		ResourceURI string             `xml:"w:ResourceURI"`
+		SelectorSet SelectorSetRequest `xml:"w:SelectorSet"`
+	}
+	SelectorSetRequest struct {
+		Selectors []SelectorRequest `xml:"w:Selector"`
+	}
+	SelectorRequest struct {
+		Name string `xml:"Name,attr"`
+		Text string `xml:",chardata"`
+	}
+)
+
+type (
+	// FilterDirection is whether a filter applies to outgoing or incoming packets.
+	FilterDirection int
+	// FilterProfile is what happens to the packets that match a filter.
+	FilterProfile int
+	// IPVersion is the IP version of a filter.
+	IPVersion int
+	// Protocol is an IP protocol number.
+	Protocol int
+	// AntiSpoofing is how a policy handles packets with a spoofed source address.
+	AntiSpoofing int
+)
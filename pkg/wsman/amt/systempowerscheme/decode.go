@@ -0,0 +1,133 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systempowerscheme
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"strings"
+)
+
+var ErrNoMatchingScheme = errors.New("no power scheme keeps the ME powered in the requested states")
+
+// Scheme is a power scheme with its description decoded. Descriptions follow the firmware format
+// "<Platform>: ON in <states>, ME Wake in <states>", e.g. "Mobile: ON in S0, ME Wake in S3, S4-5 (AC only)".
+type Scheme struct {
+	InstanceID  string
+	SchemeGUID  string
+	Description string
+	Platform    Platform
+	On          SleepStates // The states in which the ME is fully powered.
+	Wake        SleepStates // The states in which the ME is in ME Wake, powered down until network traffic wakes it.
+	ACOnly      bool        // The ME only stays powered in the sleep states while the system is on AC power.
+}
+
+// Powered returns the states in which the ME can be reached, either fully powered or in ME Wake.
+func (scheme Scheme) Powered() SleepStates {
+	return scheme.On | scheme.Wake
+}
+
+// ParseScheme decodes the description of a power scheme. Parts of the description that aren't recognised are ignored.
+func ParseScheme(scheme SystemPowerScheme) Scheme {
+	decoded := Scheme{
+		InstanceID:  scheme.InstanceID,
+		SchemeGUID:  scheme.SchemeGUID,
+		Description: scheme.Description,
+	}
+
+	description := scheme.Description
+
+	if platform, rest, found := strings.Cut(description, ":"); found {
+		switch strings.TrimSpace(platform) {
+		case "Desktop":
+			decoded.Platform = PlatformDesktop
+		case "Mobile":
+			decoded.Platform = PlatformMobile
+		}
+
+		description = rest
+	}
+
+	if strings.Contains(description, "(AC only)") {
+		decoded.ACOnly = true
+		description = strings.ReplaceAll(description, "(AC only)", "")
+	}
+
+	states := &decoded.On
+
+	for _, part := range strings.Split(description, ",") {
+		part = strings.TrimSpace(part)
+
+		switch {
+		case strings.HasPrefix(part, "ON in "):
+			states, part = &decoded.On, strings.TrimPrefix(part, "ON in ")
+		case strings.HasPrefix(part, "ME Wake in "):
+			states, part = &decoded.Wake, strings.TrimPrefix(part, "ME Wake in ")
+		}
+
+		part = strings.TrimSpace(strings.TrimSuffix(part, " only"))
+
+		if state, found := strings.CutSuffix(part, "/AC"); found {
+			decoded.ACOnly = true
+			part = state
+		}
+
+		*states |= parseSleepState(part)
+	}
+
+	return decoded
+}
+
+func parseSleepState(state string) SleepStates {
+	switch state {
+	case "S0":
+		return SleepStateS0
+	case "S3":
+		return SleepStateS3
+	case "S4-5", "S4/5", "S4/S5", "S5":
+		return SleepStateS4S5
+	}
+
+	return SleepStatesNone
+}
+
+// SelectScheme returns the scheme that keeps the ME reachable in all of states while powering it in the fewest other
+// states. Among equal schemes, one that also applies on battery power is preferred, then one that uses ME Wake rather
+// than keeping the ME fully on, then the first in the list.
+func SelectScheme(schemes []Scheme, states SleepStates) (Scheme, error) {
+	var selected *Scheme
+
+	for i := range schemes {
+		scheme := &schemes[i]
+
+		if !scheme.Powered().Contains(states) {
+			continue
+		}
+
+		if selected == nil || betterScheme(scheme, selected) {
+			selected = scheme
+		}
+	}
+
+	if selected == nil {
+		return Scheme{}, fmt.Errorf("%w: %s", ErrNoMatchingScheme, states)
+	}
+
+	return *selected, nil
+}
+
+func betterScheme(scheme, than *Scheme) bool {
+	if powered, thanPowered := bits.OnesCount(uint(scheme.Powered())), bits.OnesCount(uint(than.Powered())); powered != thanPowered {
+		return powered < thanPowered
+	}
+
+	if scheme.ACOnly != than.ACOnly {
+		return !scheme.ACOnly
+	}
+
+	return bits.OnesCount(uint(scheme.On)) < bits.OnesCount(uint(than.On))
+}
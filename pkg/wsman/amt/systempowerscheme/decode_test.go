@@ -0,0 +1,76 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systempowerscheme
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseScheme(t *testing.T) {
+	tests := []struct {
+		description string
+		expected    Scheme
+	}{
+		{"Mobile: ON in S0", Scheme{Platform: PlatformMobile, On: SleepStateS0}},
+		{"Desktop: ON in S0, S3, S4-5", Scheme{Platform: PlatformDesktop, On: SleepStateS0 | SleepStateS3 | SleepStateS4S5}},
+		{"Desktop: ON in S0, ME Wake in S3", Scheme{Platform: PlatformDesktop, On: SleepStateS0, Wake: SleepStateS3}},
+		{"Mobile: ON in S0, ME Wake in S3, S4-5 (AC only)", Scheme{Platform: PlatformMobile, On: SleepStateS0, Wake: SleepStateS3 | SleepStateS4S5, ACOnly: true}},
+		{"Mobile: ON in S0, S3/AC", Scheme{Platform: PlatformMobile, On: SleepStateS0 | SleepStateS3, ACOnly: true}},
+		{"ON in S0 only", Scheme{On: SleepStateS0}},
+		{"Custom", Scheme{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			test.expected.InstanceID = "SCHEME 0"
+			test.expected.Description = test.description
+			assert.Equal(t, test.expected, ParseScheme(SystemPowerScheme{InstanceID: "SCHEME 0", Description: test.description}))
+		})
+	}
+}
+
+func TestSelectScheme(t *testing.T) {
+	schemes := []Scheme{
+		{InstanceID: "on", On: SleepStateS0 | SleepStateS3 | SleepStateS4S5},
+		{InstanceID: "s0", On: SleepStateS0},
+		{InstanceID: "wake ac", On: SleepStateS0, Wake: SleepStateS3, ACOnly: true},
+		{InstanceID: "wake", On: SleepStateS0, Wake: SleepStateS3},
+		{InstanceID: "on s3", On: SleepStateS0 | SleepStateS3},
+	}
+
+	tests := []struct {
+		states   SleepStates
+		expected string
+	}{
+		{SleepStatesNone, "s0"},
+		{SleepStateS0, "s0"},
+		{SleepStateS3, "wake"},
+		{SleepStateS4S5, "on"},
+		{SleepStateS0 | SleepStateS3 | SleepStateS4S5, "on"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.states.String(), func(t *testing.T) {
+			scheme, err := SelectScheme(schemes, test.states)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, scheme.InstanceID)
+		})
+	}
+
+	_, err := SelectScheme(schemes[1:], SleepStateS4S5)
+	assert.ErrorIs(t, err, ErrNoMatchingScheme)
+}
+
+func TestSleepStatesString(t *testing.T) {
+	assert.Equal(t, "None", SleepStatesNone.String())
+	assert.Equal(t, "S0, S4-5", (SleepStateS0 | SleepStateS4S5).String())
+	assert.Equal(t, "Mobile", PlatformMobile.String())
+	assert.Equal(t, "NotPermitted", ReturnValueNotPermitted.String())
+	assert.Equal(t, ValueNotFound, ReturnValue(2).String())
+}
@@ -5,7 +5,93 @@
 
 package systempowerscheme
 
-// INPUTS Constants.
+import "strings"
+
 const (
 	AMTSystemPowerScheme string = "AMT_SystemPowerScheme"
+	SetPowerScheme       string = "SetPowerScheme"
+	ValueNotFound        string = "Value not found in map"
+)
+
+const (
+	ReturnValueSuccess          ReturnValue = 0
+	ReturnValueInternalError    ReturnValue = 1
+	ReturnValueNotPermitted     ReturnValue = 16
+	ReturnValueInvalidParameter ReturnValue = 36
+)
+
+// returnValueToString is a map of ReturnValue values to their string representation.
+var returnValueToString = map[ReturnValue]string{
+	ReturnValueSuccess:          "Success",
+	ReturnValueInternalError:    "InternalError",
+	ReturnValueNotPermitted:     "NotPermitted",
+	ReturnValueInvalidParameter: "InvalidParameter",
+}
+
+// String returns the string representation of the ReturnValue value.
+func (r ReturnValue) String() string {
+	if value, exists := returnValueToString[r]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+const (
+	PlatformUnknown Platform = iota
+	PlatformDesktop
+	PlatformMobile
 )
+
+// platformToString is a map of Platform values to their string representation.
+var platformToString = map[Platform]string{
+	PlatformUnknown: "Unknown",
+	PlatformDesktop: "Desktop",
+	PlatformMobile:  "Mobile",
+}
+
+// String returns the string representation of the Platform value.
+func (p Platform) String() string {
+	if value, exists := platformToString[p]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+const (
+	SleepStateS0   SleepStates = 1 << iota // Working
+	SleepStateS3                           // Suspend to RAM
+	SleepStateS4S5                         // Hibernate and soft off
+
+	SleepStatesNone SleepStates = 0
+)
+
+// sleepStateToString is a map of the single SleepStates values to their string representation.
+var sleepStateToString = map[SleepStates]string{
+	SleepStateS0:   "S0",
+	SleepStateS3:   "S3",
+	SleepStateS4S5: "S4-5",
+}
+
+// String returns the states as a comma separated list, e.g. "S0, S3".
+func (s SleepStates) String() string {
+	names := []string{}
+
+	for _, state := range []SleepStates{SleepStateS0, SleepStateS3, SleepStateS4S5} {
+		if s&state != 0 {
+			names = append(names, sleepStateToString[state])
+		}
+	}
+
+	if len(names) == 0 {
+		return "None"
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// Contains reports whether s includes every state of states.
+func (s SleepStates) Contains(states SleepStates) bool {
+	return s&states == states
+}
@@ -7,7 +7,12 @@
 package systempowerscheme
 
 import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+
 	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/methods"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/base"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
 )
@@ -22,3 +27,68 @@ func NewServiceWithClient(wsmanMessageCreator *message.WSManMessageCreator, clie
 		base.NewService[Response](wsmanMessageCreator, AMTSystemPowerScheme, client),
 	}
 }
+
+// SetPowerScheme makes the scheme identified by instanceID the active ME power policy. The method has no parameters;
+// the scheme is the instance addressed by the InstanceID selector of its endpoint reference.
+func (service Service) SetPowerScheme(instanceID string, opts ...base.HeaderOption) (response Response, err error) {
+	selector := message.Selector{
+		Name:  "InstanceID",
+		Value: instanceID,
+	}
+	header := service.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTSystemPowerScheme, SetPowerScheme), AMTSystemPowerScheme, []message.Selector{selector}, "", "", opts...)
+	body := service.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(SetPowerScheme), AMTSystemPowerScheme, &SetPowerScheme_INPUT{
+		H: fmt.Sprintf("%s%s", message.AMTSchema, AMTSystemPowerScheme),
+	})
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.Base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	err = service.Base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	if response.Body.SetPowerScheme_OUTPUT.ReturnValue != ReturnValueSuccess {
+		err = errors.New("SetPowerScheme failed with return code " + response.Body.SetPowerScheme_OUTPUT.ReturnValue.String())
+	}
+
+	return response, err
+}
+
+// Schemes enumerates the power schemes supported by the platform and decodes their descriptions.
+func (service Service) Schemes(opts ...base.HeaderOption) ([]Scheme, error) {
+	schemes := []Scheme{}
+
+	err := base.EachItem(service.WSManService, AMTSystemPowerScheme, func(scheme SystemPowerScheme) error {
+		schemes = append(schemes, ParseScheme(scheme))
+
+		return nil
+	}, opts...)
+
+	return schemes, err
+}
+
+// SelectPowerScheme activates the scheme chosen by SelectScheme for states and returns it.
+func (service Service) SelectPowerScheme(states SleepStates, opts ...base.HeaderOption) (Scheme, error) {
+	schemes, err := service.Schemes(opts...)
+	if err != nil {
+		return Scheme{}, err
+	}
+
+	scheme, err := SelectScheme(schemes, states)
+	if err != nil {
+		return Scheme{}, err
+	}
+
+	_, err = service.SetPowerScheme(scheme.InstanceID, opts...)
+
+	return scheme, err
+}
@@ -6,9 +6,11 @@
 package systempowerscheme
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
@@ -55,6 +57,15 @@ func TestPositiveAMT_SystemPowerScheme(t *testing.T) {
 					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
 				},
 			},
+			{
+				"should create a valid AMT_SystemPowerScheme SetPowerScheme call",
+				`<w:SelectorSet><w:Selector Name="InstanceID">SCHEME 1</w:Selector></w:SelectorSet>`,
+				func() (Response, error) {
+					client.CurrentMessage = SetPowerScheme
+
+					return elementUnderTest.SetPowerScheme("SCHEME 1")
+				},
+			},
 		}
 
 		for _, test := range tests {
@@ -106,6 +117,14 @@ func TestNegativeAMT_SystemPowerScheme(t *testing.T) {
 					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
 				},
 			},
+			{
+				"should handle error when SetPowerScheme fails",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.SetPowerScheme("SCHEME 1")
+				},
+			},
 		}
 
 		for _, test := range tests {
@@ -116,3 +135,48 @@ func TestNegativeAMT_SystemPowerScheme(t *testing.T) {
 		}
 	})
 }
+
+// schemeClient answers each request with the fixture of its action.
+type schemeClient struct {
+	wsmantesting.MockClient
+	requests []string
+}
+
+func (c *schemeClient) Post(msg string) ([]byte, error) {
+	c.requests = append(c.requests, msg)
+
+	switch {
+	case strings.Contains(msg, message.BaseActionsEnumerate):
+		c.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+	case strings.Contains(msg, message.BaseActionsPull):
+		c.CurrentMessage = wsmantesting.CurrentMessagePull
+	default:
+		c.CurrentMessage = SetPowerScheme
+	}
+
+	return c.MockClient.Post(msg)
+}
+
+func TestSelectPowerScheme(t *testing.T) {
+	client := &schemeClient{MockClient: wsmantesting.MockClient{PackageUnderTest: "amt/systempowerscheme"}}
+	elementUnderTest := NewServiceWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), client)
+
+	schemes, err := elementUnderTest.Schemes()
+	require.NoError(t, err)
+	require.Len(t, schemes, 2)
+	assert.Equal(t, SleepStateS0, schemes[0].Powered())
+	assert.Equal(t, SleepStateS0|SleepStateS3|SleepStateS4S5, schemes[1].Powered())
+
+	scheme, err := elementUnderTest.SelectPowerScheme(SleepStateS3)
+	require.NoError(t, err)
+	assert.Equal(t, "SCHEME 1", scheme.InstanceID)
+	assert.Contains(t, client.requests[len(client.requests)-1], `<w:SelectorSet><w:Selector Name="InstanceID">SCHEME 1</w:Selector></w:SelectorSet>`)
+	assert.Contains(t, client.requests[len(client.requests)-1], message.AMTSchema+AMTSystemPowerScheme+"/"+SetPowerScheme)
+
+	requests := len(client.requests)
+	_, err = elementUnderTest.SelectPowerScheme(SleepStateS0 | SleepStateS3 | SleepStateS4S5)
+	require.NoError(t, err)
+
+	// an enumeration, a pull and the SetPowerScheme call
+	assert.Len(t, client.requests, requests+3)
+}
@@ -27,6 +27,8 @@ type (
 		GetResponse       SystemPowerScheme
 		EnumerateResponse common.EnumerateResponse
 		PullResponse      PullResponse
+
+		SetPowerScheme_OUTPUT SetPowerScheme_OUTPUT
 	}
 	PullResponse struct {
 		XMLName                xml.Name            `xml:"PullResponse"`
@@ -47,4 +49,27 @@ type (
 		PolicyOwner             int      `xml:"PolicyOwner,omitempty"`
 		PolicyPrecedence        int      `xml:"PolicyPrecedence,omitempty"`
 	}
+
+	SetPowerScheme_OUTPUT struct {
+		XMLName     xml.Name    `xml:"SetPowerScheme_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+)
+
+// INPUTS
+// Request Types.
+type (
+	SetPowerScheme_INPUT struct {
+		XMLName xml.Name `xml:"h:SetPowerScheme_INPUT"`
+		H       string   `xml:"xmlns:h,attr"`
+	}
+)
+
+type (
+	// ReturnValue is the completion status of SetPowerScheme.
+	ReturnValue int
+	// Platform is the platform type a power scheme is meant for.
+	Platform int
+	// SleepStates is a set of ACPI system power states.
+	SleepStates int
 )
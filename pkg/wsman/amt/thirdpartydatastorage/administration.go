@@ -0,0 +1,97 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package thirdpartydatastorage
+
+import (
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/base"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewAdministrationServiceWithClient instantiates a new Third-Party Data Storage administration service.
+func NewAdministrationServiceWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) AdministrationService {
+	return AdministrationService{
+		base.NewService[Response](wsmanMessageCreator, AMTThirdPartyDataStorageAdministrationService, client),
+	}
+}
+
+// GetGlobalStorageAttributes returns the size of the storage, how much of it is allocated and the limits of the
+// entries.
+func (service AdministrationService) GetGlobalStorageAttributes(opts ...base.HeaderOption) (response Response, err error) {
+	return service.invoke(GetGlobalStorageAttributes, nil, func(body Body) ReturnValue { return body.GetGlobalStorageAttributes_OUTPUT.ReturnValue }, opts)
+}
+
+// SetGlobalStorageAttributes sets how much of the storage is reserved for partner applications and how much the other
+// applications can allocate together.
+func (service AdministrationService) SetGlobalStorageAttributes(maxPartnerStorage, maxNonPartnerTotalAllocationSize int, opts ...base.HeaderOption) (response Response, err error) {
+	input := SetGlobalStorageAttributes_INPUT{
+		MaxPartnerStorage:                maxPartnerStorage,
+		MaxNonPartnerTotalAllocationSize: maxNonPartnerTotalAllocationSize,
+	}
+
+	return service.invoke(SetGlobalStorageAttributes, &input, func(body Body) ReturnValue { return body.SetGlobalStorageAttributes_OUTPUT.ReturnValue }, opts)
+}
+
+// AddStorageEaclEntry allows the applications of an enterprise to use the storage.
+func (service AdministrationService) AddStorageEaclEntry(enterpriseName string, opts ...base.HeaderOption) (response Response, err error) {
+	input := AddStorageEaclEntry_INPUT{EnterpriseName: enterpriseName}
+
+	return service.invoke(AddStorageEaclEntry, &input, func(body Body) ReturnValue { return body.AddStorageEaclEntry_OUTPUT.ReturnValue }, opts)
+}
+
+// EnumerateStorageEaclEntries returns the handles of the enterprise entries from startIndex.
+func (service AdministrationService) EnumerateStorageEaclEntries(startIndex int, opts ...base.HeaderOption) (response Response, err error) {
+	return service.invoke(EnumerateStorageEaclEntries, startIndexRequest(EnumerateStorageEaclEntries, startIndex), func(body Body) ReturnValue { return body.EnumerateStorageEaclEntries_OUTPUT.ReturnValue }, opts)
+}
+
+// GetStorageEaclEntry returns the name of the enterprise of an entry.
+func (service AdministrationService) GetStorageEaclEntry(handle int, opts ...base.HeaderOption) (response Response, err error) {
+	return service.invoke(GetStorageEaclEntry, handleRequest(GetStorageEaclEntry, handle), func(body Body) ReturnValue { return body.GetStorageEaclEntry_OUTPUT.ReturnValue }, opts)
+}
+
+// RemoveStorageEaclEntry removes an enterprise entry.
+func (service AdministrationService) RemoveStorageEaclEntry(handle int, opts ...base.HeaderOption) (response Response, err error) {
+	return service.invoke(RemoveStorageEaclEntry, handleRequest(RemoveStorageEaclEntry, handle), func(body Body) ReturnValue { return body.RemoveStorageEaclEntry_OUTPUT.ReturnValue }, opts)
+}
+
+// AddStorageFpaclEntry reserves storage for an application or for the applications of a vendor.
+func (service AdministrationService) AddStorageFpaclEntry(entry AddStorageFpaclEntry_INPUT, opts ...base.HeaderOption) (response Response, err error) {
+	return service.invoke(AddStorageFpaclEntry, &entry, func(body Body) ReturnValue { return body.AddStorageFpaclEntry_OUTPUT.ReturnValue }, opts)
+}
+
+// EnumerateStorageAllocEntries returns the handles of the allocation entries from startIndex.
+func (service AdministrationService) EnumerateStorageAllocEntries(startIndex int, opts ...base.HeaderOption) (response Response, err error) {
+	return service.invoke(EnumerateStorageAllocEntries, startIndexRequest(EnumerateStorageAllocEntries, startIndex), func(body Body) ReturnValue { return body.EnumerateStorageAllocEntries_OUTPUT.ReturnValue }, opts)
+}
+
+// GetStorageAllocEntry returns an allocation entry.
+func (service AdministrationService) GetStorageAllocEntry(handle int, opts ...base.HeaderOption) (response Response, err error) {
+	return service.invoke(GetStorageAllocEntry, handleRequest(GetStorageAllocEntry, handle), func(body Body) ReturnValue { return body.GetStorageAllocEntry_OUTPUT.ReturnValue }, opts)
+}
+
+// UpdateStorageFpaclEntry changes the storage reserved by a partner allocation entry.
+func (service AdministrationService) UpdateStorageFpaclEntry(handle, newAllocationSize int, opts ...base.HeaderOption) (response Response, err error) {
+	input := UpdateStorageFpaclEntry_INPUT{Handle: handle, NewAllocationSize: newAllocationSize}
+
+	return service.invoke(UpdateStorageFpaclEntry, &input, func(body Body) ReturnValue { return body.UpdateStorageFpaclEntry_OUTPUT.ReturnValue }, opts)
+}
+
+// RemoveStorageFpaclEntry removes a partner allocation entry.
+func (service AdministrationService) RemoveStorageFpaclEntry(handle int, opts ...base.HeaderOption) (response Response, err error) {
+	return service.invoke(RemoveStorageFpaclEntry, handleRequest(RemoveStorageFpaclEntry, handle), func(body Body) ReturnValue { return body.RemoveStorageFpaclEntry_OUTPUT.ReturnValue }, opts)
+}
+
+func (service AdministrationService) invoke(method string, input interface{}, returnValue func(Body) ReturnValue, opts []base.HeaderOption) (Response, error) {
+	return invoke(&service.Base, AMTThirdPartyDataStorageAdministrationService, method, input, returnValue, opts)
+}
+
+func startIndexRequest(method string, startIndex int) *StartIndexRequest {
+	return &StartIndexRequest{XMLName: inputName(method), StartIndex: startIndex}
+}
+
+func handleRequest(method string, handle int) *HandleRequest {
+	return &HandleRequest{XMLName: inputName(method), Handle: handle}
+}
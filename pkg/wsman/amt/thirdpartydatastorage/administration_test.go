@@ -0,0 +1,293 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package thirdpartydatastorage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+const administrationNamespace = `xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageAdministrationService"`
+
+func TestPositiveAMT_ThirdPartyDataStorageAdministrationService(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/thirdpartydatastorage/administration",
+	}
+	elementUnderTest := NewAdministrationServiceWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_ThirdPartyDataStorageAdministrationService Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			action       string
+			body         string
+			responseFunc func() (Response, error)
+			check        func(t *testing.T, body Body)
+		}{
+			{
+				"should create a valid AMT_ThirdPartyDataStorageAdministrationService Get wsman message",
+				wsmantesting.Get,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get()
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, "Intel(r) AMT Third Party Data Storage Administration Service", body.AdministrationServiceGetResponse.Name)
+				},
+			},
+			{
+				"should create a valid AMT_ThirdPartyDataStorageAdministrationService Enumerate wsman message",
+				wsmantesting.Enumerate,
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, "D7100000-0000-0000-0000-000000000000", body.EnumerateResponse.EnumerationContext)
+				},
+			},
+			{
+				"should create a valid AMT_ThirdPartyDataStorageAdministrationService Pull wsman message",
+				wsmantesting.Pull,
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Len(t, body.PullResponse.AdministrationServiceItems, 1)
+				},
+			},
+			{
+				"should create a valid AMT_ThirdPartyDataStorageAdministrationService GetGlobalStorageAttributes wsman message",
+				methodAction(AMTThirdPartyDataStorageAdministrationService, GetGlobalStorageAttributes),
+				`<h:GetGlobalStorageAttributes_INPUT ` + administrationNamespace + `></h:GetGlobalStorageAttributes_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = GetGlobalStorageAttributes
+
+					return elementUnderTest.GetGlobalStorageAttributes()
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+
+					attributes := body.GetGlobalStorageAttributes_OUTPUT
+					assert.Equal(t, 196608, attributes.TotalStorage)
+					assert.Equal(t, 8192, attributes.TotalAllocatedStorage)
+					assert.Equal(t, 131072, attributes.MaxPartnerStorage)
+					assert.Equal(t, 65536, attributes.MaxNonPartnerTotalAllocationSize)
+					assert.Equal(t, 4, attributes.MaxEaclEntries)
+				},
+			},
+			{
+				"should create a valid AMT_ThirdPartyDataStorageAdministrationService SetGlobalStorageAttributes wsman message",
+				methodAction(AMTThirdPartyDataStorageAdministrationService, SetGlobalStorageAttributes),
+				`<h:SetGlobalStorageAttributes_INPUT ` + administrationNamespace + `><h:MaxPartnerStorage>131072</h:MaxPartnerStorage><h:MaxNonPartnerTotalAllocationSize>65536</h:MaxNonPartnerTotalAllocationSize></h:SetGlobalStorageAttributes_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = SetGlobalStorageAttributes
+
+					return elementUnderTest.SetGlobalStorageAttributes(131072, 65536)
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, ReturnValueSuccess, body.SetGlobalStorageAttributes_OUTPUT.ReturnValue)
+				},
+			},
+			{
+				"should create a valid AMT_ThirdPartyDataStorageAdministrationService AddStorageEaclEntry wsman message",
+				methodAction(AMTThirdPartyDataStorageAdministrationService, AddStorageEaclEntry),
+				`<h:AddStorageEaclEntry_INPUT ` + administrationNamespace + `><h:EnterpriseName>Enterprise</h:EnterpriseName></h:AddStorageEaclEntry_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = AddStorageEaclEntry
+
+					return elementUnderTest.AddStorageEaclEntry("Enterprise")
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, 1, body.AddStorageEaclEntry_OUTPUT.Handle)
+				},
+			},
+			{
+				"should create a valid AMT_ThirdPartyDataStorageAdministrationService EnumerateStorageEaclEntries wsman message",
+				methodAction(AMTThirdPartyDataStorageAdministrationService, EnumerateStorageEaclEntries),
+				`<h:EnumerateStorageEaclEntries_INPUT ` + administrationNamespace + `><h:StartIndex>0</h:StartIndex></h:EnumerateStorageEaclEntries_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = EnumerateStorageEaclEntries
+
+					return elementUnderTest.EnumerateStorageEaclEntries(0)
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, 1, body.EnumerateStorageEaclEntries_OUTPUT.TotalCount)
+					assert.Equal(t, []int{1}, body.EnumerateStorageEaclEntries_OUTPUT.Handles)
+				},
+			},
+			{
+				"should create a valid AMT_ThirdPartyDataStorageAdministrationService GetStorageEaclEntry wsman message",
+				methodAction(AMTThirdPartyDataStorageAdministrationService, GetStorageEaclEntry),
+				`<h:GetStorageEaclEntry_INPUT ` + administrationNamespace + `><h:Handle>1</h:Handle></h:GetStorageEaclEntry_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = GetStorageEaclEntry
+
+					return elementUnderTest.GetStorageEaclEntry(1)
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, "Enterprise", body.GetStorageEaclEntry_OUTPUT.EnterpriseName)
+				},
+			},
+			{
+				"should create a valid AMT_ThirdPartyDataStorageAdministrationService RemoveStorageEaclEntry wsman message",
+				methodAction(AMTThirdPartyDataStorageAdministrationService, RemoveStorageEaclEntry),
+				`<h:RemoveStorageEaclEntry_INPUT ` + administrationNamespace + `><h:Handle>1</h:Handle></h:RemoveStorageEaclEntry_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = RemoveStorageEaclEntry
+
+					return elementUnderTest.RemoveStorageEaclEntry(1)
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, ReturnValueSuccess, body.RemoveStorageEaclEntry_OUTPUT.ReturnValue)
+				},
+			},
+			{
+				"should create a valid AMT_ThirdPartyDataStorageAdministrationService AddStorageFpaclEntry wsman message",
+				methodAction(AMTThirdPartyDataStorageAdministrationService, AddStorageFpaclEntry),
+				`<h:AddStorageFpaclEntry_INPUT ` + administrationNamespace + `><h:AttrType>0</h:AttrType><h:ApplicationName>Agent</h:ApplicationName><h:VendorName>Vendor</h:VendorName><h:IsPartner>true</h:IsPartner><h:TotalAllocationSize>8192</h:TotalAllocationSize></h:AddStorageFpaclEntry_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = AddStorageFpaclEntry
+
+					return elementUnderTest.AddStorageFpaclEntry(AddStorageFpaclEntry_INPUT{
+						AttrType:            AllocationEntryTypeApplication,
+						ApplicationName:     "Agent",
+						VendorName:          "Vendor",
+						IsPartner:           true,
+						TotalAllocationSize: 8192,
+					})
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, 2, body.AddStorageFpaclEntry_OUTPUT.Handle)
+				},
+			},
+			{
+				"should create a valid AMT_ThirdPartyDataStorageAdministrationService EnumerateStorageAllocEntries wsman message",
+				methodAction(AMTThirdPartyDataStorageAdministrationService, EnumerateStorageAllocEntries),
+				`<h:EnumerateStorageAllocEntries_INPUT ` + administrationNamespace + `><h:StartIndex>0</h:StartIndex></h:EnumerateStorageAllocEntries_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = EnumerateStorageAllocEntries
+
+					return elementUnderTest.EnumerateStorageAllocEntries(0)
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, []int{2}, body.EnumerateStorageAllocEntries_OUTPUT.Handles)
+				},
+			},
+			{
+				"should create a valid AMT_ThirdPartyDataStorageAdministrationService GetStorageAllocEntry wsman message",
+				methodAction(AMTThirdPartyDataStorageAdministrationService, GetStorageAllocEntry),
+				`<h:GetStorageAllocEntry_INPUT ` + administrationNamespace + `><h:Handle>2</h:Handle></h:GetStorageAllocEntry_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = GetStorageAllocEntry
+
+					return elementUnderTest.GetStorageAllocEntry(2)
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+
+					entry := body.GetStorageAllocEntry_OUTPUT
+					assert.Equal(t, AllocationEntryTypeApplication, entry.AttrType)
+					assert.Equal(t, "Agent", entry.ApplicationName)
+					assert.True(t, entry.IsPartner)
+					assert.Equal(t, 8192, entry.TotalAllocationSize)
+				},
+			},
+			{
+				"should create a valid AMT_ThirdPartyDataStorageAdministrationService UpdateStorageFpaclEntry wsman message",
+				methodAction(AMTThirdPartyDataStorageAdministrationService, UpdateStorageFpaclEntry),
+				`<h:UpdateStorageFpaclEntry_INPUT ` + administrationNamespace + `><h:Handle>2</h:Handle><h:NewAllocationSize>16384</h:NewAllocationSize></h:UpdateStorageFpaclEntry_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = UpdateStorageFpaclEntry
+
+					return elementUnderTest.UpdateStorageFpaclEntry(2, 16384)
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, ReturnValueSuccess, body.UpdateStorageFpaclEntry_OUTPUT.ReturnValue)
+				},
+			},
+			{
+				"should create a valid AMT_ThirdPartyDataStorageAdministrationService RemoveStorageFpaclEntry wsman message",
+				methodAction(AMTThirdPartyDataStorageAdministrationService, RemoveStorageFpaclEntry),
+				`<h:RemoveStorageFpaclEntry_INPUT ` + administrationNamespace + `><h:Handle>2</h:Handle></h:RemoveStorageFpaclEntry_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = RemoveStorageFpaclEntry
+
+					return elementUnderTest.RemoveStorageFpaclEntry(2)
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, ReturnValueSuccess, body.RemoveStorageFpaclEntry_OUTPUT.ReturnValue)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, AMTThirdPartyDataStorageAdministrationService, test.action, "", test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				test.check(t, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeAMT_ThirdPartyDataStorageAdministrationService(t *testing.T) {
+	wsmanMessageCreator := message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/thirdpartydatastorage/administration",
+		CurrentMessage:   wsmantesting.CurrentMessageError,
+	}
+	elementUnderTest := NewAdministrationServiceWithClient(wsmanMessageCreator, &client)
+
+	calls := map[string]func() (Response, error){
+		GetGlobalStorageAttributes:   func() (Response, error) { return elementUnderTest.GetGlobalStorageAttributes() },
+		SetGlobalStorageAttributes:   func() (Response, error) { return elementUnderTest.SetGlobalStorageAttributes(0, 0) },
+		AddStorageEaclEntry:          func() (Response, error) { return elementUnderTest.AddStorageEaclEntry("Enterprise") },
+		EnumerateStorageEaclEntries:  func() (Response, error) { return elementUnderTest.EnumerateStorageEaclEntries(0) },
+		GetStorageEaclEntry:          func() (Response, error) { return elementUnderTest.GetStorageEaclEntry(1) },
+		RemoveStorageEaclEntry:       func() (Response, error) { return elementUnderTest.RemoveStorageEaclEntry(1) },
+		AddStorageFpaclEntry:         func() (Response, error) { return elementUnderTest.AddStorageFpaclEntry(AddStorageFpaclEntry_INPUT{}) },
+		EnumerateStorageAllocEntries: func() (Response, error) { return elementUnderTest.EnumerateStorageAllocEntries(0) },
+		GetStorageAllocEntry:         func() (Response, error) { return elementUnderTest.GetStorageAllocEntry(2) },
+		UpdateStorageFpaclEntry:      func() (Response, error) { return elementUnderTest.UpdateStorageFpaclEntry(2, 0) },
+		RemoveStorageFpaclEntry:      func() (Response, error) { return elementUnderTest.RemoveStorageFpaclEntry(2) },
+	}
+
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			_, err := call()
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,118 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package thirdpartydatastorage
+
+// INPUTS Constants.
+const (
+	AMTThirdPartyDataStorageService               string = "AMT_ThirdPartyDataStorageService"
+	AMTThirdPartyDataStorageAdministrationService string = "AMT_ThirdPartyDataStorageAdministrationService"
+	ValueNotFound                                 string = "Value not found in map"
+)
+
+// AMT_ThirdPartyDataStorageService methods.
+const (
+	RegisterApplication         string = "RegisterApplication"
+	UnregisterApplication       string = "UnregisterApplication"
+	GetMTU                      string = "GetMTU"
+	GetFreeBytes                string = "GetFreeBytes"
+	GetRegisteredApplications   string = "GetRegisteredApplications"
+	GetApplicationAttributes    string = "GetApplicationAttributes"
+	GetCurrentApplicationHandle string = "GetCurrentApplicationHandle"
+	GetAllocatedBlocks          string = "GetAllocatedBlocks"
+	AllocateBlock               string = "AllocateBlock"
+	DeallocateBlock             string = "DeallocateBlock"
+	GetBlockAttributes          string = "GetBlockAttributes"
+	SetBlockAttributes          string = "SetBlockAttributes"
+	ReadBlock                   string = "ReadBlock"
+	WriteBlock                  string = "WriteBlock"
+)
+
+// AMT_ThirdPartyDataStorageAdministrationService methods.
+const (
+	GetGlobalStorageAttributes   string = "GetGlobalStorageAttributes"
+	SetGlobalStorageAttributes   string = "SetGlobalStorageAttributes"
+	AddStorageEaclEntry          string = "AddStorageEaclEntry"
+	EnumerateStorageEaclEntries  string = "EnumerateStorageEaclEntries"
+	GetStorageEaclEntry          string = "GetStorageEaclEntry"
+	RemoveStorageEaclEntry       string = "RemoveStorageEaclEntry"
+	AddStorageFpaclEntry         string = "AddStorageFpaclEntry"
+	EnumerateStorageAllocEntries string = "EnumerateStorageAllocEntries"
+	GetStorageAllocEntry         string = "GetStorageAllocEntry"
+	UpdateStorageFpaclEntry      string = "UpdateStorageFpaclEntry"
+	RemoveStorageFpaclEntry      string = "RemoveStorageFpaclEntry"
+)
+
+const (
+	ReturnValueSuccess                  ReturnValue = 0
+	ReturnValueInternalError            ReturnValue = 1
+	ReturnValueNotReady                 ReturnValue = 2
+	ReturnValueApplicationNotRegistered ReturnValue = 8
+	ReturnValueInvalidRegistrationData  ReturnValue = 9
+	ReturnValueApplicationDoesNotExist  ReturnValue = 10
+	ReturnValueNotEnoughStorage         ReturnValue = 11
+	ReturnValueInvalidName              ReturnValue = 12
+	ReturnValueBlockDoesNotExist        ReturnValue = 13
+	ReturnValueInvalidByteOffset        ReturnValue = 14
+	ReturnValueInvalidByteCount         ReturnValue = 15
+	ReturnValueNotPermitted             ReturnValue = 16
+	ReturnValueNotOwner                 ReturnValue = 17
+	ReturnValueBlockLockedByOther       ReturnValue = 18
+	ReturnValueBlockNotLocked           ReturnValue = 19
+	ReturnValueMaxLimitReached          ReturnValue = 23
+	ReturnValueInvalidIndex             ReturnValue = 35
+	ReturnValueInvalidParameter         ReturnValue = 36
+)
+
+// returnValueToString is a map of ReturnValue values to their string representation.
+var returnValueToString = map[ReturnValue]string{
+	ReturnValueSuccess:                  "Success",
+	ReturnValueInternalError:            "InternalError",
+	ReturnValueNotReady:                 "NotReady",
+	ReturnValueApplicationNotRegistered: "ApplicationNotRegistered",
+	ReturnValueInvalidRegistrationData:  "InvalidRegistrationData",
+	ReturnValueApplicationDoesNotExist:  "ApplicationDoesNotExist",
+	ReturnValueNotEnoughStorage:         "NotEnoughStorage",
+	ReturnValueInvalidName:              "InvalidName",
+	ReturnValueBlockDoesNotExist:        "BlockDoesNotExist",
+	ReturnValueInvalidByteOffset:        "InvalidByteOffset",
+	ReturnValueInvalidByteCount:         "InvalidByteCount",
+	ReturnValueNotPermitted:             "NotPermitted",
+	ReturnValueNotOwner:                 "NotOwner",
+	ReturnValueBlockLockedByOther:       "BlockLockedByOther",
+	ReturnValueBlockNotLocked:           "BlockNotLocked",
+	ReturnValueMaxLimitReached:          "MaxLimitReached",
+	ReturnValueInvalidIndex:             "InvalidIndex",
+	ReturnValueInvalidParameter:         "InvalidParameter",
+}
+
+// String returns the string representation of the ReturnValue value.
+func (r ReturnValue) String() string {
+	if value, exists := returnValueToString[r]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+const (
+	AllocationEntryTypeApplication AllocationEntryType = iota // The entry allows a single application
+	AllocationEntryTypeVendor                                 // The entry allows every application of a vendor
+)
+
+// allocationEntryTypeToString is a map of AllocationEntryType values to their string representation.
+var allocationEntryTypeToString = map[AllocationEntryType]string{
+	AllocationEntryTypeApplication: "Application",
+	AllocationEntryTypeVendor:      "Vendor",
+}
+
+// String returns the string representation of the AllocationEntryType value.
+func (a AllocationEntryType) String() string {
+	if value, exists := allocationEntryTypeToString[a]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
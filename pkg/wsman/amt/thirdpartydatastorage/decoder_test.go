@@ -0,0 +1,43 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package thirdpartydatastorage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReturnValue_String(t *testing.T) {
+	tests := []struct {
+		returnValue ReturnValue
+		expected    string
+	}{
+		{ReturnValueSuccess, "Success"},
+		{ReturnValueNotEnoughStorage, "NotEnoughStorage"},
+		{ReturnValueBlockDoesNotExist, "BlockDoesNotExist"},
+		{ReturnValueInvalidByteCount, "InvalidByteCount"},
+		{ReturnValueInvalidParameter, "InvalidParameter"},
+		{ReturnValue(999), ValueNotFound},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, test.returnValue.String())
+	}
+}
+
+func TestAllocationEntryType_String(t *testing.T) {
+	assert.Equal(t, "Application", AllocationEntryTypeApplication.String())
+	assert.Equal(t, "Vendor", AllocationEntryTypeVendor.String())
+	assert.Equal(t, ValueNotFound, AllocationEntryType(9).String())
+}
+
+func TestResponseMarshaling(t *testing.T) {
+	response := Response{Body: Body{GetMTU_OUTPUT: GetMTU_OUTPUT{Mtu: 1024}}}
+
+	assert.Contains(t, response.JSON(), `"Mtu":1024`)
+	assert.Contains(t, response.YAML(), "mtu: 1024")
+}
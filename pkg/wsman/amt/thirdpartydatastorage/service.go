@@ -0,0 +1,166 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package thirdpartydatastorage facilitates communication with Intel® AMT devices to use the Third-Party Data Storage
+// (3PDS), a small non-volatile storage area that survives reinstalling the operating system.
+//
+// Applications register with the service and allocate named blocks, which they read and write in chunks of up to the
+// MTU. The administration service reserves storage for partner applications and lists the enterprises whose
+// applications can share blocks. Store is a key-value abstraction on top of the blocks of a single application.
+package thirdpartydatastorage
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/methods"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/base"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+var ErrMethodFailed = errors.New("third-party data storage method failed")
+
+// NewServiceWithClient instantiates a new Third-Party Data Storage service.
+func NewServiceWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) Service {
+	return Service{
+		base.NewService[Response](wsmanMessageCreator, AMTThirdPartyDataStorageService, client),
+	}
+}
+
+// RegisterApplication starts a session of the application. The returned SessionHandle is passed to the other methods.
+func (service Service) RegisterApplication(application Application, opts ...base.HeaderOption) (response Response, err error) {
+	input := RegisterApplication_INPUT{
+		CallerUUID:      base64.StdEncoding.EncodeToString(application.UUID[:]),
+		VendorName:      application.VendorName,
+		ApplicationName: application.ApplicationName,
+		EnterpriseName:  application.EnterpriseName,
+	}
+
+	return service.invoke(RegisterApplication, &input, func(body Body) ReturnValue { return body.RegisterApplication_OUTPUT.ReturnValue }, opts)
+}
+
+// UnregisterApplication ends the session. The blocks of the application are kept.
+func (service Service) UnregisterApplication(sessionHandle int, opts ...base.HeaderOption) (response Response, err error) {
+	return service.invoke(UnregisterApplication, sessionRequest(UnregisterApplication, sessionHandle), func(body Body) ReturnValue { return body.UnregisterApplication_OUTPUT.ReturnValue }, opts)
+}
+
+// GetMTU returns the maximum number of bytes that a single ReadBlock or WriteBlock call transfers.
+func (service Service) GetMTU(sessionHandle int, opts ...base.HeaderOption) (response Response, err error) {
+	return service.invoke(GetMTU, sessionRequest(GetMTU, sessionHandle), func(body Body) ReturnValue { return body.GetMTU_OUTPUT.ReturnValue }, opts)
+}
+
+// GetFreeBytes returns the number of bytes the application can still allocate.
+func (service Service) GetFreeBytes(sessionHandle int, opts ...base.HeaderOption) (response Response, err error) {
+	return service.invoke(GetFreeBytes, sessionRequest(GetFreeBytes, sessionHandle), func(body Body) ReturnValue { return body.GetFreeBytes_OUTPUT.ReturnValue }, opts)
+}
+
+// GetRegisteredApplications returns the handles of the applications that registered with the storage.
+func (service Service) GetRegisteredApplications(sessionHandle int, opts ...base.HeaderOption) (response Response, err error) {
+	return service.invoke(GetRegisteredApplications, sessionRequest(GetRegisteredApplications, sessionHandle), func(body Body) ReturnValue { return body.GetRegisteredApplications_OUTPUT.ReturnValue }, opts)
+}
+
+// GetApplicationAttributes returns the names and the allocation of a registered application.
+func (service Service) GetApplicationAttributes(sessionHandle, applicationHandle int, opts ...base.HeaderOption) (response Response, err error) {
+	input := GetApplicationAttributes_INPUT{SessionHandle: sessionHandle, Handle: applicationHandle}
+
+	return service.invoke(GetApplicationAttributes, &input, func(body Body) ReturnValue { return body.GetApplicationAttributes_OUTPUT.ReturnValue }, opts)
+}
+
+// GetCurrentApplicationHandle returns the handle of the application of the session.
+func (service Service) GetCurrentApplicationHandle(sessionHandle int, opts ...base.HeaderOption) (response Response, err error) {
+	return service.invoke(GetCurrentApplicationHandle, sessionRequest(GetCurrentApplicationHandle, sessionHandle), func(body Body) ReturnValue { return body.GetCurrentApplicationHandle_OUTPUT.ReturnValue }, opts)
+}
+
+// GetAllocatedBlocks returns the handles of the blocks owned by an application that the session can access.
+func (service Service) GetAllocatedBlocks(sessionHandle, ownerApplicationHandle int, opts ...base.HeaderOption) (response Response, err error) {
+	input := GetAllocatedBlocks_INPUT{SessionHandle: sessionHandle, BlockOwnerApplication: ownerApplicationHandle}
+
+	return service.invoke(GetAllocatedBlocks, &input, func(body Body) ReturnValue { return body.GetAllocatedBlocks_OUTPUT.ReturnValue }, opts)
+}
+
+// AllocateBlock allocates a block of size bytes. Hidden blocks can't be accessed by the other applications of the
+// enterprise.
+func (service Service) AllocateBlock(sessionHandle, size int, name string, hidden bool, opts ...base.HeaderOption) (response Response, err error) {
+	input := AllocateBlock_INPUT{SessionHandle: sessionHandle, BytesRequested: size, BlockHidden: hidden, BlockName: name}
+
+	return service.invoke(AllocateBlock, &input, func(body Body) ReturnValue { return body.AllocateBlock_OUTPUT.ReturnValue }, opts)
+}
+
+// DeallocateBlock deletes a block and frees its storage.
+func (service Service) DeallocateBlock(sessionHandle, blockHandle int, opts ...base.HeaderOption) (response Response, err error) {
+	return service.invoke(DeallocateBlock, blockRequest(DeallocateBlock, sessionHandle, blockHandle), func(body Body) ReturnValue { return body.DeallocateBlock_OUTPUT.ReturnValue }, opts)
+}
+
+// GetBlockAttributes returns the size, name and visibility of a block.
+func (service Service) GetBlockAttributes(sessionHandle, blockHandle int, opts ...base.HeaderOption) (response Response, err error) {
+	return service.invoke(GetBlockAttributes, blockRequest(GetBlockAttributes, sessionHandle, blockHandle), func(body Body) ReturnValue { return body.GetBlockAttributes_OUTPUT.ReturnValue }, opts)
+}
+
+// SetBlockAttributes resizes, renames or changes the visibility of a block.
+func (service Service) SetBlockAttributes(attributes SetBlockAttributes_INPUT, opts ...base.HeaderOption) (response Response, err error) {
+	return service.invoke(SetBlockAttributes, &attributes, func(body Body) ReturnValue { return body.SetBlockAttributes_OUTPUT.ReturnValue }, opts)
+}
+
+// ReadBlock reads count bytes of a block from offset. The count can't exceed the MTU. The data is base64 encoded in
+// ReadBlock_OUTPUT.Data.
+func (service Service) ReadBlock(sessionHandle, blockHandle, offset, count int, opts ...base.HeaderOption) (response Response, err error) {
+	input := ReadBlock_INPUT{SessionHandle: sessionHandle, BlockHandle: blockHandle, ByteOffset: offset, ByteCount: count}
+
+	return service.invoke(ReadBlock, &input, func(body Body) ReturnValue { return body.ReadBlock_OUTPUT.ReturnValue }, opts)
+}
+
+// WriteBlock writes data to a block from offset. The length of data can't exceed the MTU.
+func (service Service) WriteBlock(sessionHandle, blockHandle, offset int, data []byte, opts ...base.HeaderOption) (response Response, err error) {
+	input := WriteBlock_INPUT{SessionHandle: sessionHandle, BlockHandle: blockHandle, ByteOffset: offset, Data: base64.StdEncoding.EncodeToString(data)}
+
+	return service.invoke(WriteBlock, &input, func(body Body) ReturnValue { return body.WriteBlock_OUTPUT.ReturnValue }, opts)
+}
+
+func (service Service) invoke(method string, input interface{}, returnValue func(Body) ReturnValue, opts []base.HeaderOption) (Response, error) {
+	return invoke(&service.Base, AMTThirdPartyDataStorageService, method, input, returnValue, opts)
+}
+
+func sessionRequest(method string, sessionHandle int) *SessionRequest {
+	return &SessionRequest{XMLName: inputName(method), SessionHandle: sessionHandle}
+}
+
+func blockRequest(method string, sessionHandle, blockHandle int) *BlockRequest {
+	return &BlockRequest{XMLName: inputName(method), SessionHandle: sessionHandle, BlockHandle: blockHandle}
+}
+
+func inputName(method string) xml.Name {
+	return xml.Name{Local: "h:" + methods.GenerateInputMethod(method)}
+}
+
+// invoke calls a method of the class and fails when its ReturnValue isn't Success.
+func invoke(b *message.Base, class, method string, input interface{}, returnValue func(Body) ReturnValue, opts []base.HeaderOption) (response Response, err error) {
+	header := b.WSManMessageCreator.CreateHeader(methods.GenerateAction(class, method), class, nil, "", "", opts...)
+	body := b.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(method), class, input)
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: b.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	err = b.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	if value := returnValue(response.Body); value != ReturnValueSuccess {
+		return response, fmt.Errorf("%w: %s returned %d (%s)", ErrMethodFailed, method, value, value)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,351 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package thirdpartydatastorage
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+const serviceNamespace = `xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService"`
+
+var agent = Application{
+	UUID:            [16]byte{0x88, 0xbb, 0x0c, 0x25, 0x8a, 0x44, 0x4c, 0x0a, 0x9e, 0x4d, 0x2e, 0x8b, 0x1d, 0x8b, 0x5a, 0x6f},
+	VendorName:      "Vendor",
+	ApplicationName: "Agent",
+	EnterpriseName:  "Enterprise",
+}
+
+func TestPositiveAMT_ThirdPartyDataStorageService(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/thirdpartydatastorage/service",
+	}
+	elementUnderTest := NewServiceWithClient(wsmanMessageCreator, &client)
+
+	expected := ServiceResponse{
+		XMLName:                 xml.Name{Space: message.AMTSchema + AMTThirdPartyDataStorageService, Local: AMTThirdPartyDataStorageService},
+		CreationClassName:       AMTThirdPartyDataStorageService,
+		ElementName:             "Intel(r) AMT Third Party Data Storage Service",
+		Name:                    "Intel(r) AMT Third Party Data Storage Service",
+		SystemCreationClassName: "CIM_ComputerSystem",
+		SystemName:              "Intel(r) AMT",
+		EnabledState:            5,
+		RequestedState:          12,
+	}
+
+	t.Run("amt_ThirdPartyDataStorageService Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			action       string
+			body         string
+			responseFunc func() (Response, error)
+			check        func(t *testing.T, body Body)
+		}{
+			{
+				"should create a valid AMT_ThirdPartyDataStorageService Get wsman message",
+				wsmantesting.Get,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get()
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, expected, body.ServiceGetResponse)
+				},
+			},
+			{
+				"should create a valid AMT_ThirdPartyDataStorageService Enumerate wsman message",
+				wsmantesting.Enumerate,
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, "D7000000-0000-0000-0000-000000000000", body.EnumerateResponse.EnumerationContext)
+				},
+			},
+			{
+				"should create a valid AMT_ThirdPartyDataStorageService Pull wsman message",
+				wsmantesting.Pull,
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, []ServiceResponse{expected}, body.PullResponse.ServiceItems)
+				},
+			},
+			{
+				"should create a valid AMT_ThirdPartyDataStorageService RegisterApplication wsman message",
+				methodAction(AMTThirdPartyDataStorageService, RegisterApplication),
+				`<h:RegisterApplication_INPUT ` + serviceNamespace + `><h:CallerUUID>iLsMJYpETAqeTS6LHYtabw==</h:CallerUUID><h:VendorName>Vendor</h:VendorName><h:ApplicationName>Agent</h:ApplicationName><h:EnterpriseName>Enterprise</h:EnterpriseName></h:RegisterApplication_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = RegisterApplication
+
+					return elementUnderTest.RegisterApplication(agent)
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, 1, body.RegisterApplication_OUTPUT.SessionHandle)
+				},
+			},
+			{
+				"should create a valid AMT_ThirdPartyDataStorageService UnregisterApplication wsman message",
+				methodAction(AMTThirdPartyDataStorageService, UnregisterApplication),
+				`<h:UnregisterApplication_INPUT ` + serviceNamespace + `><h:SessionHandle>1</h:SessionHandle></h:UnregisterApplication_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = UnregisterApplication
+
+					return elementUnderTest.UnregisterApplication(1)
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, ReturnValueSuccess, body.UnregisterApplication_OUTPUT.ReturnValue)
+				},
+			},
+			{
+				"should create a valid AMT_ThirdPartyDataStorageService GetMTU wsman message",
+				methodAction(AMTThirdPartyDataStorageService, GetMTU),
+				`<h:GetMTU_INPUT ` + serviceNamespace + `><h:SessionHandle>1</h:SessionHandle></h:GetMTU_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = GetMTU
+
+					return elementUnderTest.GetMTU(1)
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, 1024, body.GetMTU_OUTPUT.Mtu)
+				},
+			},
+			{
+				"should create a valid AMT_ThirdPartyDataStorageService GetFreeBytes wsman message",
+				methodAction(AMTThirdPartyDataStorageService, GetFreeBytes),
+				`<h:GetFreeBytes_INPUT ` + serviceNamespace + `><h:SessionHandle>1</h:SessionHandle></h:GetFreeBytes_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = GetFreeBytes
+
+					return elementUnderTest.GetFreeBytes(1)
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, 4096, body.GetFreeBytes_OUTPUT.FreeBytes)
+				},
+			},
+			{
+				"should create a valid AMT_ThirdPartyDataStorageService GetRegisteredApplications wsman message",
+				methodAction(AMTThirdPartyDataStorageService, GetRegisteredApplications),
+				`<h:GetRegisteredApplications_INPUT ` + serviceNamespace + `><h:SessionHandle>1</h:SessionHandle></h:GetRegisteredApplications_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = GetRegisteredApplications
+
+					return elementUnderTest.GetRegisteredApplications(1)
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, []int{1, 2}, body.GetRegisteredApplications_OUTPUT.ApplicationHandles)
+				},
+			},
+			{
+				"should create a valid AMT_ThirdPartyDataStorageService GetApplicationAttributes wsman message",
+				methodAction(AMTThirdPartyDataStorageService, GetApplicationAttributes),
+				`<h:GetApplicationAttributes_INPUT ` + serviceNamespace + `><h:SessionHandle>1</h:SessionHandle><h:Handle>2</h:Handle></h:GetApplicationAttributes_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = GetApplicationAttributes
+
+					return elementUnderTest.GetApplicationAttributes(1, 2)
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+
+					attributes := body.GetApplicationAttributes_OUTPUT
+					assert.Equal(t, "iLsMJYpETAqeTS6LHYtabw==", attributes.UUID)
+					assert.Equal(t, "Enterprise", attributes.EnterpriseName)
+					assert.Equal(t, 256, attributes.CurrentAllocationSize)
+					assert.True(t, attributes.ActiveSession)
+					assert.False(t, attributes.PartnerApplication)
+				},
+			},
+			{
+				"should create a valid AMT_ThirdPartyDataStorageService GetCurrentApplicationHandle wsman message",
+				methodAction(AMTThirdPartyDataStorageService, GetCurrentApplicationHandle),
+				`<h:GetCurrentApplicationHandle_INPUT ` + serviceNamespace + `><h:SessionHandle>1</h:SessionHandle></h:GetCurrentApplicationHandle_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = GetCurrentApplicationHandle
+
+					return elementUnderTest.GetCurrentApplicationHandle(1)
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, 2, body.GetCurrentApplicationHandle_OUTPUT.ApplicationHandle)
+				},
+			},
+			{
+				"should create a valid AMT_ThirdPartyDataStorageService GetAllocatedBlocks wsman message",
+				methodAction(AMTThirdPartyDataStorageService, GetAllocatedBlocks),
+				`<h:GetAllocatedBlocks_INPUT ` + serviceNamespace + `><h:SessionHandle>1</h:SessionHandle><h:BlockOwnerApplication>2</h:BlockOwnerApplication></h:GetAllocatedBlocks_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = GetAllocatedBlocks
+
+					return elementUnderTest.GetAllocatedBlocks(1, 2)
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, []int{3, 4}, body.GetAllocatedBlocks_OUTPUT.BlockHandles)
+				},
+			},
+			{
+				"should create a valid AMT_ThirdPartyDataStorageService AllocateBlock wsman message",
+				methodAction(AMTThirdPartyDataStorageService, AllocateBlock),
+				`<h:AllocateBlock_INPUT ` + serviceNamespace + `><h:SessionHandle>1</h:SessionHandle><h:BytesRequested>256</h:BytesRequested><h:BlockHidden>true</h:BlockHidden><h:BlockName>config</h:BlockName></h:AllocateBlock_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = AllocateBlock
+
+					return elementUnderTest.AllocateBlock(1, 256, "config", true)
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, 5, body.AllocateBlock_OUTPUT.BlockHandle)
+				},
+			},
+			{
+				"should create a valid AMT_ThirdPartyDataStorageService DeallocateBlock wsman message",
+				methodAction(AMTThirdPartyDataStorageService, DeallocateBlock),
+				`<h:DeallocateBlock_INPUT ` + serviceNamespace + `><h:SessionHandle>1</h:SessionHandle><h:BlockHandle>5</h:BlockHandle></h:DeallocateBlock_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = DeallocateBlock
+
+					return elementUnderTest.DeallocateBlock(1, 5)
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, ReturnValueSuccess, body.DeallocateBlock_OUTPUT.ReturnValue)
+				},
+			},
+			{
+				"should create a valid AMT_ThirdPartyDataStorageService GetBlockAttributes wsman message",
+				methodAction(AMTThirdPartyDataStorageService, GetBlockAttributes),
+				`<h:GetBlockAttributes_INPUT ` + serviceNamespace + `><h:SessionHandle>1</h:SessionHandle><h:BlockHandle>3</h:BlockHandle></h:GetBlockAttributes_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = GetBlockAttributes
+
+					return elementUnderTest.GetBlockAttributes(1, 3)
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, 256, body.GetBlockAttributes_OUTPUT.BlockSize)
+					assert.Equal(t, "config", body.GetBlockAttributes_OUTPUT.BlockName)
+					assert.False(t, body.GetBlockAttributes_OUTPUT.BlockHidden)
+				},
+			},
+			{
+				"should create a valid AMT_ThirdPartyDataStorageService SetBlockAttributes wsman message",
+				methodAction(AMTThirdPartyDataStorageService, SetBlockAttributes),
+				`<h:SetBlockAttributes_INPUT ` + serviceNamespace + `><h:SessionHandle>1</h:SessionHandle><h:BlockHandle>3</h:BlockHandle><h:BlockSize>512</h:BlockSize><h:BlockName>settings</h:BlockName><h:BlockHidden>false</h:BlockHidden></h:SetBlockAttributes_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = SetBlockAttributes
+
+					return elementUnderTest.SetBlockAttributes(SetBlockAttributes_INPUT{SessionHandle: 1, BlockHandle: 3, BlockSize: 512, BlockName: "settings"})
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, ReturnValueSuccess, body.SetBlockAttributes_OUTPUT.ReturnValue)
+				},
+			},
+			{
+				"should create a valid AMT_ThirdPartyDataStorageService ReadBlock wsman message",
+				methodAction(AMTThirdPartyDataStorageService, ReadBlock),
+				`<h:ReadBlock_INPUT ` + serviceNamespace + `><h:SessionHandle>1</h:SessionHandle><h:BlockHandle>3</h:BlockHandle><h:ByteOffset>0</h:ByteOffset><h:ByteCount>4</h:ByteCount></h:ReadBlock_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = ReadBlock
+
+					return elementUnderTest.ReadBlock(1, 3, 0, 4)
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, "AAJoaQ==", body.ReadBlock_OUTPUT.Data)
+				},
+			},
+			{
+				"should create a valid AMT_ThirdPartyDataStorageService WriteBlock wsman message",
+				methodAction(AMTThirdPartyDataStorageService, WriteBlock),
+				`<h:WriteBlock_INPUT ` + serviceNamespace + `><h:SessionHandle>1</h:SessionHandle><h:BlockHandle>3</h:BlockHandle><h:ByteOffset>0</h:ByteOffset><h:Data>AAJoaQ==</h:Data></h:WriteBlock_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = WriteBlock
+
+					return elementUnderTest.WriteBlock(1, 3, 0, []byte{0, 2, 'h', 'i'})
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, ReturnValueSuccess, body.WriteBlock_OUTPUT.ReturnValue)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, AMTThirdPartyDataStorageService, test.action, "", test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				test.check(t, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeAMT_ThirdPartyDataStorageService(t *testing.T) {
+	wsmanMessageCreator := message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/thirdpartydatastorage/service",
+		CurrentMessage:   wsmantesting.CurrentMessageError,
+	}
+	elementUnderTest := NewServiceWithClient(wsmanMessageCreator, &client)
+
+	calls := map[string]func() (Response, error){
+		RegisterApplication:         func() (Response, error) { return elementUnderTest.RegisterApplication(agent) },
+		UnregisterApplication:       func() (Response, error) { return elementUnderTest.UnregisterApplication(1) },
+		GetMTU:                      func() (Response, error) { return elementUnderTest.GetMTU(1) },
+		GetFreeBytes:                func() (Response, error) { return elementUnderTest.GetFreeBytes(1) },
+		GetRegisteredApplications:   func() (Response, error) { return elementUnderTest.GetRegisteredApplications(1) },
+		GetApplicationAttributes:    func() (Response, error) { return elementUnderTest.GetApplicationAttributes(1, 2) },
+		GetCurrentApplicationHandle: func() (Response, error) { return elementUnderTest.GetCurrentApplicationHandle(1) },
+		GetAllocatedBlocks:          func() (Response, error) { return elementUnderTest.GetAllocatedBlocks(1, 2) },
+		AllocateBlock:               func() (Response, error) { return elementUnderTest.AllocateBlock(1, 256, "config", false) },
+		DeallocateBlock:             func() (Response, error) { return elementUnderTest.DeallocateBlock(1, 3) },
+		GetBlockAttributes:          func() (Response, error) { return elementUnderTest.GetBlockAttributes(1, 3) },
+		SetBlockAttributes:          func() (Response, error) { return elementUnderTest.SetBlockAttributes(SetBlockAttributes_INPUT{}) },
+		ReadBlock:                   func() (Response, error) { return elementUnderTest.ReadBlock(1, 3, 0, 4) },
+		WriteBlock:                  func() (Response, error) { return elementUnderTest.WriteBlock(1, 3, 0, nil) },
+	}
+
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			_, err := call()
+			assert.Error(t, err)
+		})
+	}
+}
+
+func methodAction(class, method string) string {
+	return message.AMTSchema + class + "/" + method
+}
@@ -0,0 +1,406 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package thirdpartydatastorage
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// MaxKeyLength is the maximum length of a key, which is stored as the name of its block.
+	MaxKeyLength = 32
+	// MaxRecordSize is the largest value the record format can hold, as its length is stored in the first two bytes of
+	// the block. It is not a limit of the device: a value must also fit in the free storage reported by GetFreeBytes,
+	// which is usually much smaller.
+	MaxRecordSize = 0xFFFF
+
+	// TemporaryKeyPrefix starts the names of the blocks that replace a record while it is being rewritten. Keys can't
+	// start with it.
+	TemporaryKeyPrefix = "~"
+
+	recordHeaderSize = 2
+)
+
+var (
+	ErrKeyNotFound    = errors.New("key not found")
+	ErrInvalidKey     = errors.New("invalid key")
+	ErrRecordTooLarge = errors.New("record too large")
+	ErrStorageFull    = errors.New("not enough free storage")
+	ErrCorruptRecord  = errors.New("corrupt record")
+)
+
+// Store is a key-value store on top of the blocks of an application. Each record is kept in its own block, named after
+// the key, and starts with the length of the value so that a block can be reused for a smaller value.
+type Store struct {
+	service           Service
+	sessionHandle     int
+	applicationHandle int
+	mtu               int
+}
+
+// OpenStore registers the application and returns a store of its blocks. Close unregisters the application.
+func OpenStore(service Service, application Application) (*Store, error) {
+	response, err := service.RegisterApplication(application)
+	if err != nil {
+		return nil, err
+	}
+
+	store := &Store{service: service, sessionHandle: response.Body.RegisterApplication_OUTPUT.SessionHandle}
+
+	response, err = service.GetMTU(store.sessionHandle)
+	if err != nil {
+		return nil, errors.Join(err, store.Close())
+	}
+
+	store.mtu = response.Body.GetMTU_OUTPUT.Mtu
+	if store.mtu <= 0 {
+		return nil, errors.Join(fmt.Errorf("%w: MTU is %d", ErrMethodFailed, store.mtu), store.Close())
+	}
+
+	response, err = service.GetCurrentApplicationHandle(store.sessionHandle)
+	if err != nil {
+		return nil, errors.Join(err, store.Close())
+	}
+
+	store.applicationHandle = response.Body.GetCurrentApplicationHandle_OUTPUT.ApplicationHandle
+
+	return store, nil
+}
+
+// Close unregisters the application. The records are kept.
+func (store *Store) Close() error {
+	_, err := store.service.UnregisterApplication(store.sessionHandle)
+
+	return err
+}
+
+// Put stores value under key. The block of the key is reused when it is large enough; such a rewrite happens in place
+// and is not atomic when the record takes more than one MTU-sized write. Otherwise the record is written to a new
+// block, allocated under a temporary name, which replaces the old block once it is complete, so that the old record is
+// kept when the new one can't be stored. A new block must fit in the free storage reported by the device, which
+// doesn't include the block it replaces, or Put fails with ErrStorageFull.
+func (store *Store) Put(key string, value []byte) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+
+	if len(value) > MaxRecordSize {
+		return fmt.Errorf("%w: %d bytes exceed %d", ErrRecordTooLarge, len(value), MaxRecordSize)
+	}
+
+	record := make([]byte, recordHeaderSize+len(value))
+	binary.BigEndian.PutUint16(record, uint16(len(value)))
+	copy(record[recordHeaderSize:], value)
+
+	block, found, err := store.lookup(key)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case !found:
+		block, err = store.allocate(key, key, len(record))
+		if err != nil {
+			return err
+		}
+	case block.size < len(record):
+		return store.replace(key, block, record)
+	}
+
+	return store.write(block, record)
+}
+
+// Get returns the value stored under key, or ErrKeyNotFound.
+func (store *Store) Get(key string) ([]byte, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+
+	block, found, err := store.lookup(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrKeyNotFound, key)
+	}
+
+	if block.size < recordHeaderSize {
+		return nil, fmt.Errorf("%w: block of %s has %d bytes", ErrCorruptRecord, key, block.size)
+	}
+
+	record, err := store.read(block.handle, 0, min(store.mtu, block.size))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(record) < recordHeaderSize {
+		return nil, fmt.Errorf("%w: %s has no length", ErrCorruptRecord, key)
+	}
+
+	size := recordHeaderSize + int(binary.BigEndian.Uint16(record))
+	if size > block.size {
+		return nil, fmt.Errorf("%w: %s has %d bytes but its block has %d", ErrCorruptRecord, key, size, block.size)
+	}
+
+	for len(record) < size {
+		chunk, err := store.read(block.handle, len(record), min(store.mtu, size-len(record)))
+		if err != nil {
+			return nil, err
+		}
+
+		if len(chunk) == 0 {
+			return nil, fmt.Errorf("%w: %s is truncated", ErrCorruptRecord, key)
+		}
+
+		record = append(record, chunk...)
+	}
+
+	return record[recordHeaderSize:size], nil
+}
+
+// Delete removes key and frees its block, or returns ErrKeyNotFound.
+func (store *Store) Delete(key string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+
+	block, found, err := store.lookup(key)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("%w: %s", ErrKeyNotFound, key)
+	}
+
+	_, err = store.service.DeallocateBlock(store.sessionHandle, block.handle)
+
+	return err
+}
+
+// Keys returns the keys of the store. Blocks of records that are being replaced are skipped.
+func (store *Store) Keys() ([]string, error) {
+	blocks, err := store.blocks()
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(blocks))
+	for _, block := range blocks {
+		if !strings.HasPrefix(block.name, TemporaryKeyPrefix) {
+			keys = append(keys, block.name)
+		}
+	}
+
+	return keys, nil
+}
+
+type block struct {
+	handle int
+	name   string
+	size   int
+}
+
+func (store *Store) blocks() ([]block, error) {
+	response, err := store.service.GetAllocatedBlocks(store.sessionHandle, store.applicationHandle)
+	if err != nil {
+		return nil, err
+	}
+
+	handles := response.Body.GetAllocatedBlocks_OUTPUT.BlockHandles
+	blocks := make([]block, 0, len(handles))
+
+	for _, handle := range handles {
+		response, err := store.service.GetBlockAttributes(store.sessionHandle, handle)
+		if err != nil {
+			return nil, err
+		}
+
+		attributes := response.Body.GetBlockAttributes_OUTPUT
+		blocks = append(blocks, block{handle: handle, name: attributes.BlockName, size: attributes.BlockSize})
+	}
+
+	return blocks, nil
+}
+
+// lookup returns the block of key. A replacement that was interrupted is completed first: its temporary block is
+// dropped when the old block is still there, and takes the name of the key otherwise, as it was fully written.
+func (store *Store) lookup(key string) (block, bool, error) {
+	blocks, err := store.blocks()
+	if err != nil {
+		return block{}, false, err
+	}
+
+	var current, pending block
+
+	temporary := temporaryName(key)
+
+	for _, b := range blocks {
+		switch b.name {
+		case key:
+			current = b
+		case temporary:
+			pending = b
+		}
+	}
+
+	switch {
+	case current.name != "" && pending.name != "":
+		_, err := store.service.DeallocateBlock(store.sessionHandle, pending.handle)
+
+		return current, true, err
+	case pending.name != "":
+		pending, err := store.rename(pending, key)
+
+		return pending, err == nil, err
+	}
+
+	return current, current.name != "", nil
+}
+
+// allocate allocates a block of size bytes named name for the record of key. The free storage reported by the device
+// is checked first so that a record that doesn't fit fails with ErrStorageFull.
+func (store *Store) allocate(key, name string, size int) (block, error) {
+	response, err := store.service.GetFreeBytes(store.sessionHandle)
+	if err != nil {
+		return block{}, err
+	}
+
+	available := response.Body.GetFreeBytes_OUTPUT.FreeBytes
+	if size > available {
+		return block{}, fmt.Errorf("%w: %s needs %d bytes but %d are available", ErrStorageFull, key, size, available)
+	}
+
+	response, err = store.service.AllocateBlock(store.sessionHandle, size, name, false)
+	if err != nil {
+		return block{}, err
+	}
+
+	return block{handle: response.Body.AllocateBlock_OUTPUT.BlockHandle, name: name, size: size}, nil
+}
+
+// replace writes record to a new block under a temporary name, then frees the old block of key and renames the new
+// one after key. The old record is kept until the new one is complete.
+func (store *Store) replace(key string, old block, record []byte) error {
+	replacement, err := store.allocate(key, temporaryName(key), len(record))
+	if err != nil {
+		return err
+	}
+
+	if err := store.write(replacement, record); err != nil {
+		_, deallocateErr := store.service.DeallocateBlock(store.sessionHandle, replacement.handle)
+
+		return errors.Join(err, deallocateErr)
+	}
+
+	if _, err := store.service.DeallocateBlock(store.sessionHandle, old.handle); err != nil {
+		_, deallocateErr := store.service.DeallocateBlock(store.sessionHandle, replacement.handle)
+
+		return errors.Join(err, deallocateErr)
+	}
+
+	// should the rename fail, the next lookup of key completes it
+	_, err = store.rename(replacement, key)
+
+	return err
+}
+
+func (store *Store) rename(b block, name string) (block, error) {
+	_, err := store.service.SetBlockAttributes(SetBlockAttributes_INPUT{
+		SessionHandle: store.sessionHandle,
+		BlockHandle:   b.handle,
+		BlockSize:     b.size,
+		BlockName:     name,
+	})
+	if err != nil {
+		return block{}, err
+	}
+
+	b.name = name
+
+	return b, nil
+}
+
+func (store *Store) write(b block, record []byte) error {
+	for offset := 0; offset < len(record); offset += store.mtu {
+		end := min(offset+store.mtu, len(record))
+
+		if _, err := store.service.WriteBlock(store.sessionHandle, b.handle, offset, record[offset:end]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (store *Store) read(blockHandle, offset, count int) ([]byte, error) {
+	response, err := store.service.ReadBlock(store.sessionHandle, blockHandle, offset, count)
+	if err != nil {
+		return nil, err
+	}
+
+	return base64.StdEncoding.DecodeString(response.Body.ReadBlock_OUTPUT.Data)
+}
+
+func validateKey(key string) error {
+	if key == "" || len(key) > MaxKeyLength {
+		return fmt.Errorf("%w: %q must have 1 to %d bytes", ErrInvalidKey, key, MaxKeyLength)
+	}
+
+	if strings.HasPrefix(key, TemporaryKeyPrefix) {
+		return fmt.Errorf("%w: %q starts with the reserved prefix %q", ErrInvalidKey, key, TemporaryKeyPrefix)
+	}
+
+	return nil
+}
+
+// temporaryName returns the name of the block that replaces the block of key. It is derived from a hash of the key so
+// that it fits in MaxKeyLength.
+func temporaryName(key string) string {
+	hash := sha256.Sum256([]byte(key))
+
+	return TemporaryKeyPrefix + hex.EncodeToString(hash[:])[:MaxKeyLength-len(TemporaryKeyPrefix)]
+}
+
+// TypedStore stores values of type T as JSON records of a Store.
+type TypedStore[T any] struct {
+	*Store
+}
+
+// NewTypedStore returns a TypedStore that keeps its records in store.
+func NewTypedStore[T any](store *Store) TypedStore[T] {
+	return TypedStore[T]{Store: store}
+}
+
+// Put stores the JSON encoding of value under key.
+func (store TypedStore[T]) Put(key string, value T) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return store.Store.Put(key, data)
+}
+
+// Get decodes the value stored under key, or returns ErrKeyNotFound.
+func (store TypedStore[T]) Get(key string) (value T, err error) {
+	data, err := store.Store.Get(key)
+	if err != nil {
+		return value, err
+	}
+
+	err = json.Unmarshal(data, &value)
+
+	return value, err
+}
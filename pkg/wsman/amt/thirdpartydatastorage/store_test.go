@@ -0,0 +1,306 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package thirdpartydatastorage
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+var methodPattern = regexp.MustCompile(`<a:Action>[^<]*/([A-Za-z]+)</a:Action>`)
+
+type storedBlock struct {
+	name string
+	data []byte
+}
+
+// storageClient keeps the blocks of a single application in memory and answers the Third-Party Data Storage methods
+// like AMT would.
+type storageClient struct {
+	client.WSMan
+
+	mtu        int
+	capacity   int
+	blocks     map[int]*storedBlock
+	next       int
+	calls      []string
+	failWrite  bool
+	failRename bool
+}
+
+func newStorageClient(mtu, capacity int) *storageClient {
+	return &storageClient{mtu: mtu, capacity: capacity, blocks: map[int]*storedBlock{}, next: 10}
+}
+
+func (c *storageClient) Post(msg string) ([]byte, error) {
+	method := methodPattern.FindStringSubmatch(msg)[1]
+	c.calls = append(c.calls, method)
+
+	field := func(name string) string {
+		return regexp.MustCompile(`<h:` + name + `>([^<]*)</h:` + name + `>`).FindStringSubmatch(msg)[1]
+	}
+	number := func(name string) int {
+		value, _ := strconv.Atoi(field(name))
+
+		return value
+	}
+
+	var output string
+
+	returnValue := ReturnValueSuccess
+
+	switch method {
+	case RegisterApplication:
+		output = `<SessionHandle>1</SessionHandle>`
+	case GetMTU:
+		output = fmt.Sprintf(`<Mtu>%d</Mtu>`, c.mtu)
+	case GetFreeBytes:
+		output = fmt.Sprintf(`<FreeBytes>%d</FreeBytes>`, c.free())
+	case GetCurrentApplicationHandle:
+		output = `<ApplicationHandle>2</ApplicationHandle>`
+	case GetAllocatedBlocks:
+		handles := make([]int, 0, len(c.blocks))
+		for handle := range c.blocks {
+			handles = append(handles, handle)
+		}
+
+		slices.Sort(handles)
+
+		for _, handle := range handles {
+			output += fmt.Sprintf(`<BlockHandles>%d</BlockHandles>`, handle)
+		}
+	case GetBlockAttributes:
+		block := c.blocks[number("BlockHandle")]
+		output = fmt.Sprintf(`<BlockSize>%d</BlockSize><BlockHidden>false</BlockHidden><BlockName>%s</BlockName>`, len(block.data), block.name)
+	case AllocateBlock:
+		if number("BytesRequested") > c.free() {
+			returnValue = ReturnValueNotEnoughStorage
+
+			break
+		}
+
+		c.next++
+		c.blocks[c.next] = &storedBlock{name: field("BlockName"), data: make([]byte, number("BytesRequested"))}
+		output = fmt.Sprintf(`<BlockHandle>%d</BlockHandle>`, c.next)
+	case DeallocateBlock:
+		delete(c.blocks, number("BlockHandle"))
+	case SetBlockAttributes:
+		if c.failRename {
+			returnValue = ReturnValueInvalidName
+
+			break
+		}
+
+		c.blocks[number("BlockHandle")].name = field("BlockName")
+	case ReadBlock:
+		block, offset, count := c.blocks[number("BlockHandle")], number("ByteOffset"), number("ByteCount")
+		if count > c.mtu || offset+count > len(block.data) {
+			returnValue = ReturnValueInvalidByteCount
+
+			break
+		}
+
+		output = `<Data>` + base64.StdEncoding.EncodeToString(block.data[offset:offset+count]) + `</Data>`
+	case WriteBlock:
+		block, offset := c.blocks[number("BlockHandle")], number("ByteOffset")
+		data, _ := base64.StdEncoding.DecodeString(field("Data"))
+
+		if c.failWrite || len(data) > c.mtu || offset+len(data) > len(block.data) {
+			returnValue = ReturnValueInvalidByteCount
+
+			break
+		}
+
+		copy(block.data[offset:], data)
+	}
+
+	return fmt.Appendf(nil, `<Envelope><Header></Header><Body><%s_OUTPUT>%s<ReturnValue>%d</ReturnValue></%s_OUTPUT></Body></Envelope>`, method, output, returnValue, method), nil
+}
+
+func (c *storageClient) free() int {
+	free := c.capacity
+	for _, block := range c.blocks {
+		free -= len(block.data)
+	}
+
+	return free
+}
+
+func openTestStore(t *testing.T, wsmanClient *storageClient) *Store {
+	t.Helper()
+
+	store, err := OpenStore(NewServiceWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), wsmanClient), agent)
+	require.NoError(t, err)
+
+	return store
+}
+
+func TestStore(t *testing.T) {
+	wsmanClient := newStorageClient(16, 256)
+	store := openTestStore(t, wsmanClient)
+
+	t.Run("stores records larger than the MTU", func(t *testing.T) {
+		value := bytes.Repeat([]byte("0123456789"), 5)
+
+		require.NoError(t, store.Put("config", value))
+
+		stored, err := store.Get("config")
+		require.NoError(t, err)
+		assert.Equal(t, value, stored)
+	})
+
+	t.Run("reuses the block of a smaller value", func(t *testing.T) {
+		wsmanClient.calls = nil
+
+		require.NoError(t, store.Put("config", []byte("small")))
+		assert.NotContains(t, wsmanClient.calls, AllocateBlock)
+
+		stored, err := store.Get("config")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("small"), stored)
+	})
+
+	t.Run("reallocates the block of a larger value", func(t *testing.T) {
+		value := bytes.Repeat([]byte("x"), 100)
+
+		require.NoError(t, store.Put("config", value))
+		assert.Len(t, wsmanClient.blocks, 1)
+
+		stored, err := store.Get("config")
+		require.NoError(t, err)
+		assert.Equal(t, value, stored)
+	})
+
+	t.Run("lists and deletes keys", func(t *testing.T) {
+		require.NoError(t, store.Put("state", []byte{}))
+
+		keys, err := store.Keys()
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"config", "state"}, keys)
+
+		require.NoError(t, store.Delete("state"))
+
+		_, err = store.Get("state")
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+		assert.ErrorIs(t, store.Delete("state"), ErrKeyNotFound)
+	})
+
+	t.Run("keeps the old record when the new one doesn't fit", func(t *testing.T) {
+		err := store.Put("config", make([]byte, 255))
+		assert.ErrorIs(t, err, ErrStorageFull)
+
+		stored, err := store.Get("config")
+		require.NoError(t, err)
+		assert.Len(t, stored, 100)
+	})
+
+	t.Run("keeps the old record when the new one can't be written", func(t *testing.T) {
+		wsmanClient.failWrite = true
+
+		assert.ErrorIs(t, store.Put("config", make([]byte, 120)), ErrMethodFailed)
+
+		wsmanClient.failWrite = false
+
+		stored, err := store.Get("config")
+		require.NoError(t, err)
+		assert.Len(t, stored, 100)
+		assert.Len(t, wsmanClient.blocks, 1)
+	})
+
+	t.Run("completes a replacement that wasn't renamed", func(t *testing.T) {
+		wsmanClient.failRename = true
+
+		assert.ErrorIs(t, store.Put("config", bytes.Repeat([]byte("y"), 120)), ErrMethodFailed)
+
+		wsmanClient.failRename = false
+
+		keys, err := store.Keys()
+		require.NoError(t, err)
+		assert.Empty(t, keys)
+
+		stored, err := store.Get("config")
+		require.NoError(t, err)
+		assert.Equal(t, bytes.Repeat([]byte("y"), 120), stored)
+
+		keys, err = store.Keys()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"config"}, keys)
+	})
+
+	t.Run("drops a replacement that wasn't completed", func(t *testing.T) {
+		wsmanClient.next++
+		wsmanClient.blocks[wsmanClient.next] = &storedBlock{name: temporaryName("config"), data: make([]byte, 8)}
+
+		stored, err := store.Get("config")
+		require.NoError(t, err)
+		assert.Len(t, stored, 120)
+		assert.Len(t, wsmanClient.blocks, 1)
+	})
+
+	t.Run("rejects invalid keys and records", func(t *testing.T) {
+		assert.ErrorIs(t, store.Put(TemporaryKeyPrefix+"config", nil), ErrInvalidKey)
+		assert.ErrorIs(t, store.Put("", nil), ErrInvalidKey)
+		assert.ErrorIs(t, store.Put(strings.Repeat("k", MaxKeyLength+1), nil), ErrInvalidKey)
+		assert.ErrorIs(t, store.Put("config", make([]byte, MaxRecordSize+1)), ErrRecordTooLarge)
+
+		_, err := store.Get("")
+		assert.ErrorIs(t, err, ErrInvalidKey)
+	})
+
+	t.Run("fails when AMT rejects a write", func(t *testing.T) {
+		wsmanClient.failWrite = true
+		defer func() { wsmanClient.failWrite = false }()
+
+		assert.ErrorIs(t, store.Put("config", []byte("value")), ErrMethodFailed)
+	})
+
+	t.Run("detects corrupt records", func(t *testing.T) {
+		require.NoError(t, store.Put("corrupt", []byte("abc")))
+
+		for _, block := range wsmanClient.blocks {
+			if block.name == "corrupt" {
+				block.data[0] = 0xFF
+			}
+		}
+
+		_, err := store.Get("corrupt")
+		assert.ErrorIs(t, err, ErrCorruptRecord)
+	})
+
+	require.NoError(t, store.Close())
+	assert.Equal(t, UnregisterApplication, wsmanClient.calls[len(wsmanClient.calls)-1])
+}
+
+func TestTypedStore(t *testing.T) {
+	type settings struct {
+		Server string
+		Port   int
+	}
+
+	store := NewTypedStore[settings](openTestStore(t, newStorageClient(16, 256)))
+
+	require.NoError(t, store.Put("settings", settings{Server: "mps.example.com", Port: 4433}))
+
+	value, err := store.Get("settings")
+	require.NoError(t, err)
+	assert.Equal(t, settings{Server: "mps.example.com", Port: 4433}, value)
+
+	_, err = store.Get("missing")
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+}
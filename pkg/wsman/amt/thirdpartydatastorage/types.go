@@ -0,0 +1,357 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package thirdpartydatastorage
+
+import (
+	"encoding/xml"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/base"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+)
+
+type Service struct {
+	base.WSManService[Response]
+}
+
+type AdministrationService struct {
+	base.WSManService[Response]
+}
+
+// Application identifies an application that stores data. Applications of the same enterprise can share blocks.
+type Application struct {
+	UUID            [16]byte
+	VendorName      string
+	ApplicationName string
+	EnterpriseName  string
+}
+
+// OUTPUTS
+// Response Types.
+type (
+	Response struct {
+		*client.Message
+		XMLName xml.Name       `xml:"Envelope"`
+		Header  message.Header `xml:"Header"`
+		Body    Body           `xml:"Body"`
+	}
+	Body struct {
+		XMLName                             xml.Name `xml:"Body"`
+		ServiceGetResponse                  ServiceResponse
+		AdministrationServiceGetResponse    AdministrationServiceResponse
+		EnumerateResponse                   common.EnumerateResponse
+		PullResponse                        PullResponse
+		RegisterApplication_OUTPUT          RegisterApplication_OUTPUT
+		UnregisterApplication_OUTPUT        UnregisterApplication_OUTPUT
+		GetMTU_OUTPUT                       GetMTU_OUTPUT
+		GetFreeBytes_OUTPUT                 GetFreeBytes_OUTPUT
+		GetRegisteredApplications_OUTPUT    GetRegisteredApplications_OUTPUT
+		GetApplicationAttributes_OUTPUT     GetApplicationAttributes_OUTPUT
+		GetCurrentApplicationHandle_OUTPUT  GetCurrentApplicationHandle_OUTPUT
+		GetAllocatedBlocks_OUTPUT           GetAllocatedBlocks_OUTPUT
+		AllocateBlock_OUTPUT                AllocateBlock_OUTPUT
+		DeallocateBlock_OUTPUT              DeallocateBlock_OUTPUT
+		GetBlockAttributes_OUTPUT           GetBlockAttributes_OUTPUT
+		SetBlockAttributes_OUTPUT           SetBlockAttributes_OUTPUT
+		ReadBlock_OUTPUT                    ReadBlock_OUTPUT
+		WriteBlock_OUTPUT                   WriteBlock_OUTPUT
+		GetGlobalStorageAttributes_OUTPUT   GetGlobalStorageAttributes_OUTPUT
+		SetGlobalStorageAttributes_OUTPUT   SetGlobalStorageAttributes_OUTPUT
+		AddStorageEaclEntry_OUTPUT          AddStorageEaclEntry_OUTPUT
+		EnumerateStorageEaclEntries_OUTPUT  EnumerateStorageEaclEntries_OUTPUT
+		GetStorageEaclEntry_OUTPUT          GetStorageEaclEntry_OUTPUT
+		RemoveStorageEaclEntry_OUTPUT       RemoveStorageEaclEntry_OUTPUT
+		AddStorageFpaclEntry_OUTPUT         AddStorageFpaclEntry_OUTPUT
+		EnumerateStorageAllocEntries_OUTPUT EnumerateStorageAllocEntries_OUTPUT
+		GetStorageAllocEntry_OUTPUT         GetStorageAllocEntry_OUTPUT
+		UpdateStorageFpaclEntry_OUTPUT      UpdateStorageFpaclEntry_OUTPUT
+		RemoveStorageFpaclEntry_OUTPUT      RemoveStorageFpaclEntry_OUTPUT
+	}
+	PullResponse struct {
+		XMLName                    xml.Name                        `xml:"PullResponse"`
+		ServiceItems               []ServiceResponse               `xml:"Items>AMT_ThirdPartyDataStorageService"`
+		AdministrationServiceItems []AdministrationServiceResponse `xml:"Items>AMT_ThirdPartyDataStorageAdministrationService"`
+	}
+	ServiceResponse struct {
+		XMLName                 xml.Name `xml:"AMT_ThirdPartyDataStorageService"`
+		CreationClassName       string   `xml:"CreationClassName,omitempty"`       // CreationClassName indicates the name of the class or the subclass used in the creation of an instance.
+		ElementName             string   `xml:"ElementName,omitempty"`             // A user-friendly name for the object.
+		Name                    string   `xml:"Name,omitempty"`                    // The Name property uniquely identifies the Service.
+		SystemCreationClassName string   `xml:"SystemCreationClassName,omitempty"` // The scoping System's CreationClassName.
+		SystemName              string   `xml:"SystemName,omitempty"`              // The scoping System's Name.
+		EnabledState            int      `xml:"EnabledState"`                      // The enabled and disabled states of the element.
+		RequestedState          int      `xml:"RequestedState"`                    // The last requested or desired state for the element.
+	}
+	AdministrationServiceResponse struct {
+		XMLName                 xml.Name `xml:"AMT_ThirdPartyDataStorageAdministrationService"`
+		CreationClassName       string   `xml:"CreationClassName,omitempty"`       // CreationClassName indicates the name of the class or the subclass used in the creation of an instance.
+		ElementName             string   `xml:"ElementName,omitempty"`             // A user-friendly name for the object.
+		Name                    string   `xml:"Name,omitempty"`                    // The Name property uniquely identifies the Service.
+		SystemCreationClassName string   `xml:"SystemCreationClassName,omitempty"` // The scoping System's CreationClassName.
+		SystemName              string   `xml:"SystemName,omitempty"`              // The scoping System's Name.
+		EnabledState            int      `xml:"EnabledState"`                      // The enabled and disabled states of the element.
+		RequestedState          int      `xml:"RequestedState"`                    // The last requested or desired state for the element.
+	}
+	RegisterApplication_OUTPUT struct {
+		XMLName       xml.Name    `xml:"RegisterApplication_OUTPUT"`
+		SessionHandle int         `xml:"SessionHandle"` // Identifies the session in the other calls of the application.
+		ReturnValue   ReturnValue `xml:"ReturnValue"`
+	}
+	UnregisterApplication_OUTPUT struct {
+		XMLName     xml.Name    `xml:"UnregisterApplication_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	GetMTU_OUTPUT struct {
+		XMLName     xml.Name    `xml:"GetMTU_OUTPUT"`
+		Mtu         int         `xml:"Mtu"` // The maximum number of bytes read or written by a single call.
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	GetFreeBytes_OUTPUT struct {
+		XMLName     xml.Name    `xml:"GetFreeBytes_OUTPUT"`
+		FreeBytes   int         `xml:"FreeBytes"` // The number of bytes the application can still allocate.
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	GetRegisteredApplications_OUTPUT struct {
+		XMLName            xml.Name    `xml:"GetRegisteredApplications_OUTPUT"`
+		ApplicationHandles []int       `xml:"ApplicationHandles"`
+		ReturnValue        ReturnValue `xml:"ReturnValue"`
+	}
+	GetApplicationAttributes_OUTPUT struct {
+		XMLName               xml.Name    `xml:"GetApplicationAttributes_OUTPUT"`
+		UUID                  string      `xml:"UUID"` // Base64 encoded UUID of the application.
+		VendorName            string      `xml:"VendorName"`
+		ApplicationName       string      `xml:"ApplicationName"`
+		EnterpriseName        string      `xml:"EnterpriseName"`
+		CurrentAllocationSize int         `xml:"CurrentAllocationSize"` // The number of bytes allocated by the application.
+		ActiveSession         bool        `xml:"ActiveSession"`         // Whether the application is registered.
+		PartnerApplication    bool        `xml:"PartnerApplication"`    // Whether the application has a partner allocation entry.
+		ReturnValue           ReturnValue `xml:"ReturnValue"`
+	}
+	GetCurrentApplicationHandle_OUTPUT struct {
+		XMLName           xml.Name    `xml:"GetCurrentApplicationHandle_OUTPUT"`
+		ApplicationHandle int         `xml:"ApplicationHandle"`
+		ReturnValue       ReturnValue `xml:"ReturnValue"`
+	}
+	GetAllocatedBlocks_OUTPUT struct {
+		XMLName      xml.Name    `xml:"GetAllocatedBlocks_OUTPUT"`
+		BlockHandles []int       `xml:"BlockHandles"`
+		ReturnValue  ReturnValue `xml:"ReturnValue"`
+	}
+	AllocateBlock_OUTPUT struct {
+		XMLName     xml.Name    `xml:"AllocateBlock_OUTPUT"`
+		BlockHandle int         `xml:"BlockHandle"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	DeallocateBlock_OUTPUT struct {
+		XMLName     xml.Name    `xml:"DeallocateBlock_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	GetBlockAttributes_OUTPUT struct {
+		XMLName     xml.Name    `xml:"GetBlockAttributes_OUTPUT"`
+		BlockSize   int         `xml:"BlockSize"`   // The size of the block in bytes.
+		BlockHidden bool        `xml:"BlockHidden"` // Whether the block is hidden from the other applications.
+		BlockName   string      `xml:"BlockName"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	SetBlockAttributes_OUTPUT struct {
+		XMLName     xml.Name    `xml:"SetBlockAttributes_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	ReadBlock_OUTPUT struct {
+		XMLName     xml.Name    `xml:"ReadBlock_OUTPUT"`
+		Data        string      `xml:"Data"` // Base64 encoded data.
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	WriteBlock_OUTPUT struct {
+		XMLName     xml.Name    `xml:"WriteBlock_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	GetGlobalStorageAttributes_OUTPUT struct {
+		XMLName                          xml.Name    `xml:"GetGlobalStorageAttributes_OUTPUT"`
+		TotalStorage                     int         `xml:"TotalStorage"`                     // The size of the storage in bytes.
+		TotalAllocatedStorage            int         `xml:"TotalAllocatedStorage"`            // The number of bytes allocated by all applications.
+		MaxPartnerStorage                int         `xml:"MaxPartnerStorage"`                // The number of bytes reserved for partner applications.
+		MaxNonPartnerTotalAllocationSize int         `xml:"MaxNonPartnerTotalAllocationSize"` // The number of bytes that non-partner applications can allocate together.
+		MaxFpaclEntries                  int         `xml:"MaxFpaclEntries"`                  // The maximum number of partner allocation entries.
+		MaxAslEntries                    int         `xml:"MaxAslEntries"`                    // The maximum number of registered applications.
+		MaxEaclEntries                   int         `xml:"MaxEaclEntries"`                   // The maximum number of enterprises.
+		MaxGroupsPerBlock                int         `xml:"MaxGroupsPerBlock"`                // The maximum number of permission groups of a block.
+		MaxMembersPerGroup               int         `xml:"MaxMembersPerGroup"`               // The maximum number of applications in a permission group.
+		ReturnValue                      ReturnValue `xml:"ReturnValue"`
+	}
+	SetGlobalStorageAttributes_OUTPUT struct {
+		XMLName     xml.Name    `xml:"SetGlobalStorageAttributes_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	AddStorageEaclEntry_OUTPUT struct {
+		XMLName     xml.Name    `xml:"AddStorageEaclEntry_OUTPUT"`
+		Handle      int         `xml:"Handle"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	EnumerateStorageEaclEntries_OUTPUT struct {
+		XMLName     xml.Name    `xml:"EnumerateStorageEaclEntries_OUTPUT"`
+		TotalCount  int         `xml:"TotalCount"`
+		HandleCount int         `xml:"HandleCount"`
+		Handles     []int       `xml:"Handles"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	GetStorageEaclEntry_OUTPUT struct {
+		XMLName        xml.Name    `xml:"GetStorageEaclEntry_OUTPUT"`
+		EnterpriseName string      `xml:"EnterpriseName"`
+		ReturnValue    ReturnValue `xml:"ReturnValue"`
+	}
+	RemoveStorageEaclEntry_OUTPUT struct {
+		XMLName     xml.Name    `xml:"RemoveStorageEaclEntry_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	AddStorageFpaclEntry_OUTPUT struct {
+		XMLName     xml.Name    `xml:"AddStorageFpaclEntry_OUTPUT"`
+		Handle      int         `xml:"Handle"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	EnumerateStorageAllocEntries_OUTPUT struct {
+		XMLName     xml.Name    `xml:"EnumerateStorageAllocEntries_OUTPUT"`
+		TotalCount  int         `xml:"TotalCount"`
+		HandleCount int         `xml:"HandleCount"`
+		Handles     []int       `xml:"Handles"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	GetStorageAllocEntry_OUTPUT struct {
+		XMLName             xml.Name            `xml:"GetStorageAllocEntry_OUTPUT"`
+		AttrType            AllocationEntryType `xml:"AttrType"`
+		ApplicationName     string              `xml:"ApplicationName"`
+		VendorName          string              `xml:"VendorName"`
+		IsPartner           bool                `xml:"IsPartner"`
+		TotalAllocationSize int                 `xml:"TotalAllocationSize"`
+		ReturnValue         ReturnValue         `xml:"ReturnValue"`
+	}
+	UpdateStorageFpaclEntry_OUTPUT struct {
+		XMLName     xml.Name    `xml:"UpdateStorageFpaclEntry_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	RemoveStorageFpaclEntry_OUTPUT struct {
+		XMLName     xml.Name    `xml:"RemoveStorageFpaclEntry_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+)
+
+// INPUTS
+// Request Types.
+type (
+	RegisterApplication_INPUT struct {
+		XMLName         xml.Name `xml:"h:RegisterApplication_INPUT"`
+		H               string   `xml:"xmlns:h,attr"`
+		CallerUUID      string   `xml:"h:CallerUUID"`
+		VendorName      string   `xml:"h:VendorName"`
+		ApplicationName string   `xml:"h:ApplicationName"`
+		EnterpriseName  string   `xml:"h:EnterpriseName"`
+	}
+	// SessionRequest is the input of the methods that only take the session handle.
+	SessionRequest struct {
+		XMLName       xml.Name
+		H             string `xml:"xmlns:h,attr"`
+		SessionHandle int    `xml:"h:SessionHandle"`
+	}
+	GetApplicationAttributes_INPUT struct {
+		XMLName       xml.Name `xml:"h:GetApplicationAttributes_INPUT"`
+		H             string   `xml:"xmlns:h,attr"`
+		SessionHandle int      `xml:"h:SessionHandle"`
+		Handle        int      `xml:"h:Handle"`
+	}
+	GetAllocatedBlocks_INPUT struct {
+		XMLName               xml.Name `xml:"h:GetAllocatedBlocks_INPUT"`
+		H                     string   `xml:"xmlns:h,attr"`
+		SessionHandle         int      `xml:"h:SessionHandle"`
+		BlockOwnerApplication int      `xml:"h:BlockOwnerApplication"`
+	}
+	AllocateBlock_INPUT struct {
+		XMLName        xml.Name `xml:"h:AllocateBlock_INPUT"`
+		H              string   `xml:"xmlns:h,attr"`
+		SessionHandle  int      `xml:"h:SessionHandle"`
+		BytesRequested int      `xml:"h:BytesRequested"`
+		BlockHidden    bool     `xml:"h:BlockHidden"`
+		BlockName      string   `xml:"h:BlockName"`
+	}
+	// BlockRequest is the input of the methods that take the session and block handles.
+	BlockRequest struct {
+		XMLName       xml.Name
+		H             string `xml:"xmlns:h,attr"`
+		SessionHandle int    `xml:"h:SessionHandle"`
+		BlockHandle   int    `xml:"h:BlockHandle"`
+	}
+	SetBlockAttributes_INPUT struct {
+		XMLName       xml.Name `xml:"h:SetBlockAttributes_INPUT"`
+		H             string   `xml:"xmlns:h,attr"`
+		SessionHandle int      `xml:"h:SessionHandle"`
+		BlockHandle   int      `xml:"h:BlockHandle"`
+		BlockSize     int      `xml:"h:BlockSize"`
+		BlockName     string   `xml:"h:BlockName"`
+		BlockHidden   bool     `xml:"h:BlockHidden"`
+	}
+	ReadBlock_INPUT struct {
+		XMLName       xml.Name `xml:"h:ReadBlock_INPUT"`
+		H             string   `xml:"xmlns:h,attr"`
+		SessionHandle int      `xml:"h:SessionHandle"`
+		BlockHandle   int      `xml:"h:BlockHandle"`
+		ByteOffset    int      `xml:"h:ByteOffset"`
+		ByteCount     int      `xml:"h:ByteCount"`
+	}
+	WriteBlock_INPUT struct {
+		XMLName       xml.Name `xml:"h:WriteBlock_INPUT"`
+		H             string   `xml:"xmlns:h,attr"`
+		SessionHandle int      `xml:"h:SessionHandle"`
+		BlockHandle   int      `xml:"h:BlockHandle"`
+		ByteOffset    int      `xml:"h:ByteOffset"`
+		Data          string   `xml:"h:Data"`
+	}
+	SetGlobalStorageAttributes_INPUT struct {
+		XMLName                          xml.Name `xml:"h:SetGlobalStorageAttributes_INPUT"`
+		H                                string   `xml:"xmlns:h,attr"`
+		MaxPartnerStorage                int      `xml:"h:MaxPartnerStorage"`
+		MaxNonPartnerTotalAllocationSize int      `xml:"h:MaxNonPartnerTotalAllocationSize"`
+	}
+	AddStorageEaclEntry_INPUT struct {
+		XMLName        xml.Name `xml:"h:AddStorageEaclEntry_INPUT"`
+		H              string   `xml:"xmlns:h,attr"`
+		EnterpriseName string   `xml:"h:EnterpriseName"`
+	}
+	// StartIndexRequest is the input of the methods that enumerate entries.
+	StartIndexRequest struct {
+		XMLName    xml.Name
+		H          string `xml:"xmlns:h,attr"`
+		StartIndex int    `xml:"h:StartIndex"`
+	}
+	// HandleRequest is the input of the methods that take the handle of an entry.
+	HandleRequest struct {
+		XMLName xml.Name
+		H       string `xml:"xmlns:h,attr"`
+		Handle  int    `xml:"h:Handle"`
+	}
+	// AddStorageFpaclEntry_INPUT reserves storage for a partner application or for the applications of a vendor.
+	AddStorageFpaclEntry_INPUT struct {
+		XMLName             xml.Name            `xml:"h:AddStorageFpaclEntry_INPUT"`
+		H                   string              `xml:"xmlns:h,attr"`
+		AttrType            AllocationEntryType `xml:"h:AttrType"`
+		ApplicationName     string              `xml:"h:ApplicationName"`
+		VendorName          string              `xml:"h:VendorName"`
+		IsPartner           bool                `xml:"h:IsPartner"`
+		TotalAllocationSize int                 `xml:"h:TotalAllocationSize"`
+	}
+	UpdateStorageFpaclEntry_INPUT struct {
+		XMLName           xml.Name `xml:"h:UpdateStorageFpaclEntry_INPUT"`
+		H                 string   `xml:"xmlns:h,attr"`
+		Handle            int      `xml:"h:Handle"`
+		NewAllocationSize int      `xml:"h:NewAllocationSize"`
+	}
+)
+
+type (
+	// ReturnValue is the completion status of a Third-Party Data Storage method.
+	ReturnValue int
+	// AllocationEntryType is whether a partner allocation entry applies to an application or to a vendor.
+	AllocationEntryType int
+)
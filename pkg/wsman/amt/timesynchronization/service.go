@@ -37,8 +37,8 @@ func NewTimeSynchronizationServiceWithClient(wsmanMessageCreator *message.WSManM
 // ValueMap={0, 1, 36, 38}
 //
 // Values={PT_STATUS_SUCCESS, PT_STATUS_INTERNAL_ERROR, PT_STATUS_INVALID_PARAMETER, PT_STATUS_FLASH_WRITE_LIMIT_EXCEEDED}.
-func (service Service) SetHighAccuracyTimeSynch(ta0, tm1, tm2 int64) (response Response, err error) {
-	header := service.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTTimeSynchronizationService, SetHighAccuracyTimeSynch), AMTTimeSynchronizationService, nil, "", "")
+func (service Service) SetHighAccuracyTimeSynch(ta0, tm1, tm2 int64, opts ...base.HeaderOption) (response Response, err error) {
+	header := service.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTTimeSynchronizationService, SetHighAccuracyTimeSynch), AMTTimeSynchronizationService, nil, "", "", opts...)
 	body := service.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(SetHighAccuracyTimeSynch), AMTTimeSynchronizationService, &SetHighAccuracyTimeSynch_INPUT{
 		H:   "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_TimeSynchronizationService",
 		Ta0: ta0,
@@ -65,8 +65,8 @@ func (service Service) SetHighAccuracyTimeSynch(ta0, tm1, tm2 int64) (response R
 }
 
 // GetLowAccuracyTimeSynch is used for reading the Intel® AMT device's internal clock.
-func (service Service) GetLowAccuracyTimeSynch() (response Response, err error) {
-	header := service.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTTimeSynchronizationService, GetLowAccuracyTimeSynch), AMTTimeSynchronizationService, nil, "", "")
+func (service Service) GetLowAccuracyTimeSynch(opts ...base.HeaderOption) (response Response, err error) {
+	header := service.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTTimeSynchronizationService, GetLowAccuracyTimeSynch), AMTTimeSynchronizationService, nil, "", "", opts...)
 	body := service.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(GetLowAccuracyTimeSynch), AMTTimeSynchronizationService, nil)
 	response = Response{
 		Message: &client.Message{
@@ -35,11 +35,11 @@ func NewTLSCredentialContextWithClient(wsmanMessageCreator *message.WSManMessage
 }
 
 // Delete removes the specified instance.
-func (credentialContext CredentialContext) Delete(handle string) (response Response, err error) {
+func (credentialContext CredentialContext) Delete(handle string, opts ...base.HeaderOption) (response Response, err error) {
 	selector := message.Selector{Name: "Name", Value: handle}
 	response = Response{
 		Message: &client.Message{
-			XMLInput: credentialContext.Base.Delete(selector),
+			XMLInput: credentialContext.Base.Delete(selector, opts...),
 		},
 	}
 	// send the message to AMT
@@ -57,8 +57,8 @@ func (credentialContext CredentialContext) Delete(handle string) (response Respo
 }
 
 // Creates a new instance of this class.
-func (credentialContext CredentialContext) Create(certHandle string) (response Response, err error) {
-	header := credentialContext.Base.WSManMessageCreator.CreateHeader(message.BaseActionsCreate, AMTTLSCredentialContext, nil, "", "")
+func (credentialContext CredentialContext) Create(certHandle string, opts ...base.HeaderOption) (response Response, err error) {
+	header := credentialContext.Base.WSManMessageCreator.CreateHeader(message.BaseActionsCreate, AMTTLSCredentialContext, nil, "", "", opts...)
 	body := fmt.Sprintf(`<Body><h:AMT_TLSCredentialContext xmlns:h="%sAMT_TLSCredentialContext"><h:ElementInContext><a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>%sAMT_PublicKeyCertificate</w:ResourceURI><w:SelectorSet><w:Selector Name="InstanceID">%s</w:Selector></w:SelectorSet></a:ReferenceParameters></h:ElementInContext><h:ElementProvidingContext><a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>%sAMT_TLSProtocolEndpointCollection</w:ResourceURI><w:SelectorSet><w:Selector Name="ElementName">TLSProtocolEndpointInstances Collection</w:Selector></w:SelectorSet></a:ReferenceParameters></h:ElementProvidingContext></h:AMT_TLSCredentialContext></Body>`, credentialContext.Base.WSManMessageCreator.ResourceURIBase, credentialContext.Base.WSManMessageCreator.ResourceURIBase, certHandle, credentialContext.Base.WSManMessageCreator.ResourceURIBase)
 	response = Response{
 		Message: &client.Message{
@@ -83,8 +83,8 @@ func (credentialContext CredentialContext) Create(certHandle string) (response R
 // Put overrides the generic Put because this method takes a cert handle string
 // (not a struct) and must craft a body containing two EPRs identifying the
 // PublicKeyCertificate and TLSProtocolEndpointCollection instances.
-func (credentialContext CredentialContext) Put(certHandle string) (response Response, err error) {
-	header := credentialContext.Base.WSManMessageCreator.CreateHeader(message.BaseActionsPut, AMTTLSCredentialContext, nil, "", "")
+func (credentialContext CredentialContext) Put(certHandle string, opts ...base.HeaderOption) (response Response, err error) {
+	header := credentialContext.Base.WSManMessageCreator.CreateHeader(message.BaseActionsPut, AMTTLSCredentialContext, nil, "", "", opts...)
 	body := fmt.Sprintf(`<Body><h:AMT_TLSCredentialContext xmlns:h="%sAMT_TLSCredentialContext"><h:ElementInContext><a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>%sAMT_PublicKeyCertificate</w:ResourceURI><w:SelectorSet><w:Selector Name="InstanceID">%s</w:Selector></w:SelectorSet></a:ReferenceParameters></h:ElementInContext><h:ElementProvidingContext><a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>%sAMT_TLSProtocolEndpointCollection</w:ResourceURI><w:SelectorSet><w:Selector Name="ElementName">TLSProtocolEndpointInstances Collection</w:Selector></w:SelectorSet></a:ReferenceParameters></h:ElementProvidingContext></h:AMT_TLSCredentialContext></Body>`, credentialContext.Base.WSManMessageCreator.ResourceURIBase, credentialContext.Base.WSManMessageCreator.ResourceURIBase, certHandle, credentialContext.Base.WSManMessageCreator.ResourceURIBase)
 	response = Response{
 		Message: &client.Message{
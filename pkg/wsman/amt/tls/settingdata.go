@@ -23,8 +23,8 @@ func NewTLSSettingDataWithClient(wsmanMessageCreator *message.WSManMessageCreato
 
 // Get retrieves the representation of the instance identified by the InstanceID
 // selector. Shadows the generic parameterless Get to preserve the public API.
-func (settingData SettingData) Get(instanceID string) (response Response, err error) {
-	return settingData.GetByInstanceID(instanceID)
+func (settingData SettingData) Get(instanceID string, opts ...base.HeaderOption) (response Response, err error) {
+	return settingData.GetByInstanceID(instanceID, opts...)
 }
 
 // Put changes properties of the selected instance.
@@ -39,7 +39,7 @@ func (settingData SettingData) Get(instanceID string) (response Response, err er
 // This method will not modify the flash ("Enabled" property) until setupandconfiguration.CommitChanges() is issued and performed successfully.
 // Overrides the generic Put because each TLS setting must be addressed by an
 // InstanceID selector, which the generic Put does not provide.
-func (settingData SettingData) Put(instanceID string, tlsSettingData SettingDataRequest) (response Response, err error) {
+func (settingData SettingData) Put(instanceID string, tlsSettingData SettingDataRequest, opts ...base.HeaderOption) (response Response, err error) {
 	tlsSettingData.H = fmt.Sprintf("%s%s", message.AMTSchema, AMTTLSSettingData)
 	selector := []message.Selector{{
 		Name:  "InstanceID",
@@ -47,7 +47,7 @@ func (settingData SettingData) Put(instanceID string, tlsSettingData SettingData
 	}}
 	response = Response{
 		Message: &client.Message{
-			XMLInput: settingData.Base.Put(tlsSettingData, true, selector),
+			XMLInput: settingData.Base.Put(tlsSettingData, true, selector, opts...),
 		},
 	}
 	// send the message to AMT
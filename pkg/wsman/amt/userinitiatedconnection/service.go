@@ -43,10 +43,10 @@ func NewUserInitiatedConnectionServiceWithClient(wsmanMessageCreator *message.WS
 // ValueMap={0, 1, 2, 3, 4, 5, 6, .., 4096, 4097, 4098, 4099, 4100..32767, 32768..65535}
 //
 // Values={Completed with No Error, Not Supported, Unknown or Unspecified Error, Cannot complete within Timeout Period, Failed, Invalid Parameter, In Use, DMTF Reserved, Method Parameters Checked - Job Started, Invalid State Transition, Use of Timeout Parameter Not Supported, Busy, Method Reserved, Vendor Specific}.
-func (service Service) RequestStateChange(requestedState RequestedState) (response Response, err error) {
+func (service Service) RequestStateChange(requestedState RequestedState, opts ...base.HeaderOption) (response Response, err error) {
 	response = Response{
 		Message: &client.Message{
-			XMLInput: service.Base.RequestStateChange(methods.RequestStateChange(AMTUserInitiatedConnectionService), int(requestedState)),
+			XMLInput: service.Base.RequestStateChange(methods.RequestStateChange(AMTUserInitiatedConnectionService), int(requestedState), opts...),
 		},
 	}
 	// send the message to AMT
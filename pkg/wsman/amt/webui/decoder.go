@@ -0,0 +1,65 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package webui
+
+const (
+	AMTWebUIService    string = "AMT_WebUIService"
+	RequestStateChange string = "RequestStateChange"
+	ValueNotFound      string = "Value not found in map"
+)
+
+const (
+	EnabledStateEnabled  EnabledState = 2 // The web UI listens on the AMT ports
+	EnabledStateDisabled EnabledState = 3 // The web UI is turned off
+)
+
+// enabledStateToString is a map of EnabledState values to their string representation.
+var enabledStateToString = map[EnabledState]string{
+	EnabledStateEnabled:  "Enabled",
+	EnabledStateDisabled: "Disabled",
+}
+
+// String returns the string representation of the EnabledState value.
+func (e EnabledState) String() string {
+	if value, exists := enabledStateToString[e]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+const (
+	RequestedStateEnabled  RequestedState = 2
+	RequestedStateDisabled RequestedState = 3
+)
+
+const (
+	ReturnValueCompletedWithNoError   ReturnValue = 0
+	ReturnValueNotSupported           ReturnValue = 1
+	ReturnValueUnknownOrUnspecified   ReturnValue = 2
+	ReturnValueFailed                 ReturnValue = 4
+	ReturnValueInvalidParameter       ReturnValue = 5
+	ReturnValueInvalidStateTransition ReturnValue = 4097
+)
+
+// returnValueToString is a map of ReturnValue values to their string representation.
+var returnValueToString = map[ReturnValue]string{
+	ReturnValueCompletedWithNoError:   "CompletedWithNoError",
+	ReturnValueNotSupported:           "NotSupported",
+	ReturnValueUnknownOrUnspecified:   "UnknownOrUnspecified",
+	ReturnValueFailed:                 "Failed",
+	ReturnValueInvalidParameter:       "InvalidParameter",
+	ReturnValueInvalidStateTransition: "InvalidStateTransition",
+}
+
+// String returns the string representation of the ReturnValue value.
+func (r ReturnValue) String() string {
+	if value, exists := returnValueToString[r]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
@@ -0,0 +1,31 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package webui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnabledState_String(t *testing.T) {
+	assert.Equal(t, "Enabled", EnabledStateEnabled.String())
+	assert.Equal(t, "Disabled", EnabledStateDisabled.String())
+	assert.Equal(t, ValueNotFound, EnabledState(99).String())
+}
+
+func TestReturnValue_String(t *testing.T) {
+	assert.Equal(t, "CompletedWithNoError", ReturnValueCompletedWithNoError.String())
+	assert.Equal(t, "InvalidStateTransition", ReturnValueInvalidStateTransition.String())
+	assert.Equal(t, ValueNotFound, ReturnValue(99).String())
+}
+
+func TestResponseMarshaling(t *testing.T) {
+	response := Response{Body: Body{GetResponse: WebUIResponse{EnabledState: EnabledStateDisabled}}}
+
+	assert.Contains(t, response.JSON(), `"EnabledState":3`)
+	assert.Contains(t, response.YAML(), "enabledstate: 3")
+}
@@ -0,0 +1,50 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package webui facilitates communication with Intel® AMT devices to enable or disable the built-in web UI, which is
+// served on the same ports as WS-Management.
+package webui
+
+import (
+	"encoding/xml"
+	"errors"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/methods"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/base"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewWebUIServiceWithClient instantiates a new Service.
+func NewWebUIServiceWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) Service {
+	return Service{
+		base.NewService[Response](wsmanMessageCreator, AMTWebUIService, client),
+	}
+}
+
+// RequestStateChange enables or disables the web UI.
+func (service Service) RequestStateChange(requestedState RequestedState, opts ...base.HeaderOption) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.Base.RequestStateChange(methods.GenerateAction(AMTWebUIService, RequestStateChange), int(requestedState), opts...),
+		},
+	}
+	// send the message to AMT
+	err = service.Base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	if response.Body.RequestStateChange_OUTPUT.ReturnValue != ReturnValueCompletedWithNoError {
+		err = errors.New("RequestStateChange failed with return code " + response.Body.RequestStateChange_OUTPUT.ReturnValue.String())
+	}
+
+	return response, err
+}
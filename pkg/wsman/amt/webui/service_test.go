@@ -0,0 +1,130 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package webui
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+func TestPositiveAMT_WebUIService(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/webui",
+	}
+	elementUnderTest := NewWebUIServiceWithClient(wsmanMessageCreator, &client)
+
+	expected := WebUIResponse{
+		XMLName:                 xml.Name{Space: message.AMTSchema + AMTWebUIService, Local: AMTWebUIService},
+		CreationClassName:       AMTWebUIService,
+		ElementName:             "Intel(r) AMT Web UI Service",
+		Name:                    "Intel(r) AMT Web UI Service",
+		SystemCreationClassName: "CIM_ComputerSystem",
+		SystemName:              "Intel(r) AMT",
+		EnabledState:            EnabledStateEnabled,
+		RequestedState:          2,
+	}
+
+	t.Run("amt_WebUIService Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			action       string
+			body         string
+			responseFunc func() (Response, error)
+			check        func(t *testing.T, body Body)
+		}{
+			{
+				"should create a valid AMT_WebUIService Get wsman message",
+				wsmantesting.Get,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get()
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, expected, body.GetResponse)
+				},
+			},
+			{
+				"should create a valid AMT_WebUIService Enumerate wsman message",
+				wsmantesting.Enumerate,
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, "D8000000-0000-0000-0000-000000000000", body.EnumerateResponse.EnumerationContext)
+				},
+			},
+			{
+				"should create a valid AMT_WebUIService Pull wsman message",
+				wsmantesting.Pull,
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, []WebUIResponse{expected}, body.PullResponse.WebUIItems)
+				},
+			},
+			{
+				"should create a valid AMT_WebUIService RequestStateChange wsman message",
+				message.AMTSchema + AMTWebUIService + "/" + RequestStateChange,
+				`<h:RequestStateChange_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_WebUIService"><h:RequestedState>3</h:RequestedState></h:RequestStateChange_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = RequestStateChange
+
+					return elementUnderTest.RequestStateChange(RequestedStateDisabled)
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, ReturnValueCompletedWithNoError, body.RequestStateChange_OUTPUT.ReturnValue)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, AMTWebUIService, test.action, "", test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				test.check(t, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeAMT_WebUIService(t *testing.T) {
+	wsmanMessageCreator := message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/webui",
+		CurrentMessage:   wsmantesting.CurrentMessageError,
+	}
+	elementUnderTest := NewWebUIServiceWithClient(wsmanMessageCreator, &client)
+
+	_, err := elementUnderTest.Get()
+	assert.Error(t, err)
+
+	_, err = elementUnderTest.RequestStateChange(RequestedStateDisabled)
+	assert.Error(t, err)
+}
@@ -0,0 +1,64 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package webui
+
+import (
+	"encoding/xml"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/base"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+)
+
+type Service struct {
+	base.WSManService[Response]
+}
+
+// OUTPUTS
+// Response Types.
+type (
+	Response struct {
+		*client.Message
+		XMLName xml.Name       `xml:"Envelope"`
+		Header  message.Header `xml:"Header"`
+		Body    Body           `xml:"Body"`
+	}
+	Body struct {
+		XMLName                   xml.Name `xml:"Body"`
+		GetResponse               WebUIResponse
+		RequestStateChange_OUTPUT RequestStateChange_OUTPUT
+		EnumerateResponse         common.EnumerateResponse
+		PullResponse              PullResponse
+	}
+	PullResponse struct {
+		XMLName    xml.Name        `xml:"PullResponse"`
+		WebUIItems []WebUIResponse `xml:"Items>AMT_WebUIService"`
+	}
+	WebUIResponse struct {
+		XMLName                 xml.Name     `xml:"AMT_WebUIService"`
+		CreationClassName       string       `xml:"CreationClassName,omitempty"`       // CreationClassName indicates the name of the class or the subclass used in the creation of an instance.
+		ElementName             string       `xml:"ElementName,omitempty"`             // A user-friendly name for the object.
+		Name                    string       `xml:"Name,omitempty"`                    // The Name property uniquely identifies the Service.
+		SystemCreationClassName string       `xml:"SystemCreationClassName,omitempty"` // The scoping System's CreationClassName.
+		SystemName              string       `xml:"SystemName,omitempty"`              // The scoping System's Name.
+		EnabledState            EnabledState `xml:"EnabledState"`                      // Whether the web UI is enabled.
+		RequestedState          int          `xml:"RequestedState"`                    // The last requested or desired state for the element.
+	}
+	RequestStateChange_OUTPUT struct {
+		XMLName     xml.Name    `xml:"RequestStateChange_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+)
+
+type (
+	// EnabledState is whether the web UI is enabled.
+	EnabledState int
+	// RequestedState is the state requested with RequestStateChange.
+	RequestedState int
+	// ReturnValue is the completion status of RequestStateChange.
+	ReturnValue int
+)
@@ -28,12 +28,12 @@ func NewWiFiPortConfigurationServiceWithClient(wsmanMessageCreator *message.WSMa
 
 // Put overrides the generic Put because it has a strongly-typed request
 // parameter that is passed by value.
-func (service Service) Put(wiFiPortConfigurationService WiFiPortConfigurationServiceRequest) (response Response, err error) {
+func (service Service) Put(wiFiPortConfigurationService WiFiPortConfigurationServiceRequest, opts ...base.HeaderOption) (response Response, err error) {
 	// wiFiPortConfigurationService.XMLSchema = "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_WiFiPortConfigurationService"
 	wiFiPortConfigurationService.H = fmt.Sprintf("%s%s", message.AMTSchema, AMTWiFiPortConfigurationService)
 	response = Response{
 		Message: &client.Message{
-			XMLInput: service.Base.Put(wiFiPortConfigurationService, false, nil),
+			XMLInput: service.Base.Put(wiFiPortConfigurationService, false, nil, opts...),
 		},
 	}
 
@@ -69,8 +69,8 @@ func (service Service) Put(wiFiPortConfigurationService WiFiPortConfigurationSer
 // ValueMap={0, 1, 2, 3, 4, .., 32768..65535}
 //
 // Values={Completed with No Error, Not Supported, Failed, Invalid Parameter, Invalid Reference, Method Reserved, Vendor Specific}.
-func (service Service) AddWiFiSettings(wifiEndpointSettings wifi.WiFiEndpointSettingsRequest, ieee8021xSettingsInput models.IEEE8021xSettings, wifiEndpoint, clientCredential, caCredential string) (response Response, err error) {
-	header := service.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTWiFiPortConfigurationService, AddWiFiSettings), AMTWiFiPortConfigurationService, nil, "", "")
+func (service Service) AddWiFiSettings(wifiEndpointSettings wifi.WiFiEndpointSettingsRequest, ieee8021xSettingsInput models.IEEE8021xSettings, wifiEndpoint, clientCredential, caCredential string, opts ...base.HeaderOption) (response Response, err error) {
+	header := service.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTWiFiPortConfigurationService, AddWiFiSettings), AMTWiFiPortConfigurationService, nil, "", "", opts...)
 	input := AddWiFiSettings_INPUT{
 		WifiEndpoint: WiFiEndpoint{
 			Address:             "/wsman",
@@ -130,8 +130,8 @@ func (service Service) AddWiFiSettings(wifiEndpointSettings wifi.WiFiEndpointSet
 //
 // Values={Completed with No Error, Not Supported, Failed, Invalid Parameter, Invalid Reference,
 // Method Reserved, Vendor Specific}.
-func (service Service) UpdateWiFiSettings(wifiEndpointSettings wifi.WiFiEndpointSettingsRequest, ieee8021xSettingsInput models.IEEE8021xSettings, clientCredential, caCredential string) (response Response, err error) {
-	header := service.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTWiFiPortConfigurationService, UpdateWiFiSettings), AMTWiFiPortConfigurationService, nil, "", "")
+func (service Service) UpdateWiFiSettings(wifiEndpointSettings wifi.WiFiEndpointSettingsRequest, ieee8021xSettingsInput models.IEEE8021xSettings, clientCredential, caCredential string, opts ...base.HeaderOption) (response Response, err error) {
+	header := service.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTWiFiPortConfigurationService, UpdateWiFiSettings), AMTWiFiPortConfigurationService, nil, "", "", opts...)
 	input := UpdateWiFiSettings_INPUT{
 		WiFiEndpointSettings: WiFiEndpointSettings{
 			Address:             "/wsman",
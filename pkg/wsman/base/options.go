@@ -0,0 +1,43 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package base
+
+import (
+	"time"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+)
+
+// HeaderOption configures the WS-Management header of a single request. Every service method accepts a trailing list
+// of header options, e.g.
+//
+//	response, err := m.AMT.GeneralSettings.Get(base.WithOperationTimeout(2*time.Minute), base.WithLocale("de-DE"))
+type HeaderOption = message.HeaderOption
+
+// WithOperationTimeout sets the OperationTimeout header, overriding the default of 60 seconds.
+func WithOperationTimeout(timeout time.Duration) HeaderOption {
+	return message.WithOperationTimeout(timeout)
+}
+
+// WithLocale sets the Locale header to the given language tag, e.g. "en-US".
+func WithLocale(locale string) HeaderOption {
+	return message.WithLocale(locale)
+}
+
+// WithMaxEnvelopeSize sets the MaxEnvelopeSize header, in octets.
+func WithMaxEnvelopeSize(size int) HeaderOption {
+	return message.WithMaxEnvelopeSize(size)
+}
+
+// WithOption adds a named entry to the OptionSet header. It can be repeated to add several options.
+func WithOption(name, value string) HeaderOption {
+	return message.WithOption(name, value)
+}
+
+// WithReplyTo sets the ReplyTo address, overriding the anonymous address.
+func WithReplyTo(address string) HeaderOption {
+	return message.WithReplyTo(address)
+}
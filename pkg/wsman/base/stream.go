@@ -0,0 +1,162 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package base
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// StreamResult describes the enumeration state that accompanies a streamed Enumerate or Pull response.
+type StreamResult struct {
+	EnumerationContext string
+	EndOfSequence      bool
+	Items              int
+}
+
+// DecodeItems reads a WS-Management response from r and calls fn for every element named itemName in the Body, such
+// as the instances in a Pull response or the EventRecords of an audit log response. Items are decoded one at a time as
+// tokens arrive, so memory use is bounded by the largest item rather than by the whole response. Decoding stops at
+// the first error returned by fn.
+func DecodeItems[I any](r io.Reader, itemName string, fn func(I) error) (StreamResult, error) {
+	var result StreamResult
+
+	decoder := xml.NewDecoder(r)
+	inBody := false
+
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return result, nil
+		}
+
+		if err != nil {
+			return result, fmt.Errorf("failed to decode response: %w", err)
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case start.Name.Local == "Body":
+			inBody = true
+		case !inBody:
+			continue
+		case start.Name.Local == "EnumerationContext":
+			if err := decoder.DecodeElement(&result.EnumerationContext, &start); err != nil {
+				return result, fmt.Errorf("failed to decode enumeration context: %w", err)
+			}
+		case start.Name.Local == "EndOfSequence":
+			result.EndOfSequence = true
+		case start.Name.Local == itemName:
+			var item I
+
+			if err := decoder.DecodeElement(&item, &start); err != nil {
+				return result, fmt.Errorf("failed to decode %s: %w", itemName, err)
+			}
+
+			result.Items++
+
+			if err := fn(item); err != nil {
+				return result, err
+			}
+		}
+	}
+}
+
+// PullItems sends a Pull request for the service class and streams every returned element named itemName to fn
+// instead of unmarshalling the whole response. The returned StreamResult holds the context for the next Pull.
+func PullItems[T, I any](s WSManService[T], enumerationContext, itemName string, fn func(I) error, opts ...HeaderOption) (StreamResult, error) {
+	return streamItems(&s.Base, s.Base.Pull(enumerationContext, opts...), itemName, fn)
+}
+
+// EachItem enumerates the instances of the service class and streams them to fn, pulling until the end of the
+// sequence is reached.
+func EachItem[T, I any](s WSManService[T], itemName string, fn func(I) error, opts ...HeaderOption) error {
+	result, err := streamItems[I](&s.Base, s.Base.Enumerate(opts...), itemName, nil)
+	if err != nil {
+		return err
+	}
+
+	return pullRemaining(s, result, itemName, fn, opts)
+}
+
+// EachAssociatedItem enumerates the instances of the service class associated with the objectClass instance
+// identified by selector and streams them to fn, pulling until the end of the sequence is reached.
+func EachAssociatedItem[T, I any](s WSManService[T], objectClass string, selector message.Selector, associationClass, itemName string, fn func(I) error, opts ...HeaderOption) error {
+	result, err := streamItems[I](&s.Base, s.Base.EnumerateAssociated(objectClass, selector, associationClass, opts...), itemName, nil)
+	if err != nil {
+		return err
+	}
+
+	return pullRemaining(s, result, itemName, fn, opts)
+}
+
+func pullRemaining[T, I any](s WSManService[T], result StreamResult, itemName string, fn func(I) error, opts []HeaderOption) (err error) {
+	for result.EnumerationContext != "" && !result.EndOfSequence {
+		result, err = PullItems(s, result.EnumerationContext, itemName, fn, opts...)
+		if err != nil {
+			return err
+		}
+
+		// guard against devices that keep returning an empty batch without ending the sequence
+		if result.Items == 0 {
+			break
+		}
+	}
+
+	return nil
+}
+
+func streamItems[I any](b *message.Base, xmlInput, itemName string, fn func(I) error) (StreamResult, error) {
+	body, err := b.ExecuteReader(xmlInput)
+	if err != nil {
+		return StreamResult{}, err
+	}
+
+	defer body.Close()
+
+	if fn == nil {
+		fn = func(I) error { return nil }
+	}
+
+	return DecodeItems(body, itemName, fn)
+}
+
+// executeDecode sends msg and decodes the response into out as it is read, instead of unmarshalling a second copy of
+// the buffered response. The response text is still kept in msg.XMLOutput.
+func executeDecode[T any](b *message.Base, msg *client.Message, out *T) error {
+	body, err := b.ExecuteReader(msg.XMLInput)
+	if err != nil {
+		return err
+	}
+
+	defer body.Close()
+
+	var output strings.Builder
+
+	reader := io.TeeReader(body, &output)
+	decodeErr := xml.NewDecoder(reader).Decode(out)
+
+	// keep whatever follows the envelope, as Execute would
+	_, copyErr := io.Copy(io.Discard, reader)
+
+	msg.XMLOutput = output.String()
+
+	if decodeErr != nil {
+		return decodeErr
+	}
+
+	return copyErr
+}
@@ -0,0 +1,173 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package base
+
+import (
+	"crypto/tls"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+)
+
+const (
+	streamEnumerateResponse = `<a:Envelope xmlns:a="http://www.w3.org/2003/05/soap-envelope" xmlns:g="http://schemas.xmlsoap.org/ws/2004/09/enumeration"><a:Header><g:EnumerationContext>header</g:EnumerationContext></a:Header><a:Body><g:EnumerateResponse><g:EnumerationContext>ctx-1</g:EnumerationContext></g:EnumerateResponse></a:Body></a:Envelope>`
+	streamPullResponse      = `<a:Envelope xmlns:a="http://www.w3.org/2003/05/soap-envelope" xmlns:g="http://schemas.xmlsoap.org/ws/2004/09/enumeration" xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_TestClass"><a:Body><g:PullResponse><g:EnumerationContext>ctx-2</g:EnumerationContext><g:Items><h:AMT_TestClass><h:Name>one</h:Name></h:AMT_TestClass><h:AMT_TestClass><h:Name>two</h:Name></h:AMT_TestClass></g:Items></g:PullResponse></a:Body></a:Envelope>`
+	streamLastPullResponse  = `<a:Envelope xmlns:a="http://www.w3.org/2003/05/soap-envelope" xmlns:g="http://schemas.xmlsoap.org/ws/2004/09/enumeration" xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_TestClass"><a:Body><g:PullResponse><g:Items><h:AMT_TestClass><h:Name>three</h:Name></h:AMT_TestClass></g:Items><g:EndOfSequence></g:EndOfSequence></g:PullResponse></a:Body></a:Envelope>`
+)
+
+type testItem struct {
+	Name string `xml:"Name"`
+}
+
+// sequenceClient answers each Post with the next canned response.
+type sequenceClient struct {
+	responses []string
+	requests  []string
+	err       error
+}
+
+func (c *sequenceClient) Post(msg string) ([]byte, error) {
+	c.requests = append(c.requests, msg)
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	response := c.responses[0]
+	c.responses = c.responses[1:]
+
+	return []byte(response), nil
+}
+func (c *sequenceClient) Send(data []byte) error                          { return nil }
+func (c *sequenceClient) Receive() ([]byte, error)                        { return nil, nil }
+func (c *sequenceClient) CloseConnection() error                          { return nil }
+func (c *sequenceClient) Connect() error                                  { return nil }
+func (c *sequenceClient) IsAuthenticated() bool                           { return true }
+func (c *sequenceClient) GetServerCertificate() (*tls.Certificate, error) { return nil, nil }
+
+func TestDecodeItems(t *testing.T) {
+	var names []string
+
+	result, err := DecodeItems(strings.NewReader(streamPullResponse), "AMT_TestClass", func(item testItem) error {
+		names = append(names, item.Name)
+
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"one", "two"}, names)
+	assert.Equal(t, StreamResult{EnumerationContext: "ctx-2", Items: 2}, result)
+
+	result, err = DecodeItems(strings.NewReader(streamLastPullResponse), "AMT_TestClass", func(testItem) error { return nil })
+	require.NoError(t, err)
+	assert.Equal(t, StreamResult{EndOfSequence: true, Items: 1}, result)
+
+	// elements in the header are ignored
+	result, err = DecodeItems(strings.NewReader(streamEnumerateResponse), "AMT_TestClass", func(testItem) error { return nil })
+	require.NoError(t, err)
+	assert.Equal(t, StreamResult{EnumerationContext: "ctx-1"}, result)
+}
+
+func TestDecodeItemsErrors(t *testing.T) {
+	errStop := errors.New("stop")
+
+	result, err := DecodeItems(strings.NewReader(streamPullResponse), "AMT_TestClass", func(testItem) error { return errStop })
+	assert.ErrorIs(t, err, errStop)
+	assert.Equal(t, 1, result.Items)
+
+	_, err = DecodeItems(strings.NewReader(`<Envelope><Body><Items>`), "AMT_TestClass", func(testItem) error { return nil })
+	assert.Error(t, err)
+
+	_, err = DecodeItems(strings.NewReader(`<Envelope><Body><EnumerationContext><a></EnumerationContext></Body></Envelope>`), "AMT_TestClass", func(testItem) error { return nil })
+	assert.Error(t, err)
+
+	_, err = DecodeItems(strings.NewReader(`<Envelope><Body><AMT_TestClass><Name>x</AMT_TestClass></Body></Envelope>`), "AMT_TestClass", func(testItem) error { return nil })
+	assert.Error(t, err)
+}
+
+func TestEachItem(t *testing.T) {
+	wsclient := &sequenceClient{responses: []string{streamEnumerateResponse, streamPullResponse, streamLastPullResponse}}
+	service := NewService[testResponseType](message.NewWSManMessageCreator(message.AMTSchema), "AMT_TestClass", wsclient)
+
+	var names []string
+
+	err := EachItem(service, "AMT_TestClass", func(item testItem) error {
+		names = append(names, item.Name)
+
+		return nil
+	}, WithLocale("en-US"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"one", "two", "three"}, names)
+	require.Len(t, wsclient.requests, 3)
+	assert.Contains(t, wsclient.requests[1], "<EnumerationContext>ctx-1</EnumerationContext>")
+	assert.Contains(t, wsclient.requests[2], "<EnumerationContext>ctx-2</EnumerationContext>")
+	assert.Contains(t, wsclient.requests[2], `<w:Locale xml:lang="en-US" />`)
+}
+
+func TestEachAssociatedItem(t *testing.T) {
+	wsclient := &sequenceClient{responses: []string{streamEnumerateResponse, streamPullResponse, streamLastPullResponse}}
+	service := NewService[testResponseType](message.NewWSManMessageCreator(message.AMTSchema), "AMT_TestClass", wsclient)
+
+	var names []string
+
+	err := EachAssociatedItem(service, "AMT_OwnerClass", message.Selector{Name: "InstanceID", Value: "owner"}, "", "AMT_TestClass", func(item testItem) error {
+		names = append(names, item.Name)
+
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"one", "two", "three"}, names)
+	require.Len(t, wsclient.requests, 3)
+	assert.Contains(t, wsclient.requests[0], `<w:Selector Name="InstanceID">owner</w:Selector></w:SelectorSet></a:ReferenceParameters></b:Object><b:ResultClassName>AMT_TestClass</b:ResultClassName>`)
+
+	service = NewService[testResponseType](message.NewWSManMessageCreator(message.AMTSchema), "AMT_TestClass", &sequenceClient{err: errPost})
+
+	err = EachAssociatedItem(service, "AMT_OwnerClass", message.Selector{Name: "InstanceID", Value: "owner"}, "", "AMT_TestClass", func(testItem) error { return nil })
+	assert.ErrorIs(t, err, errPost)
+}
+
+func TestEachItemStopsOnEmptyBatch(t *testing.T) {
+	emptyPull := strings.Replace(streamPullResponse, "<h:AMT_TestClass><h:Name>one</h:Name></h:AMT_TestClass><h:AMT_TestClass><h:Name>two</h:Name></h:AMT_TestClass>", "", 1)
+	wsclient := &sequenceClient{responses: []string{streamEnumerateResponse, emptyPull}}
+	service := NewService[testResponseType](message.NewWSManMessageCreator(message.AMTSchema), "AMT_TestClass", wsclient)
+
+	err := EachItem(service, "AMT_TestClass", func(testItem) error { return nil })
+	assert.NoError(t, err)
+	assert.Len(t, wsclient.requests, 2)
+}
+
+func TestEachItemErrors(t *testing.T) {
+	service := NewService[testResponseType](message.NewWSManMessageCreator(message.AMTSchema), "AMT_TestClass", &sequenceClient{err: errPost})
+
+	err := EachItem(service, "AMT_TestClass", func(testItem) error { return nil })
+	assert.ErrorIs(t, err, errPost)
+
+	wsclient := &sequenceClient{responses: []string{streamEnumerateResponse, `<Envelope><Body><Items>`}}
+	service = NewService[testResponseType](message.NewWSManMessageCreator(message.AMTSchema), "AMT_TestClass", wsclient)
+
+	err = EachItem(service, "AMT_TestClass", func(testItem) error { return nil })
+	assert.Error(t, err)
+}
+
+func TestPullItems(t *testing.T) {
+	wsclient := &sequenceClient{responses: []string{streamLastPullResponse}}
+	service := NewService[testResponseType](message.NewWSManMessageCreator(message.AMTSchema), "AMT_TestClass", wsclient)
+
+	var items []testItem
+
+	result, err := PullItems(service, "ctx-2", "AMT_TestClass", func(item testItem) error {
+		items = append(items, item)
+
+		return nil
+	})
+	require.NoError(t, err)
+	assert.True(t, result.EndOfSequence)
+	assert.Equal(t, []testItem{{Name: "three"}}, items)
+}
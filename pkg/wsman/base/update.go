@@ -0,0 +1,109 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package base
+
+import (
+	"errors"
+	"reflect"
+)
+
+// ErrUpdateConflict is returned by Update when the instance changed between the Get it started from and the Put, which
+// means another client modified it in between.
+var ErrUpdateConflict = errors.New("instance was modified concurrently")
+
+// Conversion declares how a setting-data class is read back into the request type written with Put. Every package
+// whose class is updated through Update declares one.
+type Conversion[T, Req any] struct {
+	// ToRequest copies the writable properties of a Get response into a Put request.
+	ToRequest func(T) Req
+	// WriteOnly clears the request properties that the firmware never returns, such as passwords, so they are ignored
+	// when the two reads of the instance are compared. It may be nil.
+	WriteOnly func(*Req)
+	// Validate checks the request after mutate and before it is written, so a request that would wipe a write-only
+	// property is rejected rather than sent. It may be nil.
+	Validate func(*Req) error
+}
+
+// UpdateOption configures a single Update call.
+type UpdateOption func(*updateOptions)
+
+type updateOptions struct {
+	retries int
+	headers []HeaderOption
+}
+
+// WithUpdateRetries makes Update start over from a fresh Get up to retries times when a concurrent modification is
+// detected, instead of returning ErrUpdateConflict straight away.
+func WithUpdateRetries(retries int) UpdateOption {
+	return func(o *updateOptions) {
+		o.retries = retries
+	}
+}
+
+// WithUpdateHeaderOptions applies header options to every request sent by Update.
+func WithUpdateHeaderOptions(opts ...HeaderOption) UpdateOption {
+	return func(o *updateOptions) {
+		o.headers = append(o.headers, opts...)
+	}
+}
+
+// Update performs a read-modify-write of the service's instance. It reads the current instance, converts it into the
+// request type, applies mutate and writes the whole request back, so properties the caller did not touch keep their
+// values. Just before the Put, the instance is read again and compared with the first read; a difference is reported
+// as ErrUpdateConflict, with the instance read last, unless retries are allowed with WithUpdateRetries. The instance
+// is not compared again after the Put, as the firmware may normalise the values written. The Put response is
+// returned.
+func Update[T, Req any](s WSManService[T], conversion Conversion[T, Req], mutate func(*Req), opts ...UpdateOption) (T, error) {
+	var options updateOptions
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	current, err := s.Get(options.headers...)
+	if err != nil {
+		return current, err
+	}
+
+	for attempt := 0; ; attempt++ {
+		request := conversion.ToRequest(current)
+		mutate(&request)
+
+		if conversion.Validate != nil {
+			if err = conversion.Validate(&request); err != nil {
+				return current, err
+			}
+		}
+
+		latest, err := s.Get(options.headers...)
+		if err != nil {
+			return latest, err
+		}
+
+		if unchanged(conversion, current, latest) {
+			return s.Put(&request, options.headers...)
+		}
+
+		if attempt >= options.retries {
+			return latest, ErrUpdateConflict
+		}
+
+		// start over from the instance read last
+		current = latest
+	}
+}
+
+// unchanged reports whether two reads of an instance have the same writable properties, ignoring write-only ones.
+func unchanged[T, Req any](conversion Conversion[T, Req], first, second T) bool {
+	a, b := conversion.ToRequest(first), conversion.ToRequest(second)
+
+	if conversion.WriteOnly != nil {
+		conversion.WriteOnly(&a)
+		conversion.WriteOnly(&b)
+	}
+
+	return reflect.DeepEqual(a, b)
+}
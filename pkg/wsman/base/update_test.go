@@ -0,0 +1,115 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package base
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+)
+
+func testInstance(name string) string {
+	return `<a:Envelope xmlns:a="http://www.w3.org/2003/05/soap-envelope" xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_TestClass"><a:Body><h:AMT_TestClass><h:Name>` + name + `</h:Name></h:AMT_TestClass></a:Body></a:Envelope>`
+}
+
+var testConversion = Conversion[testResponseType, testRequest]{
+	ToRequest: func(response testResponseType) testRequest {
+		return testRequest{Name: response.Body.TestClass.Name}
+	},
+}
+
+func TestUpdate(t *testing.T) {
+	// the firmware normalises the value written, which is not a conflict
+	wsclient := &sequenceClient{responses: []string{testInstance("old"), testInstance("old"), testInstance("NEW")}}
+	service := NewService[testResponseType](message.NewWSManMessageCreator(message.AMTSchema), "AMT_TestClass", wsclient)
+
+	response, err := Update(service, testConversion, func(request *testRequest) {
+		assert.Equal(t, "old", request.Name)
+		request.Name = "new"
+	}, WithUpdateHeaderOptions(WithLocale("en-US")))
+	require.NoError(t, err)
+	assert.Equal(t, "NEW", response.Body.TestClass.Name)
+	require.Len(t, wsclient.requests, 3)
+	assert.Contains(t, wsclient.requests[2], `<h:AMT_TestClass xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_TestClass"><h:Name>new</h:Name></h:AMT_TestClass>`)
+
+	for _, request := range wsclient.requests {
+		assert.Contains(t, request, `<w:Locale xml:lang="en-US" />`)
+	}
+}
+
+func TestUpdateConflict(t *testing.T) {
+	mutate := func(request *testRequest) { request.Name += "+" }
+
+	// the instance changes between the Get and the Put
+	wsclient := &sequenceClient{responses: []string{testInstance("a"), testInstance("b")}}
+	service := NewService[testResponseType](message.NewWSManMessageCreator(message.AMTSchema), "AMT_TestClass", wsclient)
+
+	response, err := Update(service, testConversion, mutate)
+	assert.ErrorIs(t, err, ErrUpdateConflict)
+	assert.Equal(t, "b", response.Body.TestClass.Name)
+	// nothing is written
+	assert.Len(t, wsclient.requests, 2)
+
+	wsclient = &sequenceClient{responses: []string{testInstance("a"), testInstance("b"), testInstance("b"), testInstance("b+")}}
+	service = NewService[testResponseType](message.NewWSManMessageCreator(message.AMTSchema), "AMT_TestClass", wsclient)
+
+	response, err = Update(service, testConversion, mutate, WithUpdateRetries(1))
+	require.NoError(t, err)
+	assert.Equal(t, "b+", response.Body.TestClass.Name)
+	require.Len(t, wsclient.requests, 4)
+	assert.Contains(t, wsclient.requests[3], `<h:Name>b+</h:Name>`)
+}
+
+func TestUpdateWriteOnly(t *testing.T) {
+	conversion := testConversion
+	conversion.WriteOnly = func(request *testRequest) { request.Name = "" }
+
+	// write-only properties are ignored when the two reads are compared
+	wsclient := &sequenceClient{responses: []string{testInstance("a"), testInstance("b"), testInstance("")}}
+	service := NewService[testResponseType](message.NewWSManMessageCreator(message.AMTSchema), "AMT_TestClass", wsclient)
+
+	_, err := Update(service, conversion, func(request *testRequest) { request.Name = "secret" })
+	require.NoError(t, err)
+	assert.Contains(t, wsclient.requests[2], `<h:Name>secret</h:Name>`)
+}
+
+func TestUpdateValidate(t *testing.T) {
+	errEmptyName := errors.New("empty name")
+	conversion := testConversion
+	conversion.Validate = func(request *testRequest) error {
+		if request.Name == "" {
+			return errEmptyName
+		}
+
+		return nil
+	}
+
+	wsclient := &sequenceClient{responses: []string{testInstance("a")}}
+	service := NewService[testResponseType](message.NewWSManMessageCreator(message.AMTSchema), "AMT_TestClass", wsclient)
+
+	response, err := Update(service, conversion, func(request *testRequest) { request.Name = "" })
+	assert.ErrorIs(t, err, errEmptyName)
+	assert.Equal(t, "a", response.Body.TestClass.Name)
+	// nothing is written
+	assert.Len(t, wsclient.requests, 1)
+}
+
+func TestUpdateErrors(t *testing.T) {
+	service := NewService[testResponseType](message.NewWSManMessageCreator(message.AMTSchema), "AMT_TestClass", &sequenceClient{err: errPost})
+
+	_, err := Update(service, testConversion, func(*testRequest) {})
+	assert.ErrorIs(t, err, errPost)
+
+	wsclient := &sequenceClient{responses: []string{testInstance("a"), `<Envelope>`}}
+	service = NewService[testResponseType](message.NewWSManMessageCreator(message.AMTSchema), "AMT_TestClass", wsclient)
+
+	_, err = Update(service, testConversion, func(*testRequest) {})
+	assert.Error(t, err)
+}
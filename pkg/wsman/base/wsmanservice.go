@@ -24,14 +24,14 @@ func NewService[T any](creator *message.WSManMessageCreator, resourceURI string,
 	}
 }
 
-func (s WSManService[T]) Get() (T, error) {
-	return s.getBySelector(nil)
+func (s WSManService[T]) Get(opts ...HeaderOption) (T, error) {
+	return s.getBySelector(nil, opts)
 }
 
-func (s WSManService[T]) getBySelector(selector *message.Selector) (T, error) {
+func (s WSManService[T]) getBySelector(selector *message.Selector, opts []HeaderOption) (T, error) {
 	var out T
 
-	msg := &client.Message{XMLInput: s.Base.Get(selector)}
+	msg := &client.Message{XMLInput: s.Base.Get(selector, opts...)}
 
 	injectMessage(&out, msg)
 
@@ -48,28 +48,28 @@ func (s WSManService[T]) getBySelector(selector *message.Selector) (T, error) {
 	return out, nil
 }
 
-func (s WSManService[T]) GetByName(name string) (T, error) {
+func (s WSManService[T]) GetByName(name string, opts ...HeaderOption) (T, error) {
 	selector := &message.Selector{
 		Name:  "Name",
 		Value: name,
 	}
 
-	return s.getBySelector(selector)
+	return s.getBySelector(selector, opts)
 }
 
-func (s WSManService[T]) GetByInstanceID(name string) (T, error) {
+func (s WSManService[T]) GetByInstanceID(name string, opts ...HeaderOption) (T, error) {
 	selector := &message.Selector{
 		Name:  "InstanceID",
 		Value: name,
 	}
 
-	return s.getBySelector(selector)
+	return s.getBySelector(selector, opts)
 }
 
-func (s WSManService[T]) Enumerate() (T, error) {
+func (s WSManService[T]) Enumerate(opts ...HeaderOption) (T, error) {
 	var out T
 
-	msg := &client.Message{XMLInput: s.Base.Enumerate()}
+	msg := &client.Message{XMLInput: s.Base.Enumerate(opts...)}
 
 	injectMessage(&out, msg)
 
@@ -86,10 +86,12 @@ func (s WSManService[T]) Enumerate() (T, error) {
 	return out, nil
 }
 
-func (s WSManService[T]) Pull(ctx string) (T, error) {
+// EnumerateAssociated starts an enumeration of the instances of this class associated with the objectClass instance
+// identified by selector. Follow it with Pull to read the instances.
+func (s WSManService[T]) EnumerateAssociated(objectClass string, selector message.Selector, associationClass string, opts ...HeaderOption) (T, error) {
 	var out T
 
-	msg := &client.Message{XMLInput: s.Base.Pull(ctx)}
+	msg := &client.Message{XMLInput: s.Base.EnumerateAssociated(objectClass, selector, associationClass, opts...)}
 
 	injectMessage(&out, msg)
 
@@ -106,12 +108,30 @@ func (s WSManService[T]) Pull(ctx string) (T, error) {
 	return out, nil
 }
 
-func (s WSManService[T]) Put(request any) (T, error) {
+// Pull reads the next batch of an enumeration. The response is decoded as it is read from the client; use PullItems
+// or EachItem to avoid keeping the whole batch in memory.
+func (s WSManService[T]) Pull(ctx string, opts ...HeaderOption) (T, error) {
+	var out T
+
+	msg := &client.Message{XMLInput: s.Base.Pull(ctx, opts...)}
+
+	injectMessage(&out, msg)
+
+	if err := executeDecode(&s.Base, msg, &out); err != nil {
+		return out, err
+	}
+
+	injectMessage(&out, msg)
+
+	return out, nil
+}
+
+func (s WSManService[T]) Put(request any, opts ...HeaderOption) (T, error) {
 	var out T
 
 	injectNamespace(request, s.Base.ClassName)
 
-	msg := &client.Message{XMLInput: s.Base.Put(request, false, nil)}
+	msg := &client.Message{XMLInput: s.Base.Put(request, false, nil, opts...)}
 
 	injectMessage(&out, msg)
 
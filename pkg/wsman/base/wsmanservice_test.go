@@ -0,0 +1,141 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package base
+
+import (
+	"crypto/tls"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+const testResponse = `<a:Envelope xmlns:a="http://www.w3.org/2003/05/soap-envelope" xmlns:g="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_TestClass"><a:Body><g:AMT_TestClass><g:Name>test</g:Name></g:AMT_TestClass></a:Body></a:Envelope>`
+
+var errPost = errors.New("post failed")
+
+type recordingClient struct {
+	requests []string
+	err      error
+}
+
+func (c *recordingClient) Post(msg string) ([]byte, error) {
+	c.requests = append(c.requests, msg)
+
+	return []byte(testResponse), c.err
+}
+func (c *recordingClient) Send(data []byte) error                          { return nil }
+func (c *recordingClient) Receive() ([]byte, error)                        { return nil, nil }
+func (c *recordingClient) CloseConnection() error                          { return nil }
+func (c *recordingClient) Connect() error                                  { return nil }
+func (c *recordingClient) IsAuthenticated() bool                           { return true }
+func (c *recordingClient) GetServerCertificate() (*tls.Certificate, error) { return nil, nil }
+
+// streamingClient answers PostReader with the body of a recordingClient.
+type streamingClient struct {
+	recordingClient
+
+	streams int
+}
+
+func (c *streamingClient) PostReader(msg string) (io.ReadCloser, error) {
+	c.streams++
+
+	return io.NopCloser(strings.NewReader(testResponse + "\n")), nil
+}
+
+type testResponseType struct {
+	*client.Message
+	XMLName xml.Name `xml:"Envelope"`
+	Body    struct {
+		TestClass struct {
+			Name string `xml:"Name"`
+		} `xml:"AMT_TestClass"`
+	} `xml:"Body"`
+}
+
+type testRequest struct {
+	XMLName xml.Name `xml:"h:AMT_TestClass"`
+	H       string   `xml:"xmlns:h,attr"`
+	Name    string   `xml:"h:Name"`
+}
+
+func TestWSManServiceHeaderOptions(t *testing.T) {
+	wsclient := &recordingClient{}
+	service := NewService[testResponseType](message.NewWSManMessageCreator(message.AMTSchema), "AMT_TestClass", wsclient)
+	opts := []HeaderOption{
+		WithOperationTimeout(90 * time.Second),
+		WithLocale("en-US"),
+		WithMaxEnvelopeSize(153600),
+		WithOption("IncludeInherited", "true"),
+		WithReplyTo("http://example.com/reply"),
+	}
+	calls := map[string]func() (testResponseType, error){
+		"Get":             func() (testResponseType, error) { return service.Get(opts...) },
+		"GetByName":       func() (testResponseType, error) { return service.GetByName("test", opts...) },
+		"GetByInstanceID": func() (testResponseType, error) { return service.GetByInstanceID("test", opts...) },
+		"Enumerate":       func() (testResponseType, error) { return service.Enumerate(opts...) },
+		"Pull":            func() (testResponseType, error) { return service.Pull("context", opts...) },
+		"Put":             func() (testResponseType, error) { return service.Put(&testRequest{Name: "test"}, opts...) },
+	}
+
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			response, err := call()
+			assert.NoError(t, err)
+			assert.Equal(t, "test", response.Body.TestClass.Name)
+			assert.Equal(t, wsclient.requests[len(wsclient.requests)-1], response.XMLInput)
+			assert.Contains(t, response.XMLInput, `<a:ReplyTo><a:Address>http://example.com/reply</a:Address></a:ReplyTo><w:OperationTimeout>PT90S</w:OperationTimeout><w:MaxEnvelopeSize>153600</w:MaxEnvelopeSize><w:Locale xml:lang="en-US" /><w:OptionSet><w:Option Name="IncludeInherited">true</w:Option></w:OptionSet>`)
+		})
+	}
+}
+
+func TestWSManServicePullStreams(t *testing.T) {
+	wsclient := &streamingClient{}
+	service := NewService[testResponseType](message.NewWSManMessageCreator(message.AMTSchema), "AMT_TestClass", wsclient)
+
+	response, err := service.Pull("context")
+	require.NoError(t, err)
+	assert.Equal(t, "test", response.Body.TestClass.Name)
+	assert.Equal(t, testResponse+"\n", response.XMLOutput)
+	assert.Contains(t, response.XMLInput, "<EnumerationContext>context</EnumerationContext>")
+	assert.Equal(t, 1, wsclient.streams)
+	assert.Empty(t, wsclient.requests)
+}
+
+func TestWSManServicePut(t *testing.T) {
+	service := NewService[testResponseType](message.NewWSManMessageCreator(message.AMTSchema), "AMT_TestClass", &recordingClient{})
+	request := &testRequest{Name: "test"}
+
+	response, err := service.Put(request)
+	assert.NoError(t, err)
+	assert.Equal(t, message.AMTSchema+"AMT_TestClass", request.H)
+	assert.Contains(t, response.XMLInput, `<Body><h:AMT_TestClass xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_TestClass"><h:Name>test</h:Name></h:AMT_TestClass></Body>`)
+}
+
+func TestWSManServiceErrors(t *testing.T) {
+	service := NewService[testResponseType](message.NewWSManMessageCreator(message.AMTSchema), "AMT_TestClass", &recordingClient{err: errPost})
+
+	_, err := service.Get()
+	assert.ErrorIs(t, err, errPost)
+
+	_, err = service.Enumerate()
+	assert.ErrorIs(t, err, errPost)
+
+	_, err = service.Pull("context")
+	assert.ErrorIs(t, err, errPost)
+
+	_, err = service.Put(&testRequest{})
+	assert.ErrorIs(t, err, errPost)
+}
@@ -0,0 +1,71 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package wsman
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/base"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/boot"
+)
+
+// SetNextBoot makes the device boot once from the first boot source of the kind found in CIM_BootSourceSetting. The
+// boot order of the AMT boot configuration is set to that source and the configuration is assigned to the next boot.
+// The boot source is returned.
+func (m Messages) SetNextBoot(kind boot.SourceKind, opts ...base.HeaderOption) (boot.BootSource, error) {
+	catalogue, err := m.CIM.BootSourceSetting.Catalogue(opts...)
+	if err != nil {
+		return boot.BootSource{}, fmt.Errorf("failed to read the boot sources: %w", err)
+	}
+
+	source, ok := catalogue.Find(kind)
+	if !ok {
+		return boot.BootSource{}, fmt.Errorf("%w: %s", boot.ErrSourceNotFound, kind)
+	}
+
+	if _, err = m.CIM.BootConfigSetting.ChangeBootOrder(source.Source, opts...); err != nil {
+		return source, err
+	}
+
+	return source, m.setBootConfigRole(boot.RoleIsNext, opts)
+}
+
+// SetBootOrder sets the boot order of the AMT boot configuration to the first boot source of each kind, in the order
+// of kinds, and assigns the configuration to the next boot. AMT only assigns its boot configuration to the next boot,
+// so the order does not persist and must be set again before every boot that needs it. Firmware that only accepts a
+// single boot source returns boot.ErrMultipleSourcesNotSupported for more than one kind. The boot sources are
+// returned.
+func (m Messages) SetBootOrder(kinds []boot.SourceKind, opts ...base.HeaderOption) ([]boot.Source, error) {
+	catalogue, err := m.CIM.BootSourceSetting.Catalogue(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the boot sources: %w", err)
+	}
+
+	sources, err := catalogue.Sources(kinds...)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = m.CIM.BootConfigSetting.ChangeBootOrderSources(sources, opts...); err != nil {
+		return sources, err
+	}
+
+	return sources, m.setBootConfigRole(boot.RoleIsNext, opts)
+}
+
+func (m Messages) setBootConfigRole(role int, opts []base.HeaderOption) error {
+	response, err := m.CIM.BootService.SetBootConfigRole(boot.AMTBootConfiguration, role, opts...)
+	if err != nil {
+		return err
+	}
+
+	if rv := response.Body.SetBootConfigRole_OUTPUT.ReturnValue; rv != boot.ReturnValueCompletedNoError {
+		return errors.New("SetBootConfigRole failed with return code " + rv.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package wsman
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/boot"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+var (
+	bootOrderSourcePattern = regexp.MustCompile(`<Selector Name="InstanceID">([^<]*)</Selector>`)
+	bootOrderRolePattern   = regexp.MustCompile(`<h:Role>(\d+)</h:Role>`)
+)
+
+// bootOrderClient lists the boot sources of a device and records the boot order and role it is given.
+type bootOrderClient struct {
+	client.WSMan
+
+	calls             []string
+	sources           []boot.Source
+	order             []string
+	role              string
+	maxSources        int
+	changeBootOrderRV int
+}
+
+func (c *bootOrderClient) Post(msg string) ([]byte, error) {
+	action := hardeningActionPattern.FindStringSubmatch(msg)[1]
+	class := ieee8021xResourcePattern.FindStringSubmatch(msg)[1]
+	c.calls = append(c.calls, class+"."+action)
+
+	var body string
+
+	switch class + "." + action {
+	case "CIM_BootSourceSetting.Enumerate":
+		body = `<EnumerateResponse><EnumerationContext>context</EnumerationContext></EnumerateResponse>`
+	case "CIM_BootSourceSetting.Pull":
+		var items strings.Builder
+
+		for _, source := range c.sources {
+			fmt.Fprintf(&items, `<CIM_BootSourceSetting><InstanceID>%s</InstanceID>%s<FailThroughSupported>2</FailThroughSupported></CIM_BootSourceSetting>`, source, bootOrderStructuredBootStrings[source])
+		}
+
+		body = `<PullResponse><Items>` + items.String() + `</Items><EndOfSequence></EndOfSequence></PullResponse>`
+	case "CIM_BootConfigSetting.ChangeBootOrder":
+		returnValue := c.changeBootOrderRV
+
+		sources := bootOrderSourcePattern.FindAllStringSubmatch(msg, -1)
+		if c.maxSources > 0 && len(sources) > c.maxSources {
+			returnValue = int(boot.ReturnValueInvalidParameter)
+		}
+
+		if returnValue == 0 {
+			c.order = nil
+
+			for _, source := range sources {
+				c.order = append(c.order, source[1])
+			}
+		}
+
+		body = fmt.Sprintf(`<ChangeBootOrder_OUTPUT><ReturnValue>%d</ReturnValue></ChangeBootOrder_OUTPUT>`, returnValue)
+	case "CIM_BootService.SetBootConfigRole":
+		c.role = bootOrderRolePattern.FindStringSubmatch(msg)[1]
+		body = `<SetBootConfigRole_OUTPUT><ReturnValue>0</ReturnValue></SetBootConfigRole_OUTPUT>`
+	}
+
+	return []byte(`<Envelope><Header></Header><Body>` + body + `</Body></Envelope>`), nil
+}
+
+var bootOrderStructuredBootStrings = map[boot.Source]string{
+	boot.HardDrive: `<StructuredBootString>CIM:Hard-Disk:1</StructuredBootString>`,
+	boot.CD:        `<StructuredBootString>CIM:CD/DVD:1</StructuredBootString>`,
+	boot.PXE:       `<StructuredBootString>CIM:Network:1</StructuredBootString>`,
+}
+
+func newBootOrderMessages(wsmanClient *bootOrderClient) Messages {
+	return Messages{Client: wsmanClient, CIM: cim.NewMessages(wsmanClient)}
+}
+
+func TestSetNextBoot(t *testing.T) {
+	wsmanClient := &bootOrderClient{sources: []boot.Source{boot.HardDrive, boot.PXE, boot.CD, boot.OCRUEFIHTTPS}}
+	m := newBootOrderMessages(wsmanClient)
+
+	source, err := m.SetNextBoot(boot.SourceKindPXE)
+	require.NoError(t, err)
+	assert.Equal(t, boot.PXE, source.Source)
+	assert.Equal(t, boot.FailThroughSupportedNotSupported, source.FailThroughSupported)
+	assert.Equal(t, []string{string(boot.PXE)}, wsmanClient.order)
+	assert.Equal(t, "1", wsmanClient.role)
+	assert.Equal(t, []string{
+		"CIM_BootSourceSetting.Enumerate",
+		"CIM_BootSourceSetting.Pull",
+		"CIM_BootConfigSetting.ChangeBootOrder",
+		"CIM_BootService.SetBootConfigRole",
+	}, wsmanClient.calls)
+
+	source, err = m.SetNextBoot(boot.SourceKindUEFIHTTPS)
+	require.NoError(t, err)
+	assert.Equal(t, boot.OCRUEFIHTTPS, source.Source)
+}
+
+func TestSetNextBootErrors(t *testing.T) {
+	t.Run("missing source", func(t *testing.T) {
+		wsmanClient := &bootOrderClient{sources: []boot.Source{boot.HardDrive}}
+
+		_, err := newBootOrderMessages(wsmanClient).SetNextBoot(boot.SourceKindCDDVD)
+		assert.ErrorIs(t, err, boot.ErrSourceNotFound)
+		assert.Empty(t, wsmanClient.order)
+		assert.Empty(t, wsmanClient.role)
+	})
+
+	t.Run("rejected boot order", func(t *testing.T) {
+		wsmanClient := &bootOrderClient{sources: []boot.Source{boot.HardDrive}, changeBootOrderRV: int(boot.ReturnValueAccessDenied)}
+
+		_, err := newBootOrderMessages(wsmanClient).SetNextBoot(boot.SourceKindHardDrive)
+		assert.Error(t, err)
+		assert.Empty(t, wsmanClient.role)
+	})
+}
+
+func TestSetBootOrder(t *testing.T) {
+	wsmanClient := &bootOrderClient{sources: []boot.Source{boot.HardDrive, boot.PXE, boot.CD}}
+	m := newBootOrderMessages(wsmanClient)
+
+	sources, err := m.SetBootOrder([]boot.SourceKind{boot.SourceKindPXE, boot.SourceKindHardDrive})
+	require.NoError(t, err)
+	assert.Equal(t, []boot.Source{boot.PXE, boot.HardDrive}, sources)
+	assert.Equal(t, []string{string(boot.PXE), string(boot.HardDrive)}, wsmanClient.order)
+	assert.Equal(t, "1", wsmanClient.role)
+
+	_, err = m.SetBootOrder([]boot.SourceKind{boot.SourceKindPXE, boot.SourceKindUSB})
+	assert.ErrorIs(t, err, boot.ErrSourceNotFound)
+}
+
+func TestSetBootOrderSingleSourceFirmware(t *testing.T) {
+	wsmanClient := &bootOrderClient{sources: []boot.Source{boot.HardDrive, boot.PXE}, maxSources: 1}
+	m := newBootOrderMessages(wsmanClient)
+
+	_, err := m.SetBootOrder([]boot.SourceKind{boot.SourceKindPXE, boot.SourceKindHardDrive})
+	assert.ErrorIs(t, err, boot.ErrMultipleSourcesNotSupported)
+	assert.Empty(t, wsmanClient.role)
+
+	sources, err := m.SetBootOrder([]boot.SourceKind{boot.SourceKindHardDrive})
+	require.NoError(t, err)
+	assert.Equal(t, []boot.Source{boot.HardDrive}, sources)
+	assert.Equal(t, "1", wsmanClient.role)
+}
@@ -32,24 +32,24 @@ func NewBIOSFeatureWithClient(wsmanMessageCreator *message.WSManMessageCreator,
 }
 
 // FetchFWData captures the XML from Get, Enumerate, Pull (and optionally Put) operations.
-func (f Feature) FetchFWData(request PutRequest) (FWData, error) {
+func (f Feature) FetchFWData(request PutRequest, opts ...base.HeaderOption) (FWData, error) {
 	var fwData FWData
 
-	enumerateResponse, err := f.Enumerate()
+	enumerateResponse, err := f.Enumerate(opts...)
 	if err != nil {
 		return fwData, err
 	}
 
 	fwData.EnumerateXML = enumerateResponse.XMLOutput
 
-	pullResponse, err := f.Pull(enumerateResponse.Body.EnumerateResponse.EnumerationContext)
+	pullResponse, err := f.Pull(enumerateResponse.Body.EnumerateResponse.EnumerationContext, opts...)
 	if err != nil {
 		return fwData, err
 	}
 
 	fwData.PullXML = pullResponse.XMLOutput
 
-	getResponse, err := f.Get()
+	getResponse, err := f.Get(opts...)
 	if err != nil {
 		return fwData, err
 	}
@@ -61,7 +61,7 @@ func (f Feature) FetchFWData(request PutRequest) (FWData, error) {
 			Name: request.FeatureName,
 		}
 
-		putResponse, err := f.Put(feature)
+		putResponse, err := f.Put(feature, opts...)
 		if err != nil {
 			return fwData, err
 		}
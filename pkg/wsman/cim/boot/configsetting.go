@@ -26,6 +26,7 @@ import (
 	"encoding/xml"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/base"
@@ -33,6 +34,10 @@ import (
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
 )
 
+// ErrMultipleSourcesNotSupported is returned by ChangeBootOrderSources when the firmware rejects a boot order with more
+// than one source.
+var ErrMultipleSourcesNotSupported = errors.New("boot order with multiple sources is not supported")
+
 type ConfigSetting struct {
 	base.WSManService[Response]
 }
@@ -61,20 +66,46 @@ func NewBootConfigSettingWithClient(wsmanMessageCreator *message.WSManMessageCre
 // 2) Parameter 'Source' changed in capitalization. Intel AMT Release 5.0 and earlier releases use 2.13.0 MOF version and therefor expect 'Source' parameter as 'source'.
 //
 // 3) Intel AMT Release 7.0: Returns WSMAN Fault = “access denied” if user consent is required but IPS_OptInService.OptInState value is not 'Received' or 'In Session'. An exception to this rule is when the Source parameter is an empty array.
-func (configSetting ConfigSetting) ChangeBootOrder(source Source) (response Response, err error) {
-	header := configSetting.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(CIMBootConfigSetting, ChangeBootOrder), CIMBootConfigSetting, nil, "", "")
+func (configSetting ConfigSetting) ChangeBootOrder(source Source, opts ...base.HeaderOption) (response Response, err error) {
+	var sources []Source
+
+	if source != "" {
+		sources = []Source{source}
+	}
+
+	return configSetting.changeBootOrder(sources, opts)
+}
+
+// ChangeBootOrderSources sets the boot order to the sources, first to last, as ChangeBootOrder does for a single
+// source. An empty list clears the boot order. AMT firmware that only accepts a single boot source rejects longer
+// lists, which is reported as ErrMultipleSourcesNotSupported.
+func (configSetting ConfigSetting) ChangeBootOrderSources(sources []Source, opts ...base.HeaderOption) (response Response, err error) {
+	response, err = configSetting.changeBootOrder(sources, opts)
+
+	returnValue := response.Body.ChangeBootOrder_OUTPUT.ReturnValue
+	if err != nil && len(sources) > 1 && (returnValue == ReturnValueNotSupported || returnValue == ReturnValueInvalidParameter) {
+		err = fmt.Errorf("%w: %w", ErrMultipleSourcesNotSupported, err)
+	}
+
+	return response, err
+}
 
-	var body string
+func (configSetting ConfigSetting) changeBootOrder(sources []Source, opts []base.HeaderOption) (response Response, err error) {
+	header := configSetting.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(CIMBootConfigSetting, ChangeBootOrder), CIMBootConfigSetting, nil, "", "", opts...)
 
-	if source == "" {
-		body = `<Body><h:ChangeBootOrder_INPUT xmlns:h="http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_BootConfigSetting"></h:ChangeBootOrder_INPUT></Body>`
-	} else {
-		body = fmt.Sprintf(`<Body><h:ChangeBootOrder_INPUT xmlns:h="http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_BootConfigSetting"><h:Source><Address xmlns="http://schemas.xmlsoap.org/ws/2004/08/addressing">http://schemas.xmlsoap.org/ws/2004/08/addressing</Address><ReferenceParameters xmlns="http://schemas.xmlsoap.org/ws/2004/08/addressing"><ResourceURI xmlns="http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd">http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_BootSourceSetting</ResourceURI><SelectorSet xmlns="http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd"><Selector Name="InstanceID">%s</Selector></SelectorSet></ReferenceParameters></h:Source></h:ChangeBootOrder_INPUT></Body>`, source)
+	var body strings.Builder
+
+	body.WriteString(`<Body><h:ChangeBootOrder_INPUT xmlns:h="http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_BootConfigSetting">`)
+
+	for _, source := range sources {
+		fmt.Fprintf(&body, `<h:Source><Address xmlns="http://schemas.xmlsoap.org/ws/2004/08/addressing">http://schemas.xmlsoap.org/ws/2004/08/addressing</Address><ReferenceParameters xmlns="http://schemas.xmlsoap.org/ws/2004/08/addressing"><ResourceURI xmlns="http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd">http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_BootSourceSetting</ResourceURI><SelectorSet xmlns="http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd"><Selector Name="InstanceID">%s</Selector></SelectorSet></ReferenceParameters></h:Source>`, source)
 	}
 
+	body.WriteString(`</h:ChangeBootOrder_INPUT></Body>`)
+
 	response = Response{
 		Message: &client.Message{
-			XMLInput: configSetting.Base.WSManMessageCreator.CreateXML(header, body),
+			XMLInput: configSetting.Base.WSManMessageCreator.CreateXML(header, body.String()),
 		},
 	}
 
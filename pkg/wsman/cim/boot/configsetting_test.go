@@ -7,6 +7,7 @@ package boot
 
 import (
 	"encoding/xml"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -141,6 +142,24 @@ func TestPositiveConfigSetting(t *testing.T) {
 					},
 				},
 			},
+			// Change Boot Order with multiple sources
+			{
+				"should create and parse a valid cim_BootConfigSetting ChangeBootOrder call with multiple sources",
+				CIMBootConfigSetting,
+				methods.GenerateAction(CIMBootConfigSetting, ChangeBootOrder),
+				"<h:ChangeBootOrder_INPUT xmlns:h=\"http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_BootConfigSetting\"><h:Source><Address xmlns=\"http://schemas.xmlsoap.org/ws/2004/08/addressing\">http://schemas.xmlsoap.org/ws/2004/08/addressing</Address><ReferenceParameters xmlns=\"http://schemas.xmlsoap.org/ws/2004/08/addressing\"><ResourceURI xmlns=\"http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd\">http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_BootSourceSetting</ResourceURI><SelectorSet xmlns=\"http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd\"><Selector Name=\"InstanceID\">Intel(r) AMT: Force PXE Boot</Selector></SelectorSet></ReferenceParameters></h:Source><h:Source><Address xmlns=\"http://schemas.xmlsoap.org/ws/2004/08/addressing\">http://schemas.xmlsoap.org/ws/2004/08/addressing</Address><ReferenceParameters xmlns=\"http://schemas.xmlsoap.org/ws/2004/08/addressing\"><ResourceURI xmlns=\"http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd\">http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_BootSourceSetting</ResourceURI><SelectorSet xmlns=\"http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd\"><Selector Name=\"InstanceID\">Intel(r) AMT: Force Hard-drive Boot</Selector></SelectorSet></ReferenceParameters></h:Source></h:ChangeBootOrder_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = CurrentMessageChangeBootOrder
+
+					return elementUnderTest.ChangeBootOrderSources([]Source{PXE, HardDrive})
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					ChangeBootOrder_OUTPUT: ChangeBootOrder_OUTPUT{
+						ReturnValue: 0,
+					},
+				},
+			},
 			// Change Boot Order with empty source
 			{
 				"should create and parse a valid cim_BootConfigSetting ChangeBootOrder call",
@@ -304,3 +323,32 @@ func TestNegativeConfigSetting(t *testing.T) {
 		}
 	})
 }
+
+// returnValueClient answers ChangeBootOrder with a fixed return value.
+type returnValueClient struct {
+	wsmantesting.MockClient
+	returnValue ReturnValue
+}
+
+func (c *returnValueClient) Post(msg string) ([]byte, error) {
+	return []byte(fmt.Sprintf(`<a:Envelope xmlns:a="http://www.w3.org/2003/05/soap-envelope" xmlns:g="http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_BootConfigSetting"><a:Header></a:Header><a:Body><g:ChangeBootOrder_OUTPUT><g:ReturnValue>%d</g:ReturnValue></g:ChangeBootOrder_OUTPUT></a:Body></a:Envelope>`, c.returnValue)), nil
+}
+
+func TestChangeBootOrderSourcesNotSupported(t *testing.T) {
+	client := &returnValueClient{returnValue: ReturnValueInvalidParameter}
+	elementUnderTest := NewBootConfigSettingWithClient(message.NewWSManMessageCreator(wsmantesting.CIMResourceURIBase), client)
+
+	response, err := elementUnderTest.ChangeBootOrderSources([]Source{PXE, HardDrive})
+	assert.ErrorIs(t, err, ErrMultipleSourcesNotSupported)
+	assert.Equal(t, ReturnValueInvalidParameter, response.Body.ChangeBootOrder_OUTPUT.ReturnValue)
+
+	// a single rejected source is an ordinary failure
+	_, err = elementUnderTest.ChangeBootOrderSources([]Source{PXE})
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrMultipleSourcesNotSupported)
+
+	client.returnValue = ReturnValueAccessDenied
+	_, err = elementUnderTest.ChangeBootOrderSources([]Source{PXE, HardDrive})
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrMultipleSourcesNotSupported)
+}
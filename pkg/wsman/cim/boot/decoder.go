@@ -32,6 +32,48 @@ const (
 	OCRUEFIBootOption10 Source = "Intel(r) AMT: Force OCR UEFI Boot Option 10"
 )
 
+// AMTBootConfiguration is the InstanceID of the CIM_BootConfigSetting that AMT uses for the next boot.
+const AMTBootConfiguration = "Intel(r) AMT: Boot Configuration 0"
+
+// Roles of SetBootConfigRole. AMT only accepts RoleIsNext for its boot configuration, which then applies to the next
+// boot only.
+const (
+	RoleIsDefault = 0 // The boot configuration is the default one; not supported by AMT
+	RoleIsNext    = 1 // The boot configuration is used on the next boot
+)
+
+const (
+	SourceKindUnknown SourceKind = iota
+	SourceKindHardDrive
+	SourceKindCDDVD
+	SourceKindPXE
+	SourceKindUEFIHTTPS
+	SourceKindUEFIBootOption
+	SourceKindFloppy
+	SourceKindUSB
+)
+
+// sourceKindToString is a mapping of the SourceKind value to a string.
+var sourceKindToString = map[SourceKind]string{
+	SourceKindUnknown:        "Unknown",
+	SourceKindHardDrive:      "HardDrive",
+	SourceKindCDDVD:          "CDDVD",
+	SourceKindPXE:            "PXE",
+	SourceKindUEFIHTTPS:      "UEFIHTTPS",
+	SourceKindUEFIBootOption: "UEFIBootOption",
+	SourceKindFloppy:         "Floppy",
+	SourceKindUSB:            "USB",
+}
+
+// String returns the string representation of the SourceKind value.
+func (k SourceKind) String() string {
+	if value, exists := sourceKindToString[k]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
 const (
 	FailThroughSupportedUnknown FailThroughSupported = iota
 	FailThroughSupportedIsSupported
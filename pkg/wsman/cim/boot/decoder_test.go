@@ -147,3 +147,27 @@ func TestRequestedState_String(t *testing.T) {
 		}
 	}
 }
+
+func TestSourceKind_String(t *testing.T) {
+	tests := []struct {
+		state    SourceKind
+		expected string
+	}{
+		{SourceKindUnknown, "Unknown"},
+		{SourceKindHardDrive, "HardDrive"},
+		{SourceKindCDDVD, "CDDVD"},
+		{SourceKindPXE, "PXE"},
+		{SourceKindUEFIHTTPS, "UEFIHTTPS"},
+		{SourceKindUEFIBootOption, "UEFIBootOption"},
+		{SourceKindFloppy, "Floppy"},
+		{SourceKindUSB, "USB"},
+		{SourceKind(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
@@ -28,8 +28,8 @@ func NewBootServiceWithClient(wsmanMessageCreator *message.WSManMessageCreator,
 	}
 }
 
-func (service Service) SetBootConfigRole(instanceID string, role int) (response Response, err error) {
-	header := service.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(CIMBootService, SetBootConfigRole), CIMBootService, nil, "", "")
+func (service Service) SetBootConfigRole(instanceID string, role int, opts ...base.HeaderOption) (response Response, err error) {
+	header := service.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(CIMBootService, SetBootConfigRole), CIMBootService, nil, "", "", opts...)
 
 	var body strings.Builder
 
@@ -63,10 +63,10 @@ func (service Service) SetBootConfigRole(instanceID string, role int) (response
 }
 
 // RequestStateChange requests that the state of the element be changed to the value specified in the RequestedState parameter . . .
-func (service Service) RequestStateChange(requestedState int) (response Response, err error) {
+func (service Service) RequestStateChange(requestedState int, opts ...base.HeaderOption) (response Response, err error) {
 	response = Response{
 		Message: &client.Message{
-			XMLInput: service.Base.RequestStateChange(methods.GenerateAction(CIMBootService, "RequestStateChange"), requestedState),
+			XMLInput: service.Base.RequestStateChange(methods.GenerateAction(CIMBootService, "RequestStateChange"), requestedState, opts...),
 		},
 	}
 
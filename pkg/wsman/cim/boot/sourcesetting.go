@@ -6,18 +6,121 @@
 package boot
 
 import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/base"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
 )
 
+// ErrSourceNotFound is returned by Catalogue.Sources when the device has no boot source of a kind.
+var ErrSourceNotFound = errors.New("boot source not found")
+
 type SourceSetting struct {
 	base.WSManService[Response]
 }
 
+// BootSource is a boot source of the device, as described by its CIM_BootSourceSetting.
+type BootSource struct {
+	Source               Source // The InstanceID to pass to ChangeBootOrder
+	Kind                 SourceKind
+	Index                int // The index of StructuredBootString, or 0 when it has none
+	ElementName          string
+	StructuredBootString string
+	BIOSBootString       string
+	BootString           string
+	FailThroughSupported FailThroughSupported
+}
+
+// Catalogue is the list of boot sources of a device, in enumeration order.
+type Catalogue []BootSource
+
 // NewBootSourceSetting returns a new instance of the BootSourceSetting struct.
 func NewBootSourceSettingWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) SourceSetting {
 	return SourceSetting{
 		base.NewService[Response](wsmanMessageCreator, CIMBootSourceSetting, client),
 	}
 }
+
+// Catalogue enumerates the CIM_BootSourceSetting instances of the device and returns them as a Catalogue.
+func (sourceSetting SourceSetting) Catalogue(opts ...base.HeaderOption) (Catalogue, error) {
+	var catalogue Catalogue
+
+	err := base.EachItem(sourceSetting.WSManService, CIMBootSourceSetting, func(setting BootSourceSetting) error {
+		catalogue = append(catalogue, NewBootSource(setting))
+
+		return nil
+	}, opts...)
+
+	return catalogue, err
+}
+
+// NewBootSource describes a CIM_BootSourceSetting. The kind of the OCR sources is taken from their InstanceID, the
+// kind of the others from the identifier of their StructuredBootString, such as "CIM:Hard-Disk:1".
+func NewBootSource(setting BootSourceSetting) BootSource {
+	source := BootSource{
+		Source:               Source(setting.InstanceID),
+		ElementName:          setting.ElementName,
+		StructuredBootString: setting.StructuredBootString,
+		BIOSBootString:       setting.BIOSBootString,
+		BootString:           setting.BootString,
+		FailThroughSupported: setting.FailThroughSupported,
+	}
+
+	identifier := ""
+
+	if parts := strings.Split(setting.StructuredBootString, ":"); len(parts) == 3 {
+		identifier = parts[1]
+		source.Index, _ = strconv.Atoi(parts[2])
+	}
+
+	switch {
+	case source.Source == OCRUEFIHTTPS:
+		source.Kind = SourceKindUEFIHTTPS
+	case strings.HasPrefix(string(source.Source), strings.TrimSuffix(string(OCRUEFIBootOption1), "1")):
+		source.Kind = SourceKindUEFIBootOption
+	default:
+		source.Kind = structuredBootKinds[identifier]
+	}
+
+	return source
+}
+
+// structuredBootKinds maps the DMTF identifiers of StructuredBootString to a SourceKind.
+var structuredBootKinds = map[string]SourceKind{
+	"Hard-Disk": SourceKindHardDrive,
+	"CD/DVD":    SourceKindCDDVD,
+	"Network":   SourceKindPXE,
+	"Floppy":    SourceKindFloppy,
+	"USB":       SourceKindUSB,
+}
+
+// Find returns the first boot source of the kind.
+func (c Catalogue) Find(kind SourceKind) (BootSource, bool) {
+	for _, source := range c {
+		if source.Kind == kind {
+			return source, true
+		}
+	}
+
+	return BootSource{}, false
+}
+
+// Sources returns the first boot source of each kind, in the order of kinds, for ChangeBootOrderSources.
+func (c Catalogue) Sources(kinds ...SourceKind) ([]Source, error) {
+	sources := make([]Source, 0, len(kinds))
+
+	for _, kind := range kinds {
+		source, ok := c.Find(kind)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrSourceNotFound, kind)
+		}
+
+		sources = append(sources, source.Source)
+	}
+
+	return sources, nil
+}
@@ -7,9 +7,11 @@ package boot
 
 import (
 	"encoding/xml"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/common"
@@ -249,3 +251,101 @@ func TestNegativeSourceSetting(t *testing.T) {
 		}
 	})
 }
+
+// catalogueClient answers each request with the fixture of its action.
+type catalogueClient struct {
+	wsmantesting.MockClient
+	requests int
+}
+
+func (c *catalogueClient) Post(msg string) ([]byte, error) {
+	c.requests++
+
+	if strings.Contains(msg, message.BaseActionsEnumerate) {
+		c.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+	} else {
+		c.CurrentMessage = wsmantesting.CurrentMessagePull
+	}
+
+	return c.MockClient.Post(msg)
+}
+
+func TestSourceSettingCatalogue(t *testing.T) {
+	client := &catalogueClient{MockClient: wsmantesting.MockClient{PackageUnderTest: "cim/boot/sourcesetting"}}
+	elementUnderTest := NewBootSourceSettingWithClient(message.NewWSManMessageCreator(wsmantesting.CIMResourceURIBase), client)
+
+	catalogue, err := elementUnderTest.Catalogue()
+	require.NoError(t, err)
+	require.Len(t, catalogue, 3)
+	assert.Equal(t, 2, client.requests)
+	assert.Equal(t, BootSource{
+		Source:               HardDrive,
+		Kind:                 SourceKindHardDrive,
+		Index:                1,
+		ElementName:          "Intel(r) AMT: Boot Source",
+		StructuredBootString: "CIM:Hard-Disk:1",
+		FailThroughSupported: FailThroughSupportedNotSupported,
+	}, catalogue[0])
+	assert.Equal(t, SourceKindPXE, catalogue[1].Kind)
+	assert.Equal(t, SourceKindCDDVD, catalogue[2].Kind)
+
+	// no fixtures, so the enumeration fails
+	client.PackageUnderTest = "cim/boot/missing"
+	_, err = elementUnderTest.Catalogue()
+	assert.Error(t, err)
+}
+
+func TestNewBootSource(t *testing.T) {
+	tests := []struct {
+		setting BootSourceSetting
+		kind    SourceKind
+		index   int
+	}{
+		{BootSourceSetting{InstanceID: string(HardDrive), StructuredBootString: "CIM:Hard-Disk:2"}, SourceKindHardDrive, 2},
+		{BootSourceSetting{InstanceID: string(CD), StructuredBootString: "CIM:CD/DVD:1"}, SourceKindCDDVD, 1},
+		{BootSourceSetting{InstanceID: string(PXE), StructuredBootString: "CIM:Network:1"}, SourceKindPXE, 1},
+		{BootSourceSetting{InstanceID: "Floppy", StructuredBootString: "CIM:Floppy:1"}, SourceKindFloppy, 1},
+		{BootSourceSetting{InstanceID: "USB", StructuredBootString: "CIM:USB:3"}, SourceKindUSB, 3},
+		{BootSourceSetting{InstanceID: string(OCRUEFIHTTPS)}, SourceKindUEFIHTTPS, 0},
+		{BootSourceSetting{InstanceID: string(OCRUEFIBootOption3), BIOSBootString: "Windows Boot Manager"}, SourceKindUEFIBootOption, 0},
+		{BootSourceSetting{InstanceID: "Vendor", StructuredBootString: "Vendor:Other:1"}, SourceKindUnknown, 1},
+		{BootSourceSetting{InstanceID: "Vendor"}, SourceKindUnknown, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.setting.InstanceID, func(t *testing.T) {
+			source := NewBootSource(test.setting)
+			assert.Equal(t, Source(test.setting.InstanceID), source.Source)
+			assert.Equal(t, test.kind, source.Kind)
+			assert.Equal(t, test.index, source.Index)
+			assert.Equal(t, test.setting.BIOSBootString, source.BIOSBootString)
+		})
+	}
+}
+
+func TestCatalogueSources(t *testing.T) {
+	catalogue := Catalogue{
+		{Source: HardDrive, Kind: SourceKindHardDrive, Index: 1},
+		{Source: "Intel(r) AMT: Force Hard-drive Boot 2", Kind: SourceKindHardDrive, Index: 2},
+		{Source: PXE, Kind: SourceKindPXE, Index: 1},
+	}
+
+	source, ok := catalogue.Find(SourceKindHardDrive)
+	assert.True(t, ok)
+	assert.Equal(t, HardDrive, source.Source)
+
+	_, ok = catalogue.Find(SourceKindCDDVD)
+	assert.False(t, ok)
+
+	sources, err := catalogue.Sources(SourceKindPXE, SourceKindHardDrive)
+	require.NoError(t, err)
+	assert.Equal(t, []Source{PXE, HardDrive}, sources)
+
+	sources, err = catalogue.Sources()
+	require.NoError(t, err)
+	assert.Empty(t, sources)
+
+	_, err = catalogue.Sources(SourceKindPXE, SourceKindCDDVD)
+	assert.ErrorIs(t, err, ErrSourceNotFound)
+	assert.ErrorContains(t, err, "CDDVD")
+}
@@ -78,6 +78,8 @@ type (
 		ReturnValue ReturnValue `xml:"ReturnValue"`
 	}
 
+	// SourceKind is the kind of device a boot source boots from.
+	SourceKind int
 	// FailThroughSupported is an enumeration indicating the behavior when the attempt to boot using the boot source fails (no media, timeout).
 	FailThroughSupported int
 	// ReturnValue is an enumeration indicating the return value of the operation.
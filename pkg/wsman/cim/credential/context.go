@@ -36,13 +36,13 @@ func NewContextWithClient(wsmanMessageCreator *message.WSManMessageCreator, clie
 // server-side enumeration cursor across successive Pulls made with the same request
 // XML, so we re-post until EndOfSequence is seen. A safety valve caps iterations in
 // case firmware never terminates the sequence.
-func (context Context) Pull(enumerationContext string) (response Response, err error) {
+func (context Context) Pull(enumerationContext string, opts ...base.HeaderOption) (response Response, err error) {
 	loopMax := 25
 	loopCnt := 0
 
 	response = Response{
 		Message: &client.Message{
-			XMLInput: context.Base.Pull(enumerationContext),
+			XMLInput: context.Base.Pull(enumerationContext, opts...),
 		},
 	}
 
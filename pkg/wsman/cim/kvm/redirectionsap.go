@@ -27,10 +27,10 @@ func NewKVMRedirectionSAPWithClient(wsmanMessageCreator *message.WSManMessageCre
 }
 
 // RequestStateChange requests that the state of the element be changed to the value specified in the RequestedState parameter . . .
-func (redirectionSAP RedirectionSAP) RequestStateChange(requestedState KVMRedirectionSAPRequestStateChangeInput) (response Response, err error) {
+func (redirectionSAP RedirectionSAP) RequestStateChange(requestedState KVMRedirectionSAPRequestStateChangeInput, opts ...base.HeaderOption) (response Response, err error) {
 	response = Response{
 		Message: &client.Message{
-			XMLInput: redirectionSAP.Base.RequestStateChange(methods.RequestStateChange(CIMKVMRedirectionSAP), int(requestedState)),
+			XMLInput: redirectionSAP.Base.RequestStateChange(methods.RequestStateChange(CIMKVMRedirectionSAP), int(requestedState), opts...),
 		},
 	}
 
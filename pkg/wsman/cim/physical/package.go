@@ -22,13 +22,13 @@ func NewPhysicalPackageWithClient(wsmanMessageCreator *message.WSManMessageCreat
 }
 
 // Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
-func (physicalPackage Package) Pull(enumerationContext string) (response Response, err error) {
+func (physicalPackage Package) Pull(enumerationContext string, opts ...base.HeaderOption) (response Response, err error) {
 	loopMax := 3
 	loopCnt := 0
 
 	response = Response{
 		Message: &client.Message{
-			XMLInput: physicalPackage.Base.Pull(enumerationContext),
+			XMLInput: physicalPackage.Base.Pull(enumerationContext, opts...),
 		},
 	}
 
@@ -30,8 +30,8 @@ func NewPowerManagementServiceWithClient(wsmanMessageCreator *message.WSManMessa
 }
 
 // RequestPowerStateChange defines the desired power state of the managed element, and when the element should be put into that state.
-func (managementService ManagementService) RequestPowerStateChange(powerState PowerState) (response Response, err error) {
-	header := managementService.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(CIMPowerManagementService, RequestPowerStateChange), CIMPowerManagementService, nil, "", "")
+func (managementService ManagementService) RequestPowerStateChange(powerState PowerState, opts ...base.HeaderOption) (response Response, err error) {
+	header := managementService.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(CIMPowerManagementService, RequestPowerStateChange), CIMPowerManagementService, nil, "", "", opts...)
 	body := fmt.Sprintf(`<Body><h:RequestPowerStateChange_INPUT xmlns:h="http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_PowerManagementService"><h:PowerState>%d</h:PowerState><h:ManagedElement><Address xmlns="http://schemas.xmlsoap.org/ws/2004/08/addressing">http://schemas.xmlsoap.org/ws/2004/08/addressing</Address><ReferenceParameters xmlns="http://schemas.xmlsoap.org/ws/2004/08/addressing"><ResourceURI xmlns="http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd">http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_ComputerSystem</ResourceURI><SelectorSet xmlns="http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd"><Selector Name="CreationClassName">CIM_ComputerSystem</Selector><Selector Name="Name">ManagedSystem</Selector></SelectorSet></ReferenceParameters></h:ManagedElement></h:RequestPowerStateChange_INPUT></Body>`, powerState)
 	response = Response{
 		Message: &client.Message{
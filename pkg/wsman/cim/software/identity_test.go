@@ -6,12 +6,17 @@
 package software
 
 import (
+	"bytes"
 	"encoding/xml"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/base"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/common"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
 )
@@ -361,3 +366,48 @@ func TestNegativeCIMSoftwareIdentity(t *testing.T) {
 		}
 	})
 }
+
+// largePullResponse repeats the items of the pull fixture count times.
+func largePullResponse(b *testing.B, count int) []byte {
+	b.Helper()
+
+	data, err := os.ReadFile("../../wsmantesting/responses/cim/software/identity/pull.xml")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	fixture := string(data)
+	start := strings.Index(fixture, "<g:Items>") + len("<g:Items>")
+	end := strings.Index(fixture, "</g:Items>")
+
+	return []byte(fixture[:start] + strings.Repeat(fixture[start:end], count) + fixture[end:])
+}
+
+func BenchmarkPullUnmarshal(b *testing.B) {
+	data := largePullResponse(b, 2000)
+
+	b.ReportAllocs()
+	b.SetBytes(int64(len(data)))
+
+	for b.Loop() {
+		// mirrors the buffered path: the body is kept as XMLOutput and unmarshalled in full
+		response := Response{Message: &client.Message{XMLOutput: string(data)}}
+		if err := xml.Unmarshal([]byte(response.XMLOutput), &response); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkPullDecodeItems(b *testing.B) {
+	data := largePullResponse(b, 2000)
+
+	b.ReportAllocs()
+	b.SetBytes(int64(len(data)))
+
+	for b.Loop() {
+		_, err := base.DecodeItems(bytes.NewReader(data), "CIM_SoftwareIdentity", func(SoftwareIdentity) error { return nil })
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -33,11 +33,11 @@ func NewWiFiEndpointSettingsWithClient(wsmanMessageCreator *message.WSManMessage
 }
 
 // Delete removes a the specified instance.
-func (endpointSettings EndpointSettings) Delete(handle string) (response Response, err error) {
+func (endpointSettings EndpointSettings) Delete(handle string, opts ...base.HeaderOption) (response Response, err error) {
 	selector := message.Selector{Name: "InstanceID", Value: handle}
 	response = Response{
 		Message: &client.Message{
-			XMLInput: endpointSettings.Base.Delete(selector),
+			XMLInput: endpointSettings.Base.Delete(selector, opts...),
 		},
 	}
 
@@ -28,10 +28,10 @@ func NewWiFiPortWithClient(wsmanMessageCreator *message.WSManMessageCreator, cli
 }
 
 // RequestStateChange requests that the state of the element be changed to the value specified in the RequestedState parameter . . .
-func (port Port) RequestStateChange(requestedState int) (response Response, err error) {
+func (port Port) RequestStateChange(requestedState int, opts ...base.HeaderOption) (response Response, err error) {
 	response = Response{
 		Message: &client.Message{
-			XMLInput: port.Base.RequestStateChange(methods.GenerateAction(CIMWiFiPort, "RequestStateChange"), requestedState),
+			XMLInput: port.Base.RequestStateChange(methods.GenerateAction(CIMWiFiPort, "RequestStateChange"), requestedState, opts...),
 		},
 	}
 
@@ -0,0 +1,403 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package client
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	actionGet       = "http://schemas.xmlsoap.org/ws/2004/09/transfer/Get"
+	actionEnumerate = "http://schemas.xmlsoap.org/ws/2004/09/enumeration/Enumerate"
+	actionPull      = "http://schemas.xmlsoap.org/ws/2004/09/enumeration/Pull"
+	actionRelease   = "http://schemas.xmlsoap.org/ws/2004/09/enumeration/Release"
+)
+
+// CacheKey identifies a cached response.
+type CacheKey struct {
+	Scope       string // separates devices that share a CacheStore
+	ResourceURI string // resource URI of the request
+	Request     string // hash of the request without its MessageID
+}
+
+// CacheStore is the backend of a CachingClient. Implementations must be safe for concurrent use.
+type CacheStore interface {
+	// Get returns the response stored under key, if it has not expired.
+	Get(key CacheKey) ([]byte, bool)
+	// Set stores response under key for ttl.
+	Set(key CacheKey, response []byte, ttl time.Duration)
+	// Invalidate drops every response stored for resourceURI in scope.
+	Invalidate(scope, resourceURI string)
+}
+
+// CacheOptions configures a CachingClient.
+type CacheOptions struct {
+	Store      CacheStore               // backend; an in-memory store is used when nil
+	TTL        map[string]time.Duration // time to live per class name, e.g. "AMT_BootCapabilities"
+	DefaultTTL time.Duration            // time to live of classes missing from TTL; 0 disables caching for them
+	Scope      string                   // separates devices that share a Store, e.g. the device address
+}
+
+// CachingClient serves Get, Enumerate and Pull responses of read-mostly classes from a CacheStore instead of sending
+// them to the device. Any other request, such as Put, Create, Delete or a method call, is forwarded and invalidates the
+// cached responses of its resource URI.
+//
+// An enumeration is only cached once all of its Pull responses up to EndOfSequence have been read, so that a cached
+// Enumerate never hands out an enumeration context the device does not know about. Cached responses keep the RelatesTo
+// header of the request that fetched them.
+type CachingClient struct {
+	WSMan
+
+	store      CacheStore
+	ttl        map[string]time.Duration
+	defaultTTL time.Duration
+	scope      string
+
+	mu          sync.Mutex
+	generations map[string]uint64
+	pending     map[string]*pendingEnumeration
+}
+
+// pendingEnumeration collects the responses of an enumeration until its last Pull.
+type pendingEnumeration struct {
+	resourceURI string
+	generation  uint64
+	ttl         time.Duration
+	started     time.Time
+	keys        []CacheKey
+	responses   [][]byte
+}
+
+// NewCachingClient wraps client with a response cache configured by options.
+func NewCachingClient(client WSMan, options CacheOptions) *CachingClient {
+	store := options.Store
+	if store == nil {
+		store = NewMemoryCache()
+	}
+
+	return &CachingClient{
+		WSMan:       client,
+		store:       store,
+		ttl:         options.TTL,
+		defaultTTL:  options.DefaultTTL,
+		scope:       options.Scope,
+		generations: map[string]uint64{},
+		pending:     map[string]*pendingEnumeration{},
+	}
+}
+
+// Post returns the cached response to msg when there is one, and forwards msg to the wrapped client otherwise.
+func (c *CachingClient) Post(msg string) ([]byte, error) {
+	action, err := headerValue([]byte(msg), "Action")
+	if err != nil {
+		return c.WSMan.Post(msg)
+	}
+
+	resourceURI, err := headerValue([]byte(msg), "ResourceURI")
+	if err != nil || resourceURI == "" {
+		return c.WSMan.Post(msg)
+	}
+
+	switch action {
+	case actionGet:
+		return c.get(msg, resourceURI)
+	case actionEnumerate:
+		return c.enumerate(msg, resourceURI)
+	case actionPull:
+		return c.pull(msg, resourceURI)
+	case actionRelease:
+		return c.WSMan.Post(msg)
+	default:
+		c.Invalidate(resourceURI)
+
+		response, err := c.WSMan.Post(msg)
+
+		// the request may have changed the resource even when it failed
+		c.Invalidate(resourceURI)
+
+		return response, err
+	}
+}
+
+// PostReader streams the response to msg from the wrapped client when it implements Streamer and the response is not
+// going to be cached, such as a Pull of a class without a time to live. Other responses are read in full by Post.
+func (c *CachingClient) PostReader(msg string) (io.ReadCloser, error) {
+	if streamer, ok := c.WSMan.(Streamer); ok && c.bypasses(msg) {
+		return streamer.PostReader(msg)
+	}
+
+	response, err := c.Post(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return io.NopCloser(bytes.NewReader(response)), nil
+}
+
+// bypasses reports whether msg is a read that Post would forward without caching or invalidating anything.
+func (c *CachingClient) bypasses(msg string) bool {
+	action, err := headerValue([]byte(msg), "Action")
+	if err != nil {
+		return false
+	}
+
+	resourceURI, err := headerValue([]byte(msg), "ResourceURI")
+	if err != nil || resourceURI == "" {
+		return false
+	}
+
+	switch action {
+	case actionGet, actionEnumerate:
+		return c.ttlFor(resourceURI) <= 0
+	case actionPull:
+		if _, ok := c.store.Get(c.key(msg, resourceURI)); ok {
+			return false
+		}
+
+		context, _, err := enumerationState([]byte(msg))
+		if err != nil {
+			return false
+		}
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		_, pending := c.pending[context]
+
+		return !pending
+	case actionRelease:
+		return true
+	default:
+		return false
+	}
+}
+
+// Invalidate drops the cached responses for resourceURI, including enumerations that are still being read.
+func (c *CachingClient) Invalidate(resourceURI string) {
+	c.mu.Lock()
+	c.generations[resourceURI]++
+
+	for context, enumeration := range c.pending {
+		if enumeration.resourceURI == resourceURI {
+			delete(c.pending, context)
+		}
+	}
+	c.mu.Unlock()
+
+	c.store.Invalidate(c.scope, resourceURI)
+}
+
+func (c *CachingClient) get(msg, resourceURI string) ([]byte, error) {
+	ttl := c.ttlFor(resourceURI)
+	if ttl <= 0 {
+		return c.WSMan.Post(msg)
+	}
+
+	key := c.key(msg, resourceURI)
+
+	if response, ok := c.store.Get(key); ok {
+		return response, nil
+	}
+
+	generation := c.generation(resourceURI)
+
+	response, err := c.WSMan.Post(msg)
+	if err != nil {
+		return response, err
+	}
+
+	c.mu.Lock()
+	current := c.generations[resourceURI] == generation
+	c.mu.Unlock()
+
+	if current {
+		c.store.Set(key, response, ttl)
+	}
+
+	return response, nil
+}
+
+func (c *CachingClient) enumerate(msg, resourceURI string) ([]byte, error) {
+	ttl := c.ttlFor(resourceURI)
+	if ttl <= 0 {
+		return c.WSMan.Post(msg)
+	}
+
+	key := c.key(msg, resourceURI)
+
+	if response, ok := c.store.Get(key); ok {
+		return response, nil
+	}
+
+	generation := c.generation(resourceURI)
+
+	response, err := c.WSMan.Post(msg)
+	if err != nil {
+		return response, err
+	}
+
+	context, _, err := enumerationState(response)
+	if err != nil || context == "" {
+		return response, nil //nolint:nilerr // the response is still valid, it is just not cached
+	}
+
+	now := time.Now()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// enumerations that were never read to the end are dropped once they would have expired
+	for pendingContext, enumeration := range c.pending {
+		if now.Sub(enumeration.started) > enumeration.ttl {
+			delete(c.pending, pendingContext)
+		}
+	}
+
+	if c.generations[resourceURI] == generation {
+		c.pending[context] = &pendingEnumeration{
+			resourceURI: resourceURI,
+			generation:  generation,
+			ttl:         ttl,
+			started:     now,
+			keys:        []CacheKey{key},
+			responses:   [][]byte{response},
+		}
+	}
+
+	return response, nil
+}
+
+func (c *CachingClient) pull(msg, resourceURI string) ([]byte, error) {
+	key := c.key(msg, resourceURI)
+
+	if response, ok := c.store.Get(key); ok {
+		return response, nil
+	}
+
+	response, err := c.WSMan.Post(msg)
+	if err != nil {
+		return response, err
+	}
+
+	requestContext, _, err := enumerationState([]byte(msg))
+	if err != nil {
+		return response, nil //nolint:nilerr // the response is still valid, it is just not cached
+	}
+
+	c.mu.Lock()
+
+	enumeration, ok := c.pending[requestContext]
+	if !ok {
+		c.mu.Unlock()
+
+		return response, nil
+	}
+
+	delete(c.pending, requestContext)
+
+	context, endOfSequence, err := enumerationState(response)
+	if err != nil || c.generations[resourceURI] != enumeration.generation {
+		c.mu.Unlock()
+
+		return response, nil //nolint:nilerr // the response is still valid, it is just not cached
+	}
+
+	enumeration.keys = append(enumeration.keys, key)
+	enumeration.responses = append(enumeration.responses, response)
+
+	if !endOfSequence {
+		if context != "" {
+			c.pending[context] = enumeration
+		}
+
+		c.mu.Unlock()
+
+		return response, nil
+	}
+
+	c.mu.Unlock()
+
+	// the Enumerate response is stored first so that it expires before the Pull responses it leads to
+	for i, pageKey := range enumeration.keys {
+		c.store.Set(pageKey, enumeration.responses[i], enumeration.ttl)
+	}
+
+	return response, nil
+}
+
+func (c *CachingClient) generation(resourceURI string) uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.generations[resourceURI]
+}
+
+func (c *CachingClient) ttlFor(resourceURI string) time.Duration {
+	class := resourceURI[strings.LastIndex(resourceURI, "/")+1:]
+
+	if ttl, ok := c.ttl[class]; ok {
+		return ttl
+	}
+
+	return c.defaultTTL
+}
+
+// key hashes msg without its MessageID, which changes on every request.
+func (c *CachingClient) key(msg, resourceURI string) CacheKey {
+	hash := sha256.New()
+
+	start := strings.Index(msg, "<a:MessageID>")
+	end := strings.Index(msg, "</a:MessageID>")
+
+	if start >= 0 && end > start {
+		hash.Write([]byte(msg[:start]))
+		hash.Write([]byte(msg[end:]))
+	} else {
+		hash.Write([]byte(msg))
+	}
+
+	return CacheKey{Scope: c.scope, ResourceURI: resourceURI, Request: hex.EncodeToString(hash.Sum(nil))}
+}
+
+// enumerationState returns the EnumerationContext of an Enumerate or Pull message and whether it signals EndOfSequence.
+func enumerationState(envelope []byte) (context string, endOfSequence bool, err error) {
+	decoder := xml.NewDecoder(bytes.NewReader(envelope))
+
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return context, endOfSequence, nil
+			}
+
+			return "", false, err
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		switch start.Name.Local {
+		case "EnumerationContext":
+			if err := decoder.DecodeElement(&context, &start); err != nil {
+				return "", false, err
+			}
+
+			context = strings.TrimSpace(context)
+		case "EndOfSequence":
+			endOfSequence = true
+		}
+	}
+}
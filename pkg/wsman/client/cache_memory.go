@@ -0,0 +1,80 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package client
+
+import (
+	"bytes"
+	"sync"
+	"time"
+)
+
+// MemoryCache is an in-memory CacheStore. Expired responses are dropped when they are next read.
+type MemoryCache struct {
+	mu      sync.Mutex
+	entries map[memoryCacheResource]map[string]memoryCacheEntry
+	now     func() time.Time
+}
+
+type memoryCacheResource struct {
+	scope       string
+	resourceURI string
+}
+
+type memoryCacheEntry struct {
+	response []byte
+	expires  time.Time
+}
+
+// NewMemoryCache creates an empty in-memory CacheStore.
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{
+		entries: map[memoryCacheResource]map[string]memoryCacheEntry{},
+		now:     time.Now,
+	}
+}
+
+// Get returns a copy of the response stored under key, if it has not expired.
+func (m *MemoryCache) Get(key CacheKey) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	resource := memoryCacheResource{scope: key.Scope, resourceURI: key.ResourceURI}
+
+	entry, ok := m.entries[resource][key.Request]
+	if !ok {
+		return nil, false
+	}
+
+	if !m.now().Before(entry.expires) {
+		delete(m.entries[resource], key.Request)
+
+		return nil, false
+	}
+
+	return bytes.Clone(entry.response), true
+}
+
+// Set stores a copy of response under key for ttl.
+func (m *MemoryCache) Set(key CacheKey, response []byte, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	resource := memoryCacheResource{scope: key.Scope, resourceURI: key.ResourceURI}
+
+	if m.entries[resource] == nil {
+		m.entries[resource] = map[string]memoryCacheEntry{}
+	}
+
+	m.entries[resource][key.Request] = memoryCacheEntry{response: bytes.Clone(response), expires: m.now().Add(ttl)}
+}
+
+// Invalidate drops every response stored for resourceURI in scope.
+func (m *MemoryCache) Invalidate(scope, resourceURI string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.entries, memoryCacheResource{scope: scope, resourceURI: resourceURI})
+}
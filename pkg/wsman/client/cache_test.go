@@ -0,0 +1,370 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package client
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const cacheTestResource = "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_BootCapabilities"
+
+var errCacheTestPost = errors.New("post failed")
+
+// countingClient answers requests with the responses registered per action and counts the requests it receives.
+type countingClient struct {
+	WSMan
+
+	responses map[string][]string
+	posts     map[string]int
+	fail      bool
+}
+
+func newCountingClient() *countingClient {
+	return &countingClient{responses: map[string][]string{}, posts: map[string]int{}}
+}
+
+func (c *countingClient) Post(msg string) ([]byte, error) {
+	action, _ := headerValue([]byte(msg), "Action")
+	c.posts[action]++
+
+	if c.fail {
+		return nil, errCacheTestPost
+	}
+
+	responses := c.responses[action]
+	if len(responses) == 0 {
+		return []byte("<Envelope><Header></Header><Body></Body></Envelope>"), nil
+	}
+
+	c.responses[action] = responses[1:]
+
+	return []byte(responses[0]), nil
+}
+
+func cacheTestRequest(action, resourceURI string, messageID int, body string) string {
+	return fmt.Sprintf(`<Envelope><Header><a:Action>%s</a:Action><w:ResourceURI>%s</w:ResourceURI><a:MessageID>%d</a:MessageID></Header><Body>%s</Body></Envelope>`,
+		action, resourceURI, messageID, body)
+}
+
+func pullRequest(messageID int, context string) string {
+	return cacheTestRequest(actionPull, cacheTestResource, messageID, "<Pull><EnumerationContext>"+context+"</EnumerationContext></Pull>")
+}
+
+func enumerateResponse(context string) string {
+	return "<Envelope><Body><EnumerateResponse><EnumerationContext>" + context + "</EnumerationContext></EnumerateResponse></Body></Envelope>"
+}
+
+func pullResponse(context string, end bool) string {
+	if end {
+		return "<Envelope><Body><PullResponse><Items>" + context + "</Items><EndOfSequence></EndOfSequence></PullResponse></Body></Envelope>"
+	}
+
+	return "<Envelope><Body><PullResponse><EnumerationContext>" + context + "</EnumerationContext><Items></Items></PullResponse></Body></Envelope>"
+}
+
+func TestCachingClient_Get(t *testing.T) {
+	inner := newCountingClient()
+	inner.responses[actionGet] = []string{"first", "second"}
+	cache := NewCachingClient(inner, CacheOptions{TTL: map[string]time.Duration{"AMT_BootCapabilities": time.Minute}})
+
+	response, err := cache.Post(cacheTestRequest(actionGet, cacheTestResource, 0, ""))
+	require.NoError(t, err)
+	assert.Equal(t, "first", string(response))
+
+	response, err = cache.Post(cacheTestRequest(actionGet, cacheTestResource, 1, ""))
+	require.NoError(t, err)
+	assert.Equal(t, "first", string(response))
+	assert.Equal(t, 1, inner.posts[actionGet])
+
+	// a different selector is a different request
+	_, err = cache.Post(cacheTestRequest(actionGet, cacheTestResource, 2, "<w:SelectorSet></w:SelectorSet>"))
+	require.NoError(t, err)
+	assert.Equal(t, 2, inner.posts[actionGet])
+}
+
+func TestCachingClient_Uncached(t *testing.T) {
+	inner := newCountingClient()
+	cache := NewCachingClient(inner, CacheOptions{TTL: map[string]time.Duration{"AMT_BootCapabilities": time.Minute}})
+	other := "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_BootSettingData"
+
+	for i := range 2 {
+		_, err := cache.Post(cacheTestRequest(actionGet, other, i, ""))
+		require.NoError(t, err)
+		_, err = cache.Post(cacheTestRequest(actionEnumerate, other, i, ""))
+		require.NoError(t, err)
+		_, err = cache.Post(cacheTestRequest(actionRelease, cacheTestResource, i, ""))
+		require.NoError(t, err)
+		_, err = cache.Post("not xml")
+		require.NoError(t, err)
+		_, err = cache.Post(cacheTestRequest(actionGet, "", i, ""))
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, 4, inner.posts[actionGet])
+	assert.Equal(t, 2, inner.posts[actionEnumerate])
+	assert.Equal(t, 2, inner.posts[actionRelease])
+}
+
+func TestCachingClient_DefaultTTL(t *testing.T) {
+	inner := newCountingClient()
+	cache := NewCachingClient(inner, CacheOptions{DefaultTTL: time.Minute})
+
+	for i := range 2 {
+		_, err := cache.Post(cacheTestRequest(actionGet, cacheTestResource, i, ""))
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, 1, inner.posts[actionGet])
+}
+
+func TestCachingClient_Error(t *testing.T) {
+	inner := newCountingClient()
+	inner.fail = true
+	cache := NewCachingClient(inner, CacheOptions{DefaultTTL: time.Minute})
+
+	for _, action := range []string{actionGet, actionEnumerate, actionPull} {
+		for i := range 2 {
+			_, err := cache.Post(cacheTestRequest(action, cacheTestResource, i, ""))
+			require.ErrorIs(t, err, errCacheTestPost)
+		}
+
+		assert.Equal(t, 2, inner.posts[action])
+	}
+}
+
+func TestCachingClient_Invalidation(t *testing.T) {
+	for _, action := range []string{
+		"http://schemas.xmlsoap.org/ws/2004/09/transfer/Put",
+		"http://schemas.xmlsoap.org/ws/2004/09/transfer/Create",
+		"http://schemas.xmlsoap.org/ws/2004/09/transfer/Delete",
+		cacheTestResource + "/SetBootConfigRole",
+	} {
+		t.Run(action, func(t *testing.T) {
+			inner := newCountingClient()
+			cache := NewCachingClient(inner, CacheOptions{DefaultTTL: time.Minute})
+
+			_, err := cache.Post(cacheTestRequest(actionGet, cacheTestResource, 0, ""))
+			require.NoError(t, err)
+
+			_, err = cache.Post(cacheTestRequest(action, cacheTestResource, 1, ""))
+			require.NoError(t, err)
+
+			_, err = cache.Post(cacheTestRequest(actionGet, cacheTestResource, 2, ""))
+			require.NoError(t, err)
+			assert.Equal(t, 2, inner.posts[actionGet])
+		})
+	}
+}
+
+func TestCachingClient_InvalidationOtherResource(t *testing.T) {
+	inner := newCountingClient()
+	cache := NewCachingClient(inner, CacheOptions{DefaultTTL: time.Minute})
+
+	_, err := cache.Post(cacheTestRequest(actionGet, cacheTestResource, 0, ""))
+	require.NoError(t, err)
+
+	cache.Invalidate("http://intel.com/wbem/wscim/1/amt-schema/1/AMT_BootSettingData")
+
+	_, err = cache.Post(cacheTestRequest(actionGet, cacheTestResource, 1, ""))
+	require.NoError(t, err)
+	assert.Equal(t, 1, inner.posts[actionGet])
+}
+
+func TestCachingClient_Enumeration(t *testing.T) {
+	inner := newCountingClient()
+	inner.responses[actionEnumerate] = []string{enumerateResponse("ctx1")}
+	inner.responses[actionPull] = []string{pullResponse("ctx2", false), pullResponse("items", true)}
+	cache := NewCachingClient(inner, CacheOptions{DefaultTTL: time.Minute})
+
+	read := func(messageID int) []string {
+		enumeration, err := cache.Post(cacheTestRequest(actionEnumerate, cacheTestResource, messageID, ""))
+		require.NoError(t, err)
+
+		first, err := cache.Post(pullRequest(messageID+1, "ctx1"))
+		require.NoError(t, err)
+
+		last, err := cache.Post(pullRequest(messageID+2, "ctx2"))
+		require.NoError(t, err)
+
+		return []string{string(enumeration), string(first), string(last)}
+	}
+
+	expected := []string{enumerateResponse("ctx1"), pullResponse("ctx2", false), pullResponse("items", true)}
+
+	assert.Equal(t, expected, read(0))
+	assert.Equal(t, expected, read(10))
+	assert.Equal(t, 1, inner.posts[actionEnumerate])
+	assert.Equal(t, 2, inner.posts[actionPull])
+}
+
+func TestCachingClient_IncompleteEnumeration(t *testing.T) {
+	inner := newCountingClient()
+	inner.responses[actionEnumerate] = []string{enumerateResponse("ctx1"), enumerateResponse("ctx1")}
+	inner.responses[actionPull] = []string{pullResponse("ctx2", false)}
+	cache := NewCachingClient(inner, CacheOptions{DefaultTTL: time.Minute})
+
+	_, err := cache.Post(cacheTestRequest(actionEnumerate, cacheTestResource, 0, ""))
+	require.NoError(t, err)
+
+	_, err = cache.Post(pullRequest(1, "ctx1"))
+	require.NoError(t, err)
+
+	// the enumeration was not read to the end, so it must not be served from the cache
+	_, err = cache.Post(cacheTestRequest(actionEnumerate, cacheTestResource, 2, ""))
+	require.NoError(t, err)
+	assert.Equal(t, 2, inner.posts[actionEnumerate])
+}
+
+func TestCachingClient_EnumerationInvalidated(t *testing.T) {
+	inner := newCountingClient()
+	inner.responses[actionEnumerate] = []string{enumerateResponse("ctx1"), enumerateResponse("ctx1")}
+	inner.responses[actionPull] = []string{pullResponse("items", true)}
+	cache := NewCachingClient(inner, CacheOptions{DefaultTTL: time.Minute})
+
+	_, err := cache.Post(cacheTestRequest(actionEnumerate, cacheTestResource, 0, ""))
+	require.NoError(t, err)
+
+	cache.Invalidate(cacheTestResource)
+
+	_, err = cache.Post(pullRequest(1, "ctx1"))
+	require.NoError(t, err)
+
+	_, err = cache.Post(cacheTestRequest(actionEnumerate, cacheTestResource, 2, ""))
+	require.NoError(t, err)
+	assert.Equal(t, 2, inner.posts[actionEnumerate])
+}
+
+func TestCachingClient_UnknownPull(t *testing.T) {
+	inner := newCountingClient()
+	inner.responses[actionPull] = []string{pullResponse("items", true), pullResponse("items", true)}
+	cache := NewCachingClient(inner, CacheOptions{DefaultTTL: time.Minute})
+
+	for i := range 2 {
+		_, err := cache.Post(pullRequest(i, "unknown"))
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, 2, inner.posts[actionPull])
+}
+
+// streamingCountingClient is a countingClient that also implements Streamer.
+type streamingCountingClient struct {
+	*countingClient
+
+	streams int
+}
+
+func (c *streamingCountingClient) PostReader(msg string) (io.ReadCloser, error) {
+	c.streams++
+
+	response, err := c.Post(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return io.NopCloser(bytes.NewReader(response)), nil
+}
+
+func TestCachingClient_PostReader(t *testing.T) {
+	inner := &streamingCountingClient{countingClient: newCountingClient()}
+	inner.responses[actionEnumerate] = []string{enumerateResponse("ctx1")}
+	inner.responses[actionPull] = []string{pullResponse("items", true), pullResponse("other", true)}
+	cache := NewCachingClient(inner, CacheOptions{TTL: map[string]time.Duration{"AMT_BootCapabilities": time.Minute}})
+	other := "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_BootSettingData"
+
+	read := func(msg string) string {
+		body, err := cache.PostReader(msg)
+		require.NoError(t, err)
+
+		response, err := io.ReadAll(body)
+		require.NoError(t, err)
+
+		return string(response)
+	}
+
+	// the enumeration of a cached class is collected by Post
+	assert.Equal(t, enumerateResponse("ctx1"), read(cacheTestRequest(actionEnumerate, cacheTestResource, 0, "")))
+	assert.Equal(t, pullResponse("items", true), read(pullRequest(1, "ctx1")))
+	assert.Equal(t, 0, inner.streams)
+
+	// and is then served from the cache
+	assert.Equal(t, pullResponse("items", true), read(pullRequest(2, "ctx1")))
+	assert.Equal(t, 0, inner.streams)
+
+	// reads that are not cached are streamed
+	read(cacheTestRequest(actionGet, other, 3, ""))
+	read(cacheTestRequest(actionEnumerate, other, 4, ""))
+	assert.Equal(t, pullResponse("other", true), read(pullRequest(5, "unknown")))
+	read(cacheTestRequest(actionRelease, cacheTestResource, 6, ""))
+	assert.Equal(t, 4, inner.streams)
+
+	// requests that change a resource still invalidate it
+	read(cacheTestRequest("Put", cacheTestResource, 7, ""))
+	assert.Equal(t, 4, inner.streams)
+	read(cacheTestRequest(actionEnumerate, cacheTestResource, 8, ""))
+	assert.Equal(t, 3, inner.posts[actionEnumerate])
+
+	inner.fail = true
+
+	_, err := cache.PostReader(cacheTestRequest(actionGet, cacheTestResource, 9, ""))
+	assert.ErrorIs(t, err, errCacheTestPost)
+}
+
+func TestCachingClient_PostReaderWithoutStreamer(t *testing.T) {
+	inner := newCountingClient()
+	inner.responses[actionPull] = []string{pullResponse("items", true)}
+	cache := NewCachingClient(inner, CacheOptions{})
+
+	body, err := cache.PostReader(pullRequest(0, "unknown"))
+	require.NoError(t, err)
+
+	response, err := io.ReadAll(body)
+	require.NoError(t, err)
+	assert.Equal(t, pullResponse("items", true), string(response))
+	assert.Equal(t, 1, inner.posts[actionPull])
+}
+
+func TestMemoryCache(t *testing.T) {
+	now := time.Now()
+	store := NewMemoryCache()
+	store.now = func() time.Time { return now }
+
+	key := CacheKey{Scope: "device1", ResourceURI: cacheTestResource, Request: "request"}
+	otherScope := CacheKey{Scope: "device2", ResourceURI: cacheTestResource, Request: "request"}
+
+	_, ok := store.Get(key)
+	assert.False(t, ok)
+
+	response := []byte("response")
+	store.Set(key, response, time.Minute)
+	store.Set(otherScope, []byte("other"), time.Minute)
+	response[0] = 'X'
+
+	cached, ok := store.Get(key)
+	assert.True(t, ok)
+	assert.Equal(t, "response", string(cached))
+
+	store.Invalidate("device1", cacheTestResource)
+
+	_, ok = store.Get(key)
+	assert.False(t, ok)
+
+	_, ok = store.Get(otherScope)
+	assert.True(t, ok)
+
+	now = now.Add(time.Minute)
+
+	_, ok = store.Get(otherScope)
+	assert.False(t, ok)
+}
@@ -0,0 +1,70 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package client
+
+import (
+	"fmt"
+	"io"
+)
+
+// DefaultMaxResponseSize is the largest response body accepted when Parameters.MaxResponseSize is not set.
+const DefaultMaxResponseSize = 32 << 20
+
+// Streamer is implemented by clients that can hand out a response body before it has been read into memory.
+type Streamer interface {
+	PostReader(msg string) (io.ReadCloser, error)
+}
+
+// ResponseTooLargeError is returned when a response body exceeds the maximum response size.
+type ResponseTooLargeError struct {
+	Limit int64
+}
+
+func (e *ResponseTooLargeError) Error() string {
+	return fmt.Sprintf("wsman response exceeds the maximum size of %d bytes", e.Limit)
+}
+
+func maxResponseSize(configured int64) int64 {
+	if configured == 0 {
+		return DefaultMaxResponseSize
+	}
+
+	return configured
+}
+
+// limitedBody fails reads with a *ResponseTooLargeError once more than limit bytes have been read.
+type limitedBody struct {
+	io.ReadCloser
+	limit     int64
+	remaining int64
+}
+
+func limitBody(body io.ReadCloser, limit int64) io.ReadCloser {
+	if limit < 0 {
+		return body
+	}
+
+	return &limitedBody{ReadCloser: body, limit: limit, remaining: limit}
+}
+
+func (l *limitedBody) Read(p []byte) (int, error) {
+	// read one byte past the limit so that a body of exactly limit bytes is accepted
+	if int64(len(p)) > l.remaining+1 {
+		p = p[:l.remaining+1]
+	}
+
+	n, err := l.ReadCloser.Read(p)
+	if int64(n) > l.remaining {
+		n = int(l.remaining)
+		l.remaining = 0
+
+		return n, &ResponseTooLargeError{Limit: l.limit}
+	}
+
+	l.remaining -= int64(n)
+
+	return n, err
+}
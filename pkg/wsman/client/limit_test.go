@@ -0,0 +1,117 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSizedResponseServer(t *testing.T, size int) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentType)
+		w.WriteHeader(http.StatusOK)
+
+		_, err := w.Write([]byte(strings.Repeat("a", size)))
+		if err != nil {
+			t.Errorf("Unexpected error during write: %v", err)
+		}
+	}))
+}
+
+func TestClient_PostMaxResponseSize(t *testing.T) {
+	ts := newSizedResponseServer(t, 1024)
+	defer ts.Close()
+
+	tests := []struct {
+		name    string
+		limit   int64
+		wantErr bool
+	}{
+		{"default limit", 0, false},
+		{"exact limit", 1024, false},
+		{"limit exceeded", 1023, true},
+		{"limit disabled", -1, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := NewWsman(Parameters{Target: ts.URL, MaxResponseSize: test.limit})
+			client.endpoint = ts.URL
+
+			response, err := client.Post(testMsg)
+			if !test.wantErr {
+				require.NoError(t, err)
+				assert.Len(t, response, 1024)
+
+				return
+			}
+
+			var tooLarge *ResponseTooLargeError
+
+			require.ErrorAs(t, err, &tooLarge)
+			assert.Equal(t, int64(1023), tooLarge.Limit)
+			assert.Equal(t, "wsman response exceeds the maximum size of 1023 bytes", err.Error())
+			assert.Nil(t, response)
+		})
+	}
+}
+
+func TestClient_PostReader(t *testing.T) {
+	ts := newSizedResponseServer(t, 4096)
+	defer ts.Close()
+
+	client := NewWsman(Parameters{Target: ts.URL, MaxResponseSize: 4000})
+	client.endpoint = ts.URL
+
+	body, err := client.PostReader(testMsg)
+	require.NoError(t, err)
+
+	defer body.Close()
+
+	buf := make([]byte, 3000)
+	n, err := io.ReadFull(body, buf)
+	require.NoError(t, err)
+	assert.Equal(t, 3000, n)
+
+	_, err = io.ReadAll(body)
+
+	var tooLarge *ResponseTooLargeError
+
+	assert.ErrorAs(t, err, &tooLarge)
+}
+
+func TestClient_PostReaderLogged(t *testing.T) {
+	ts := newSizedResponseServer(t, 16)
+	defer ts.Close()
+
+	client := NewWsman(Parameters{Target: ts.URL, LogAMTMessages: true, MaxResponseSize: 8})
+	client.endpoint = ts.URL
+
+	_, err := client.PostReader(testMsg)
+
+	var tooLarge *ResponseTooLargeError
+
+	assert.ErrorAs(t, err, &tooLarge)
+
+	client = NewWsman(Parameters{Target: ts.URL, LogAMTMessages: true})
+	client.endpoint = ts.URL
+
+	body, err := client.PostReader(testMsg)
+	require.NoError(t, err)
+
+	response, err := io.ReadAll(body)
+	assert.NoError(t, err)
+	assert.Equal(t, strings.Repeat("a", 16), string(response))
+}
@@ -0,0 +1,110 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package client
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ErrRelatesToMismatch is returned when the RelatesTo header of a response does not refer to the MessageID of the request.
+var ErrRelatesToMismatch = errors.New("response RelatesTo does not match request MessageID")
+
+// CheckRelatesTo verifies that the RelatesTo header of response refers to the MessageID header of request.
+// Requests without a MessageID are not checked.
+func CheckRelatesTo(request, response []byte) error {
+	messageID, err := headerValue(request, "MessageID")
+	if err != nil {
+		return fmt.Errorf("failed to read request MessageID: %w", err)
+	}
+
+	if messageID == "" {
+		return nil
+	}
+
+	relatesTo, err := headerValue(response, "RelatesTo")
+	if err != nil {
+		return fmt.Errorf("failed to read response RelatesTo: %w", err)
+	}
+
+	if relatesTo != messageID {
+		return fmt.Errorf("%w: expected %q, got %q", ErrRelatesToMismatch, messageID, relatesTo)
+	}
+
+	return nil
+}
+
+// checkRelatesToBody verifies the RelatesTo header of a streamed response like CheckRelatesTo. Only the header is read
+// from body; the returned reader hands out the whole response, including the part that was read.
+func checkRelatesToBody(request []byte, body io.ReadCloser) (io.ReadCloser, error) {
+	messageID, err := headerValue(request, "MessageID")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request MessageID: %w", err)
+	}
+
+	if messageID == "" {
+		return body, nil
+	}
+
+	var head bytes.Buffer
+
+	relatesTo, err := readHeaderValue(io.TeeReader(body, &head), "RelatesTo")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response RelatesTo: %w", err)
+	}
+
+	if relatesTo != messageID {
+		return nil, fmt.Errorf("%w: expected %q, got %q", ErrRelatesToMismatch, messageID, relatesTo)
+	}
+
+	return struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(&head, body), body}, nil
+}
+
+// headerValue returns the trimmed text of the first SOAP header element with the given local name.
+// It stops reading at the start of the Body.
+func headerValue(envelope []byte, name string) (string, error) {
+	return readHeaderValue(bytes.NewReader(envelope), name)
+}
+
+func readHeaderValue(r io.Reader, name string) (string, error) {
+	decoder := xml.NewDecoder(r)
+
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return "", nil
+			}
+
+			return "", err
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		switch start.Name.Local {
+		case "Body":
+			return "", nil
+		case name:
+			var value string
+
+			if err := decoder.DecodeElement(&value, &start); err != nil {
+				return "", err
+			}
+
+			return strings.TrimSpace(value), nil
+		}
+	}
+}
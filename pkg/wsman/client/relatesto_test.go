@@ -0,0 +1,120 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package client
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	relatesToRequest  = `<Envelope xmlns:a="http://schemas.xmlsoap.org/ws/2004/08/addressing"><Header><a:Action>Get</a:Action><a:MessageID>7</a:MessageID></Header><Body></Body></Envelope>`
+	relatesToResponse = `<a:Envelope xmlns:a="http://www.w3.org/2003/05/soap-envelope" xmlns:b="http://schemas.xmlsoap.org/ws/2004/08/addressing"><a:Header><b:MessageID>uuid:00000000-8086-8086-8086-000000000001</b:MessageID><b:RelatesTo>%s</b:RelatesTo></a:Header><a:Body></a:Body></a:Envelope>`
+)
+
+func TestCheckRelatesTo(t *testing.T) {
+	tests := []struct {
+		name     string
+		request  string
+		response string
+		err      error
+		wantErr  bool
+	}{
+		{"matching RelatesTo", relatesToRequest, `<a:Envelope xmlns:a="x" xmlns:b="y"><a:Header><b:RelatesTo>7</b:RelatesTo></a:Header></a:Envelope>`, nil, false},
+		{"mismatched RelatesTo", relatesToRequest, `<a:Envelope xmlns:a="x" xmlns:b="y"><a:Header><b:RelatesTo>6</b:RelatesTo></a:Header></a:Envelope>`, ErrRelatesToMismatch, true},
+		{"missing RelatesTo", relatesToRequest, `<a:Envelope xmlns:a="x"><a:Header></a:Header><a:Body><b:RelatesTo xmlns:b="y">7</b:RelatesTo></a:Body></a:Envelope>`, ErrRelatesToMismatch, true},
+		{"request without MessageID", `<Envelope><Header></Header></Envelope>`, `<Envelope></Envelope>`, nil, false},
+		{"malformed request", `<Envelope><Header>`, ``, nil, true},
+		{"malformed response", relatesToRequest, `<Envelope><Header><RelatesTo>7</Header>`, nil, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := CheckRelatesTo([]byte(test.request), []byte(test.response))
+			if !test.wantErr {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.Error(t, err)
+
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err)
+			}
+		})
+	}
+}
+
+func TestClient_PostValidateRelatesTo(t *testing.T) {
+	relatesTo := "7"
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentType)
+		w.WriteHeader(http.StatusOK)
+
+		_, err := w.Write([]byte(fmt.Sprintf(relatesToResponse, relatesTo)))
+		if err != nil {
+			t.Errorf("Unexpected error during write: %v", err)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewWsman(Parameters{Target: ts.URL, ValidateRelatesTo: true})
+	client.endpoint = ts.URL
+
+	response, err := client.Post(relatesToRequest)
+	assert.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf(relatesToResponse, "7"), string(response))
+
+	relatesTo = "8"
+
+	response, err = client.Post(relatesToRequest)
+	assert.ErrorIs(t, err, ErrRelatesToMismatch)
+	assert.Nil(t, response)
+}
+
+func TestClient_PostReaderValidateRelatesTo(t *testing.T) {
+	relatesTo := "7"
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentType)
+		w.WriteHeader(http.StatusOK)
+
+		_, err := w.Write([]byte(fmt.Sprintf(relatesToResponse, relatesTo)))
+		if err != nil {
+			t.Errorf("Unexpected error during write: %v", err)
+		}
+	}))
+	defer ts.Close()
+
+	for _, logged := range []bool{false, true} {
+		client := NewWsman(Parameters{Target: ts.URL, ValidateRelatesTo: true, LogAMTMessages: logged})
+		client.endpoint = ts.URL
+
+		relatesTo = "7"
+
+		body, err := client.PostReader(relatesToRequest)
+		require.NoError(t, err)
+
+		response, err := io.ReadAll(body)
+		require.NoError(t, err)
+		require.NoError(t, body.Close())
+		assert.Equal(t, fmt.Sprintf(relatesToResponse, "7"), string(response))
+
+		relatesTo = "8"
+
+		body, err = client.PostReader(relatesToRequest)
+		assert.ErrorIs(t, err, ErrRelatesToMismatch)
+		assert.Nil(t, body)
+	}
+}
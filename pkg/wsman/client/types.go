@@ -28,4 +28,7 @@ type Parameters struct {
 	AllowInsecureCipherSuites bool
 	IsCIRA                    bool               // Flag to indicate CIRA APF tunnel connection
 	CIRAManager               CIRAChannelManager // Manager for CIRA channel operations
+	ValidateRelatesTo         bool               // Reject responses whose RelatesTo does not match the request MessageID
+	MaxResponseSize           int64              // Maximum response body size in bytes; 0 uses DefaultMaxResponseSize, negative disables the limit
+	Cache                     *CacheOptions      // Serve responses of read-mostly classes from a cache; nil disables caching
 }
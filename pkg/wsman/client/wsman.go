@@ -70,6 +70,8 @@ type Target struct {
 	InsecureSkipVerify bool
 	PinnedCert         string
 	tlsConfig          *tls.Config
+	validateRelatesTo  bool
+	maxResponseSize    int64
 }
 
 const timeout = 10 * time.Second
@@ -97,6 +99,8 @@ func NewWsman(cp Parameters) *Target {
 		InsecureSkipVerify: cp.SelfSignedAllowed,
 		conn:               cp.Connection,
 		tlsConfig:          cp.TlsConfig,
+		validateRelatesTo:  cp.ValidateRelatesTo,
+		maxResponseSize:    maxResponseSize(cp.MaxResponseSize),
 	}
 
 	res.Timeout = timeout
@@ -222,6 +226,26 @@ func (t *Target) GetServerCertificate() (*tls.Certificate, error) {
 
 // Post overrides http.Client's Post method.
 func (t *Target) Post(msg string) (response []byte, err error) {
+	body, err := t.PostReader(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	defer body.Close()
+
+	response, err = io.ReadAll(body)
+	if err != nil && err.Error() != io.EOF.Error() {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// PostReader sends msg like Post but returns the response body without reading it, so that large responses can be
+// decoded as they arrive. Reads fail with a *ResponseTooLargeError once the body exceeds the maximum response size.
+// The caller must close the body. When ValidateRelatesTo is set, the response header is checked before the body is
+// returned.
+func (t *Target) PostReader(msg string) (io.ReadCloser, error) {
 	msgBody := []byte(msg)
 
 	var auth string
@@ -260,6 +284,8 @@ func (t *Target) Post(msg string) (response []byte, err error) {
 	}
 
 	if t.useDigest && res.StatusCode == 401 {
+		res.Body.Close()
+
 		if err := t.challenge.parseChallenge(res.Header.Get("WWW-Authenticate")); err != nil {
 			return nil, err
 		}
@@ -285,9 +311,27 @@ func (t *Target) Post(msg string) (response []byte, err error) {
 		}
 	}
 
-	defer res.Body.Close()
+	body := limitBody(res.Body, t.maxResponseSize)
+
+	if !t.logAMTMessages && res.StatusCode < 400 {
+		if !t.validateRelatesTo {
+			return body, nil
+		}
+
+		checked, err := checkRelatesToBody(msgBody, body)
+		if err != nil {
+			body.Close()
+
+			return nil, err
+		}
+
+		return checked, nil
+	}
+
+	// error responses and logged responses are read in full
+	defer body.Close()
 
-	response, err = io.ReadAll(res.Body)
+	response, err := io.ReadAll(body)
 
 	if t.logAMTMessages {
 		logrus.Trace(string(response))
@@ -309,7 +353,13 @@ func (t *Target) Post(msg string) (response []byte, err error) {
 		return nil, fmt.Errorf("%w: %v\n%v", errPostResponse, res.Status, string(response))
 	}
 
-	return response, nil
+	if t.validateRelatesTo {
+		if err := CheckRelatesTo(msgBody, response); err != nil {
+			return nil, err
+		}
+	}
+
+	return io.NopCloser(bytes.NewReader(response)), nil
 }
 
 // ProxyURL sets proxy address for the underlying Transport if supported.
@@ -0,0 +1,377 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package wsman
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/messagelog"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/base"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/hostbootreason"
+)
+
+// ValueNotFound is returned by the String methods of the values that are not known.
+const ValueNotFound = "Value not found in map"
+
+// Kinds of the events in a boot timeline.
+const (
+	BootEventBootOptions      BootEventKind = iota // Boot options were set remotely
+	BootEventPowerAction                           // A remote power action, such as a power up or a reset
+	BootEventFirmwareStarted                       // The BIOS started
+	BootEventFirmwareProgress                      // The BIOS reported a progress code
+	BootEventFirmwareError                         // The BIOS reported an error code
+	BootEventNoBootableMedia                       // No bootable media was found
+	BootEventBootFailure                           // The boot failed
+	BootEventWatchdog                              // An agent watchdog changed state
+	BootEventOSLockup                              // The operating system stopped responding
+)
+
+// bootEventKindToString is a map of BootEventKind values to their string representation.
+var bootEventKindToString = map[BootEventKind]string{
+	BootEventBootOptions:      "BootOptions",
+	BootEventPowerAction:      "PowerAction",
+	BootEventFirmwareStarted:  "FirmwareStarted",
+	BootEventFirmwareProgress: "FirmwareProgress",
+	BootEventFirmwareError:    "FirmwareError",
+	BootEventNoBootableMedia:  "NoBootableMedia",
+	BootEventBootFailure:      "BootFailure",
+	BootEventWatchdog:         "Watchdog",
+	BootEventOSLockup:         "OSLockup",
+}
+
+// String returns the string representation of the BootEventKind value.
+func (k BootEventKind) String() string {
+	if value, exists := bootEventKindToString[k]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+// Outcomes of a power cycle.
+const (
+	BootOutcomeUnknown    BootOutcome = iota // Nothing shows how the cycle ended
+	BootOutcomeInProgress                    // The BIOS is still booting the current cycle
+	BootOutcomeBooted                        // The BIOS handed over to the operating system
+	BootOutcomeFailed                        // The BIOS reported an error or could not boot
+	BootOutcomeHung                          // The boot stopped before the operating system started, or the operating system locked up
+)
+
+// bootOutcomeToString is a map of BootOutcome values to their string representation.
+var bootOutcomeToString = map[BootOutcome]string{
+	BootOutcomeUnknown:    "Unknown",
+	BootOutcomeInProgress: "InProgress",
+	BootOutcomeBooted:     "Booted",
+	BootOutcomeFailed:     "Failed",
+	BootOutcomeHung:       "Hung",
+}
+
+// String returns the string representation of the BootOutcome value.
+func (o BootOutcome) String() string {
+	if value, exists := bootOutcomeToString[o]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+// Event log sensor types and codes used to build a boot timeline.
+const (
+	sensorSystemFirmware   = 15
+	sensorWatchdog         = 18
+	sensorNoBootableMedia  = 30
+	sensorOSLockup         = 32
+	sensorBootFailure      = 35
+	sensorFirmwareStarted  = 37
+	watchdogEventData      = 170
+	invalidEventData       = 235
+	progressOSWakeVector   = 18
+	progressStartingOSBoot = 19
+)
+
+// Audit log remote control event used to build a boot timeline. The other remote control events are power actions.
+const auditEventSetBootOptions = 4
+
+// BIOSLastStatus general status words of AMT_BootSettingData.
+const (
+	biosStatusSuccess    = 0
+	biosStatusInProgress = 1
+	biosStatusFailed     = 0xFFFF
+)
+
+type (
+	// BootEventKind is the kind of a BootEvent.
+	BootEventKind int
+	// BootOutcome is how a power cycle ended.
+	BootOutcome int
+)
+
+// BootDiagnostics holds the records that NewBootTimeline merges. Messages.BootTimeline reads them from the device.
+type BootDiagnostics struct {
+	BootReason     hostbootreason.HostBootReasonResponse
+	Events         []messagelog.RefinedEventData
+	AuditRecords   []auditlog.AuditLogRecord
+	BIOSLastStatus []uint16 // BIOSLastStatus of AMT_BootSettingData
+}
+
+// BootEvent is one entry of a boot timeline.
+type BootEvent struct {
+	Time        time.Time
+	Kind        BootEventKind
+	Code        int    // The progress or error code of firmware events, the remote control event ID of audit log events
+	Description string // The decoded event, as in the event or audit log
+}
+
+// BootCycle is the events of one power cycle, oldest first.
+type BootCycle struct {
+	Events  []BootEvent
+	Outcome BootOutcome
+	// LastProgress is the last progress code the BIOS reported in the cycle, or nil when it reported none. When the
+	// boot hung, this is the step that did not complete.
+	LastProgress *BootEvent
+	// Failure is the event that ended a failed or hung cycle, or nil.
+	Failure *BootEvent
+}
+
+// BootTimeline is the boot history of a device, split per power cycle, oldest first.
+type BootTimeline struct {
+	Reason          hostbootreason.Reason  // Why the host booted last
+	ReasonDetails   string                 // Details of Reason, as reported by the firmware
+	PreviousSxState hostbootreason.SxState // The system state before the last boot
+	Cycles          []BootCycle
+}
+
+// Last returns the most recent power cycle, or nil when the timeline is empty.
+func (t BootTimeline) Last() *BootCycle {
+	if len(t.Cycles) == 0 {
+		return nil
+	}
+
+	return &t.Cycles[len(t.Cycles)-1]
+}
+
+// BootTimeline reads IPS_HostBootReason, the event log, the audit log and AMT_BootSettingData and merges them into a
+// boot timeline with NewBootTimeline.
+func (m Messages) BootTimeline(opts ...base.HeaderOption) (BootTimeline, error) {
+	var diagnostics BootDiagnostics
+
+	reason, err := m.IPS.HostBootReason.Get(opts...)
+	if err != nil {
+		return BootTimeline{}, fmt.Errorf("failed to read the host boot reason: %w", err)
+	}
+
+	diagnostics.BootReason = reason.Body.GetResponse
+
+	for event, err := range m.AMT.MessageLog.Records(opts...) {
+		if err != nil {
+			return BootTimeline{}, fmt.Errorf("failed to read the event log: %w", err)
+		}
+
+		diagnostics.Events = append(diagnostics.Events, event)
+	}
+
+	for entry, err := range m.AMT.AuditLog.RecordsSince(auditlog.Cursor{}, opts...) {
+		if err != nil {
+			return BootTimeline{}, fmt.Errorf("failed to read the audit log: %w", err)
+		}
+
+		diagnostics.AuditRecords = append(diagnostics.AuditRecords, entry.Record)
+	}
+
+	bootSettings, err := m.AMT.BootSettingData.Get(opts...)
+	if err != nil {
+		return BootTimeline{}, fmt.Errorf("failed to read the boot settings: %w", err)
+	}
+
+	diagnostics.BIOSLastStatus = bootSettings.Body.BootSettingDataGetResponse.BIOSLastStatus
+
+	return NewBootTimeline(diagnostics), nil
+}
+
+// NewBootTimeline merges the boot related records of d into a timeline ordered by time and splits it per power cycle.
+// A cycle starts with the remote control events that lead to a boot, followed by the BIOS start and the progress,
+// error and failure events of the boot; an event that belongs to an earlier step than one already seen starts the
+// next cycle. Records that are not boot related are ignored. The boot reason and BIOSLastStatus describe the last
+// cycle only.
+func NewBootTimeline(d BootDiagnostics) BootTimeline {
+	timeline := BootTimeline{
+		Reason:          d.BootReason.Reason,
+		ReasonDetails:   d.BootReason.ReasonDetails,
+		PreviousSxState: d.BootReason.PreviousSxState,
+	}
+
+	var events []BootEvent
+
+	for _, event := range d.Events {
+		if bootEvent, ok := bootEventFromLog(event); ok {
+			events = append(events, bootEvent)
+		}
+	}
+
+	for _, record := range d.AuditRecords {
+		if bootEvent, ok := bootEventFromAudit(record); ok {
+			events = append(events, bootEvent)
+		}
+	}
+
+	slices.SortStableFunc(events, func(a, b BootEvent) int {
+		return a.Time.Compare(b.Time)
+	})
+
+	var (
+		cycle BootCycle
+		step  = -1
+	)
+
+	for _, event := range events {
+		eventStep := bootStep(event.Kind)
+		if len(cycle.Events) > 0 && eventStep < bootStepBoot && eventStep <= step {
+			timeline.Cycles = append(timeline.Cycles, cycle)
+			cycle, step = BootCycle{}, -1
+		}
+
+		cycle.Events = append(cycle.Events, event)
+		step = max(step, eventStep)
+	}
+
+	if len(cycle.Events) > 0 {
+		timeline.Cycles = append(timeline.Cycles, cycle)
+	}
+
+	for i := range timeline.Cycles {
+		last := i == len(timeline.Cycles)-1
+		timeline.Cycles[i].summarize(last, d.BIOSLastStatus)
+	}
+
+	return timeline
+}
+
+// Steps of a power cycle, in the order they happen.
+const (
+	bootStepOptions = iota
+	bootStepPowerAction
+	bootStepFirmwareStarted
+	bootStepBoot
+)
+
+func bootStep(kind BootEventKind) int {
+	switch kind {
+	case BootEventBootOptions:
+		return bootStepOptions
+	case BootEventPowerAction:
+		return bootStepPowerAction
+	case BootEventFirmwareStarted:
+		return bootStepFirmwareStarted
+	case BootEventFirmwareProgress, BootEventFirmwareError, BootEventNoBootableMedia, BootEventBootFailure,
+		BootEventWatchdog, BootEventOSLockup:
+		return bootStepBoot
+	}
+
+	return bootStepBoot
+}
+
+// summarize sets the outcome of the cycle from its events. The outcome of the last cycle falls back to
+// BIOSLastStatus, which only describes the most recent boot.
+func (c *BootCycle) summarize(last bool, biosLastStatus []uint16) {
+	booted, started := false, false
+
+	for i := range c.Events {
+		event := &c.Events[i]
+
+		switch event.Kind {
+		case BootEventFirmwareStarted:
+			started = true
+		case BootEventFirmwareProgress:
+			started = true
+			c.LastProgress = event
+
+			if event.Code == progressOSWakeVector || event.Code == progressStartingOSBoot {
+				booted = true
+			}
+		case BootEventFirmwareError, BootEventNoBootableMedia, BootEventBootFailure:
+			c.Outcome, c.Failure = BootOutcomeFailed, event
+		case BootEventOSLockup:
+			if c.Outcome != BootOutcomeFailed {
+				c.Outcome, c.Failure = BootOutcomeHung, event
+			}
+		case BootEventBootOptions, BootEventPowerAction, BootEventWatchdog:
+		}
+	}
+
+	switch {
+	case c.Outcome != BootOutcomeUnknown:
+	case booted:
+		c.Outcome = BootOutcomeBooted
+	case last && len(biosLastStatus) > 0:
+		c.Outcome = biosOutcome(biosLastStatus[0])
+	case started && !last:
+		// a later cycle began before this one reached the operating system
+		c.Outcome = BootOutcomeHung
+	}
+}
+
+func biosOutcome(status uint16) BootOutcome {
+	switch status {
+	case biosStatusSuccess:
+		return BootOutcomeBooted
+	case biosStatusInProgress:
+		return BootOutcomeInProgress
+	case biosStatusFailed:
+		return BootOutcomeFailed
+	}
+
+	return BootOutcomeUnknown
+}
+
+func bootEventFromLog(event messagelog.RefinedEventData) (BootEvent, bool) {
+	bootEvent := BootEvent{Time: event.TimeStamp, Description: event.Description}
+
+	switch event.EventSensorType {
+	case sensorSystemFirmware:
+		if len(event.EventData) < 2 || event.EventData[0] == invalidEventData {
+			return BootEvent{}, false
+		}
+
+		bootEvent.Kind, bootEvent.Code = BootEventFirmwareProgress, int(event.EventData[1])
+		if event.EventOffset == 0 {
+			bootEvent.Kind = BootEventFirmwareError
+		}
+	case sensorWatchdog:
+		if len(event.EventData) == 0 || event.EventData[0] != watchdogEventData {
+			return BootEvent{}, false
+		}
+
+		bootEvent.Kind = BootEventWatchdog
+	case sensorNoBootableMedia:
+		bootEvent.Kind = BootEventNoBootableMedia
+	case sensorOSLockup:
+		bootEvent.Kind = BootEventOSLockup
+	case sensorBootFailure:
+		bootEvent.Kind = BootEventBootFailure
+	case sensorFirmwareStarted:
+		bootEvent.Kind = BootEventFirmwareStarted
+	default:
+		return BootEvent{}, false
+	}
+
+	return bootEvent, true
+}
+
+func bootEventFromAudit(record auditlog.AuditLogRecord) (BootEvent, bool) {
+	if record.AuditAppID != auditlog.RemoteControl {
+		return BootEvent{}, false
+	}
+
+	kind := BootEventPowerAction
+	if record.EventID == auditEventSetBootOptions {
+		kind = BootEventBootOptions
+	}
+
+	return BootEvent{Time: record.Time, Kind: kind, Code: record.EventID, Description: record.ExStr}, true
+}
@@ -0,0 +1,171 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package wsman
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/messagelog"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/hostbootreason"
+)
+
+var bootStart = time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+
+func at(seconds int) time.Time {
+	return bootStart.Add(time.Duration(seconds) * time.Second)
+}
+
+func firmwareEvent(seconds int, offset, code uint8) messagelog.RefinedEventData {
+	return messagelog.RefinedEventData{TimeStamp: at(seconds), EventSensorType: 15, EventOffset: offset, EventData: []uint8{0, code}}
+}
+
+func sensorEvent(seconds int, sensorType uint8) messagelog.RefinedEventData {
+	return messagelog.RefinedEventData{TimeStamp: at(seconds), EventSensorType: sensorType, EventData: []uint8{0}}
+}
+
+func remoteControl(seconds, eventID int) auditlog.AuditLogRecord {
+	return auditlog.AuditLogRecord{AuditAppID: auditlog.RemoteControl, EventID: eventID, Time: at(seconds)}
+}
+
+func kinds(cycle BootCycle) []BootEventKind {
+	result := make([]BootEventKind, 0, len(cycle.Events))
+
+	for _, event := range cycle.Events {
+		result = append(result, event.Kind)
+	}
+
+	return result
+}
+
+func TestBootEventKind_String(t *testing.T) {
+	assert.Equal(t, "FirmwareProgress", BootEventFirmwareProgress.String())
+	assert.Equal(t, "NoBootableMedia", BootEventNoBootableMedia.String())
+	assert.Equal(t, ValueNotFound, BootEventKind(99).String())
+}
+
+func TestBootOutcome_String(t *testing.T) {
+	assert.Equal(t, "Hung", BootOutcomeHung.String())
+	assert.Equal(t, ValueNotFound, BootOutcome(99).String())
+}
+
+func TestNewBootTimeline(t *testing.T) {
+	t.Run("splits the records per power cycle", func(t *testing.T) {
+		timeline := NewBootTimeline(BootDiagnostics{
+			BootReason: hostbootreason.HostBootReasonResponse{Reason: hostbootreason.ReasonRemoteControl, ReasonDetails: "Reset", PreviousSxState: hostbootreason.SxStateS0},
+			Events: []messagelog.RefinedEventData{
+				// the event log is not in time order
+				firmwareEvent(130, 2, 1),
+				sensorEvent(120, 37),
+				firmwareEvent(20, 2, 19),
+				sensorEvent(10, 37),
+				firmwareEvent(15, 2, 1),
+				{TimeStamp: at(16), EventSensorType: 6, EventData: []uint8{0, 1, 0}},
+			},
+			AuditRecords: []auditlog.AuditLogRecord{
+				remoteControl(100, 4),
+				remoteControl(110, 3),
+				{AuditAppID: auditlog.SecurityAdmin, EventID: 0, Time: at(105)},
+			},
+			BIOSLastStatus: []uint16{1, 0},
+		})
+
+		assert.Equal(t, hostbootreason.ReasonRemoteControl, timeline.Reason)
+		assert.Equal(t, "Reset", timeline.ReasonDetails)
+		require.Len(t, timeline.Cycles, 2)
+
+		first := timeline.Cycles[0]
+		assert.Equal(t, []BootEventKind{BootEventFirmwareStarted, BootEventFirmwareProgress, BootEventFirmwareProgress}, kinds(first))
+		assert.Equal(t, BootOutcomeBooted, first.Outcome)
+		assert.Equal(t, 19, first.LastProgress.Code)
+
+		last := timeline.Last()
+		assert.Equal(t, []BootEventKind{BootEventBootOptions, BootEventPowerAction, BootEventFirmwareStarted, BootEventFirmwareProgress}, kinds(*last))
+		assert.Equal(t, 3, last.Events[1].Code)
+		assert.Equal(t, BootOutcomeInProgress, last.Outcome)
+		assert.Equal(t, 1, last.LastProgress.Code)
+	})
+
+	t.Run("marks a cycle that stopped before the operating system as hung", func(t *testing.T) {
+		timeline := NewBootTimeline(BootDiagnostics{
+			Events: []messagelog.RefinedEventData{
+				sensorEvent(0, 37),
+				firmwareEvent(5, 2, 1),
+				firmwareEvent(8, 2, 9),
+				sensorEvent(60, 37),
+				firmwareEvent(65, 2, 19),
+			},
+		})
+
+		require.Len(t, timeline.Cycles, 2)
+		assert.Equal(t, BootOutcomeHung, timeline.Cycles[0].Outcome)
+		assert.Equal(t, 9, timeline.Cycles[0].LastProgress.Code)
+		assert.Nil(t, timeline.Cycles[0].Failure)
+		assert.Equal(t, BootOutcomeBooted, timeline.Cycles[1].Outcome)
+	})
+
+	t.Run("reports firmware failures", func(t *testing.T) {
+		timeline := NewBootTimeline(BootDiagnostics{
+			Events: []messagelog.RefinedEventData{
+				sensorEvent(0, 37),
+				firmwareEvent(5, 2, 2),
+				sensorEvent(9, 30),
+				sensorEvent(30, 37),
+				firmwareEvent(31, 0, 1),
+				sensorEvent(50, 37),
+				firmwareEvent(55, 2, 19),
+				sensorEvent(300, 32),
+			},
+			BIOSLastStatus: []uint16{0, 0},
+		})
+
+		require.Len(t, timeline.Cycles, 3)
+		assert.Equal(t, BootOutcomeFailed, timeline.Cycles[0].Outcome)
+		assert.Equal(t, BootEventNoBootableMedia, timeline.Cycles[0].Failure.Kind)
+		assert.Equal(t, 2, timeline.Cycles[0].LastProgress.Code)
+		assert.Equal(t, BootOutcomeFailed, timeline.Cycles[1].Outcome)
+		assert.Equal(t, BootEventFirmwareError, timeline.Cycles[1].Failure.Kind)
+		assert.Nil(t, timeline.Cycles[1].LastProgress)
+		assert.Equal(t, BootOutcomeHung, timeline.Cycles[2].Outcome)
+		assert.Equal(t, BootEventOSLockup, timeline.Cycles[2].Failure.Kind)
+	})
+
+	t.Run("falls back to the BIOS status for the last cycle", func(t *testing.T) {
+		timeline := NewBootTimeline(BootDiagnostics{
+			AuditRecords:   []auditlog.AuditLogRecord{remoteControl(0, 0)},
+			BIOSLastStatus: []uint16{0xFFFF, 1},
+		})
+
+		require.Len(t, timeline.Cycles, 1)
+		assert.Equal(t, BootOutcomeFailed, timeline.Last().Outcome)
+	})
+
+	t.Run("ignores unrelated records", func(t *testing.T) {
+		timeline := NewBootTimeline(BootDiagnostics{
+			Events: []messagelog.RefinedEventData{
+				sensorEvent(0, 6),
+				{TimeStamp: at(1), EventSensorType: 18, EventData: []uint8{0}},
+				{TimeStamp: at(2), EventSensorType: 15},
+				// invalid data, as decoded by messagelog
+				{TimeStamp: at(3), EventSensorType: 15, EventData: []uint8{235, 1}},
+			},
+		})
+
+		assert.Empty(t, timeline.Cycles)
+		assert.Nil(t, timeline.Last())
+	})
+}
+
+func TestMessages_BootTimeline(t *testing.T) {
+	wsmanClient := &hardeningClient{failClass: "IPS_HostBootReason"}
+
+	_, err := newHardeningMessages(wsmanClient).BootTimeline()
+	assert.ErrorContains(t, err, "failed to read the host boot reason")
+}
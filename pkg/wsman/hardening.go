@@ -0,0 +1,184 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package wsman
+
+import (
+	"fmt"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/redirection"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/webui"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/base"
+)
+
+// Interfaces reported by ApplyHardeningProfile.
+const (
+	InterfaceWebUI               = "WebUI"               // The web UI of AMT_WebUIService
+	InterfaceRMCPPing            = "RMCPPing"            // The RMCP ping responder of AMT_GeneralSettings
+	InterfaceDDNS                = "DDNS"                // The Dynamic DNS update client of AMT_GeneralSettings
+	InterfaceRedirectionListener = "RedirectionListener" // The SOL and IDER listener of AMT_RedirectionService
+	InterfaceKVMPort5900         = "KVMPort5900"         // The standard VNC port of IPS_KVMRedirectionSettingData
+)
+
+// HardeningProfile selects the interfaces that ApplyHardeningProfile disables. The other interfaces are reported but
+// left as they are.
+type HardeningProfile struct {
+	DisableWebUI               bool
+	DisableRMCPPing            bool
+	DisableDDNS                bool
+	DisableRedirectionListener bool
+	DisableKVMPort5900         bool
+}
+
+// DefaultHardeningProfile disables every interface.
+var DefaultHardeningProfile = HardeningProfile{
+	DisableWebUI:               true,
+	DisableRMCPPing:            true,
+	DisableDDNS:                true,
+	DisableRedirectionListener: true,
+	DisableKVMPort5900:         true,
+}
+
+// InterfaceState is whether an interface was enabled before and after ApplyHardeningProfile.
+type InterfaceState struct {
+	Interface string
+	Before    bool
+	After     bool
+}
+
+// ApplyHardeningProfile disables the interfaces selected by the profile and returns the state of every interface
+// before and after. Each service is only written when one of its interfaces changes. On error, the states of the
+// interfaces handled so far are returned.
+func (m Messages) ApplyHardeningProfile(profile HardeningProfile, opts ...base.HeaderOption) ([]InterfaceState, error) {
+	steps := []func(HardeningProfile, []base.HeaderOption) ([]InterfaceState, error){
+		m.hardenWebUI,
+		m.hardenGeneralSettings,
+		m.hardenRedirectionListener,
+		m.hardenKVMPort5900,
+	}
+
+	var states []InterfaceState
+
+	for _, step := range steps {
+		stepStates, err := step(profile, opts)
+		if err != nil {
+			return states, err
+		}
+
+		states = append(states, stepStates...)
+	}
+
+	return states, nil
+}
+
+func (m Messages) hardenWebUI(profile HardeningProfile, opts []base.HeaderOption) ([]InterfaceState, error) {
+	response, err := m.AMT.WebUIService.Get(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", InterfaceWebUI, err)
+	}
+
+	state := InterfaceState{Interface: InterfaceWebUI, Before: response.Body.GetResponse.EnabledState == webui.EnabledStateEnabled}
+	state.After = state.Before
+
+	if profile.DisableWebUI && state.Before {
+		if _, err := m.AMT.WebUIService.RequestStateChange(webui.RequestedStateDisabled, opts...); err != nil {
+			return nil, fmt.Errorf("%s: %w", InterfaceWebUI, err)
+		}
+
+		response, err = m.AMT.WebUIService.Get(opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", InterfaceWebUI, err)
+		}
+
+		state.After = response.Body.GetResponse.EnabledState == webui.EnabledStateEnabled
+	}
+
+	return []InterfaceState{state}, nil
+}
+
+func (m Messages) hardenGeneralSettings(profile HardeningProfile, opts []base.HeaderOption) ([]InterfaceState, error) {
+	response, err := m.AMT.GeneralSettings.Get(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", InterfaceRMCPPing, err)
+	}
+
+	settings := response.Body.GetResponse
+	rmcpPing := InterfaceState{Interface: InterfaceRMCPPing, Before: settings.RmcpPingResponseEnabled, After: settings.RmcpPingResponseEnabled}
+	ddns := InterfaceState{Interface: InterfaceDDNS, Before: settings.DDNSUpdateEnabled, After: settings.DDNSUpdateEnabled}
+
+	disableRMCPPing := profile.DisableRMCPPing && rmcpPing.Before
+	disableDDNS := profile.DisableDDNS && ddns.Before
+
+	if disableRMCPPing || disableDDNS {
+		request := settings.ToRequest()
+		request.RmcpPingResponseEnabled = settings.RmcpPingResponseEnabled && !disableRMCPPing
+		request.DDNSUpdateEnabled = settings.DDNSUpdateEnabled && !disableDDNS
+
+		response, err = m.AMT.GeneralSettings.Put(&request, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%s, %s: %w", InterfaceRMCPPing, InterfaceDDNS, err)
+		}
+
+		rmcpPing.After = response.Body.GetResponse.RmcpPingResponseEnabled
+		ddns.After = response.Body.GetResponse.DDNSUpdateEnabled
+	}
+
+	return []InterfaceState{rmcpPing, ddns}, nil
+}
+
+func (m Messages) hardenRedirectionListener(profile HardeningProfile, opts []base.HeaderOption) ([]InterfaceState, error) {
+	response, err := m.AMT.RedirectionService.Get(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", InterfaceRedirectionListener, err)
+	}
+
+	service := response.Body.GetAndPutResponse
+	state := InterfaceState{Interface: InterfaceRedirectionListener, Before: service.ListenerEnabled, After: service.ListenerEnabled}
+
+	if profile.DisableRedirectionListener && state.Before {
+		request := redirection.RedirectionRequest{
+			CreationClassName:       service.CreationClassName,
+			ElementName:             service.ElementName,
+			EnabledState:            service.EnabledState,
+			ListenerEnabled:         false,
+			Name:                    service.Name,
+			SystemCreationClassName: service.SystemCreationClassName,
+			SystemName:              service.SystemName,
+		}
+
+		response, err = m.AMT.RedirectionService.Put(&request, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", InterfaceRedirectionListener, err)
+		}
+
+		state.After = response.Body.GetAndPutResponse.ListenerEnabled
+	}
+
+	return []InterfaceState{state}, nil
+}
+
+func (m Messages) hardenKVMPort5900(profile HardeningProfile, opts []base.HeaderOption) ([]InterfaceState, error) {
+	response, err := m.IPS.KVMRedirectionSettingData.Get(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", InterfaceKVMPort5900, err)
+	}
+
+	settings := response.Body.KVMRedirectionSettingsResponse
+	state := InterfaceState{Interface: InterfaceKVMPort5900, Before: settings.Is5900PortEnabled, After: settings.Is5900PortEnabled}
+
+	if profile.DisableKVMPort5900 && state.Before {
+		request := settings.ToRequest()
+		request.Is5900PortEnabled = false
+
+		response, err = m.IPS.KVMRedirectionSettingData.Put(&request, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", InterfaceKVMPort5900, err)
+		}
+
+		state.After = response.Body.KVMRedirectionSettingsResponse.Is5900PortEnabled
+	}
+
+	return []InterfaceState{state}, nil
+}
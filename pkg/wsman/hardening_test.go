@@ -0,0 +1,150 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package wsman
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips"
+)
+
+var (
+	hardeningActionPattern   = regexp.MustCompile(`<a:Action>[^<]*/([A-Za-z]+)</a:Action>`)
+	hardeningResourcePattern = regexp.MustCompile(`<w:ResourceURI>[^<]*/([A-Za-z_]+)</w:ResourceURI>`)
+)
+
+// hardeningClient keeps the interface toggles of a device and answers Get, Put and RequestStateChange like AMT would.
+type hardeningClient struct {
+	client.WSMan
+
+	webUI, rmcpPing, ddns, listener, port5900 bool
+	puts                                      []string
+	putMessages                               map[string]string
+	failClass                                 string
+}
+
+func (c *hardeningClient) Post(msg string) ([]byte, error) {
+	action := hardeningActionPattern.FindStringSubmatch(msg)[1]
+	class := hardeningResourcePattern.FindStringSubmatch(msg)[1]
+
+	if class == c.failClass {
+		return nil, fmt.Errorf("%s unavailable", class)
+	}
+
+	field := func(name string) bool {
+		return regexp.MustCompile(`<h:` + name + `>true</h:` + name + `>`).MatchString(msg)
+	}
+
+	if action == "Put" {
+		c.puts = append(c.puts, class)
+
+		if c.putMessages == nil {
+			c.putMessages = map[string]string{}
+		}
+
+		c.putMessages[class] = msg
+	}
+
+	var body string
+
+	switch class {
+	case "AMT_WebUIService":
+		if action == "RequestStateChange" {
+			c.webUI = !regexp.MustCompile(`<h:RequestedState>3</h:RequestedState>`).MatchString(msg)
+
+			return []byte(`<Envelope><Header></Header><Body><RequestStateChange_OUTPUT><ReturnValue>0</ReturnValue></RequestStateChange_OUTPUT></Body></Envelope>`), nil
+		}
+
+		state := 3
+		if c.webUI {
+			state = 2
+		}
+
+		body = fmt.Sprintf(`<AMT_WebUIService><EnabledState>%d</EnabledState></AMT_WebUIService>`, state)
+	case "AMT_GeneralSettings":
+		if action == "Put" {
+			c.rmcpPing, c.ddns = field("RmcpPingResponseEnabled"), field("DDNSUpdateEnabled")
+		}
+
+		body = fmt.Sprintf(`<AMT_GeneralSettings><HostName>host</HostName><RmcpPingResponseEnabled>%t</RmcpPingResponseEnabled><DDNSUpdateEnabled>%t</DDNSUpdateEnabled></AMT_GeneralSettings>`, c.rmcpPing, c.ddns)
+	case "AMT_RedirectionService":
+		if action == "Put" {
+			c.listener = field("ListenerEnabled")
+		}
+
+		body = fmt.Sprintf(`<AMT_RedirectionService><EnabledState>32771</EnabledState><ListenerEnabled>%t</ListenerEnabled></AMT_RedirectionService>`, c.listener)
+	case "IPS_KVMRedirectionSettingData":
+		if action == "Put" {
+			c.port5900 = field("Is5900PortEnabled")
+		}
+
+		body = fmt.Sprintf(`<IPS_KVMRedirectionSettingData><Is5900PortEnabled>%t</Is5900PortEnabled></IPS_KVMRedirectionSettingData>`, c.port5900)
+	}
+
+	return []byte(`<Envelope><Header></Header><Body>` + body + `</Body></Envelope>`), nil
+}
+
+func newHardeningMessages(wsmanClient *hardeningClient) Messages {
+	return Messages{Client: wsmanClient, AMT: amt.NewMessages(wsmanClient), IPS: ips.NewMessages(wsmanClient)}
+}
+
+func TestMessages_ApplyHardeningProfile(t *testing.T) {
+	t.Run("disables every enabled interface", func(t *testing.T) {
+		wsmanClient := &hardeningClient{webUI: true, rmcpPing: true, ddns: true, listener: true, port5900: true}
+
+		states, err := newHardeningMessages(wsmanClient).ApplyHardeningProfile(DefaultHardeningProfile)
+		require.NoError(t, err)
+		assert.Equal(t, []InterfaceState{
+			{Interface: InterfaceWebUI, Before: true, After: false},
+			{Interface: InterfaceRMCPPing, Before: true, After: false},
+			{Interface: InterfaceDDNS, Before: true, After: false},
+			{Interface: InterfaceRedirectionListener, Before: true, After: false},
+			{Interface: InterfaceKVMPort5900, Before: true, After: false},
+		}, states)
+
+		// the RFB password is never read back, so it must not be written either
+		assert.NotContains(t, wsmanClient.putMessages["IPS_KVMRedirectionSettingData"], "RFBPassword")
+		// the general settings are written whole, including the booleans that are off
+		assert.Contains(t, wsmanClient.putMessages["AMT_GeneralSettings"], "<h:SharedFQDN>false</h:SharedFQDN>")
+		assert.Contains(t, wsmanClient.putMessages["AMT_GeneralSettings"], "<h:RmcpPingResponseEnabled>false</h:RmcpPingResponseEnabled>")
+	})
+
+	t.Run("leaves interfaces outside the profile enabled", func(t *testing.T) {
+		wsmanClient := &hardeningClient{webUI: true, rmcpPing: true, ddns: true, listener: true, port5900: true}
+
+		states, err := newHardeningMessages(wsmanClient).ApplyHardeningProfile(HardeningProfile{DisableRMCPPing: true})
+		require.NoError(t, err)
+		assert.Equal(t, InterfaceState{Interface: InterfaceRMCPPing, Before: true, After: false}, states[1])
+		assert.Equal(t, InterfaceState{Interface: InterfaceDDNS, Before: true, After: true}, states[2])
+		assert.True(t, wsmanClient.webUI)
+		assert.Equal(t, []string{"AMT_GeneralSettings"}, wsmanClient.puts)
+	})
+
+	t.Run("doesn't write services that are already hardened", func(t *testing.T) {
+		wsmanClient := &hardeningClient{}
+
+		states, err := newHardeningMessages(wsmanClient).ApplyHardeningProfile(DefaultHardeningProfile)
+		require.NoError(t, err)
+		assert.Len(t, states, 5)
+		assert.Empty(t, wsmanClient.puts)
+	})
+
+	t.Run("returns the states handled before an error", func(t *testing.T) {
+		wsmanClient := &hardeningClient{webUI: true, failClass: "AMT_RedirectionService"}
+
+		states, err := newHardeningMessages(wsmanClient).ApplyHardeningProfile(DefaultHardeningProfile)
+		require.ErrorContains(t, err, InterfaceRedirectionListener)
+		assert.Len(t, states, 3)
+		assert.False(t, wsmanClient.webUI)
+	})
+}
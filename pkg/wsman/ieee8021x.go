@@ -0,0 +1,341 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package wsman
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/config"
+	amtieee8021x "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/ieee8021x"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/publickey"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/base"
+	ipsieee8021x "github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/ieee8021x"
+)
+
+// Limits of AMT_8021XProfile.
+const (
+	maxIEEE8021xUsername   = 128
+	maxIEEE8021xPassword   = 32
+	maxIEEE8021xPXETimeout = 86400
+)
+
+var (
+	// ErrInvalid8021xConfig is returned by Configure8021x when the configuration is incomplete or inconsistent.
+	ErrInvalid8021xConfig = errors.New("invalid 802.1x configuration")
+	// ErrUnsupported8021xProtocol is returned by Configure8021x for the EAP methods it cannot configure on a wired port.
+	ErrUnsupported8021xProtocol = errors.New("unsupported 802.1x authentication protocol")
+	// ErrCredentialNotBound is returned by Configure8021x when a certificate is missing from the credential contexts
+	// after SetCertificates succeeded.
+	ErrCredentialNotBound = errors.New("certificate is not bound to the 802.1x profile")
+)
+
+// IEEE8021xResult holds the handles of the certificates and key that Configure8021x added to the AMT certificate
+// store. The handles are empty for the credentials that the configuration does not use.
+type IEEE8021xResult struct {
+	KeyHandle               string
+	ClientCertificateHandle string
+	RootCertificateHandle   string
+}
+
+// ieee8021xCredentials are the validated credentials of an 802.1x configuration, as base64 encoded DER blobs.
+type ieee8021xCredentials struct {
+	key, clientCertificate, rootCertificate string
+}
+
+// Configure8021x configures wired 802.1x authentication. It validates the configuration, adds the private key and the
+// certificates to the AMT certificate store with AMT_PublicKeyManagementService, writes AMT_8021XProfile and binds the
+// certificates with IPS_IEEE8021xSettings.SetCertificates. The binding is then checked against
+// IPS_8021xCredentialContext and AMT_8021xCredentialContext.
+//
+// EAP-TLS needs a username, a client certificate with its private key and the CA certificate of the authentication
+// server. PEAPv0/EAP-MSCHAPv2 needs a username and a password; its CA certificate is optional, as AMT looks for a
+// matching root certificate in its store when none is bound. Certificates and keys are accepted PEM or base64 DER
+// encoded. A certificate that is already in the store is reported as a duplicate by AMT and must be removed first.
+//
+// When a step fails after credentials were added, they are removed from the store again, so the configuration can be
+// retried. The handles that could not be removed are returned with the error and must be deleted by the caller. The
+// profile itself is not restored.
+func (m Messages) Configure8021x(cfg config.IEEE8021x, opts ...base.HeaderOption) (IEEE8021xResult, error) {
+	credentials, err := validate8021x(cfg)
+	if err != nil {
+		return IEEE8021xResult{}, err
+	}
+
+	result, err := m.add8021xCredentials(credentials, opts)
+	if err == nil {
+		err = m.write8021xProfile(cfg, result, opts)
+	}
+
+	if err != nil {
+		return m.remove8021xCredentials(result, err, opts)
+	}
+
+	return result, nil
+}
+
+// write8021xProfile writes AMT_8021XProfile and binds the certificates of result to it.
+func (m Messages) write8021xProfile(cfg config.IEEE8021x, result IEEE8021xResult, opts []base.HeaderOption) error {
+	_, err := m.AMT.IEEE8021xProfile.Update(func(request *amtieee8021x.ProfileRequest) {
+		request.Enabled = true
+		request.AuthenticationProtocol = amtieee8021x.AuthenticationProtocol(cfg.AuthenticationProtocol)
+		request.Username = cfg.Username
+		request.Password = cfg.Password
+		request.PxeTimeout = cfg.PXETimeout
+	}, base.WithUpdateHeaderOptions(opts...))
+	if err != nil {
+		return fmt.Errorf("failed to write the 802.1x profile: %w", err)
+	}
+
+	if result.ClientCertificateHandle == "" && result.RootCertificateHandle == "" {
+		return nil
+	}
+
+	response, err := m.IPS.IEEE8021xSettings.SetCertificates(result.RootCertificateHandle, result.ClientCertificateHandle, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to bind the 802.1x certificates: %w", err)
+	}
+
+	if rv := response.Body.SetCertificatesResponse.ReturnValue; rv != ipsieee8021x.ReturnValueSuccess {
+		return errors.New("SetCertificates failed with return code " + rv.String())
+	}
+
+	return m.verify8021xCredentials(result, opts)
+}
+
+// remove8021xCredentials deletes the certificates and the key of result after err, certificates first as AMT refuses
+// to delete a key that a certificate refers to. The handles left in the store are returned with err.
+func (m Messages) remove8021xCredentials(result IEEE8021xResult, err error, opts []base.HeaderOption) (IEEE8021xResult, error) {
+	for _, handle := range []*string{&result.ClientCertificateHandle, &result.RootCertificateHandle} {
+		if *handle == "" {
+			continue
+		}
+
+		if _, deleteErr := m.AMT.PublicKeyCertificate.Delete(*handle, opts...); deleteErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to remove certificate %s: %w", *handle, deleteErr))
+
+			continue
+		}
+
+		*handle = ""
+	}
+
+	if result.KeyHandle != "" {
+		if _, deleteErr := m.AMT.PublicPrivateKeyPair.Delete(result.KeyHandle, opts...); deleteErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to remove key %s: %w", result.KeyHandle, deleteErr))
+		} else {
+			result.KeyHandle = ""
+		}
+	}
+
+	return result, err
+}
+
+func validate8021x(cfg config.IEEE8021x) (ieee8021xCredentials, error) {
+	var credentials ieee8021xCredentials
+
+	if cfg.Username == "" || len(cfg.Username) > maxIEEE8021xUsername {
+		return credentials, fmt.Errorf("%w: username must be 1 to %d characters", ErrInvalid8021xConfig, maxIEEE8021xUsername)
+	}
+
+	if cfg.PXETimeout < 0 || cfg.PXETimeout > maxIEEE8021xPXETimeout {
+		return credentials, fmt.Errorf("%w: PXE timeout must be 0 to %d seconds", ErrInvalid8021xConfig, maxIEEE8021xPXETimeout)
+	}
+
+	var err error
+
+	if cfg.CACert != "" {
+		if credentials.rootCertificate, _, err = certificateBlob(cfg.CACert); err != nil {
+			return credentials, fmt.Errorf("%w: CA certificate: %w", ErrInvalid8021xConfig, err)
+		}
+	}
+
+	switch amtieee8021x.AuthenticationProtocol(cfg.AuthenticationProtocol) {
+	case amtieee8021x.AuthenticationProtocolTLS:
+		if cfg.ClientCert == "" || cfg.PrivateKey == "" || cfg.CACert == "" {
+			return credentials, fmt.Errorf("%w: EAP-TLS needs a client certificate, its private key and a CA certificate", ErrInvalid8021xConfig)
+		}
+
+		if cfg.Password != "" {
+			return credentials, fmt.Errorf("%w: EAP-TLS does not use a password", ErrInvalid8021xConfig)
+		}
+
+		return validateClientCredentials(cfg, credentials)
+	case amtieee8021x.AuthenticationProtocolPEAPMSCHAPv2:
+		if cfg.Password == "" || len(cfg.Password) > maxIEEE8021xPassword {
+			return credentials, fmt.Errorf("%w: PEAP-MSCHAPv2 needs a password of 1 to %d characters", ErrInvalid8021xConfig, maxIEEE8021xPassword)
+		}
+
+		if cfg.ClientCert != "" || cfg.PrivateKey != "" {
+			return credentials, fmt.Errorf("%w: PEAP-MSCHAPv2 does not use a client certificate", ErrInvalid8021xConfig)
+		}
+
+		return credentials, nil
+	case amtieee8021x.AuthenticationProtocolTTLSMSCHAPv2, amtieee8021x.AuthenticationProtocolEAPGTC,
+		amtieee8021x.AuthenticationProtocolEAPFASTMSCHAPv2, amtieee8021x.AuthenticationProtocolEAPFASTGTC,
+		amtieee8021x.AuthenticationProtocolEAPFASTTLS:
+	}
+
+	return credentials, fmt.Errorf("%w: %d", ErrUnsupported8021xProtocol, cfg.AuthenticationProtocol)
+}
+
+// validateClientCredentials checks that the private key of cfg belongs to its client certificate.
+func validateClientCredentials(cfg config.IEEE8021x, credentials ieee8021xCredentials) (ieee8021xCredentials, error) {
+	blob, certificate, err := certificateBlob(cfg.ClientCert)
+	if err != nil {
+		return credentials, fmt.Errorf("%w: client certificate: %w", ErrInvalid8021xConfig, err)
+	}
+
+	key, err := parseRSAKey(cfg.PrivateKey)
+	if err != nil {
+		return credentials, fmt.Errorf("%w: private key: %w", ErrInvalid8021xConfig, err)
+	}
+
+	if !key.PublicKey.Equal(certificate.PublicKey) {
+		return credentials, fmt.Errorf("%w: the private key does not match the client certificate", ErrInvalid8021xConfig)
+	}
+
+	credentials.clientCertificate = blob
+	credentials.key = base64.StdEncoding.EncodeToString(x509.MarshalPKCS1PrivateKey(key))
+
+	return credentials, nil
+}
+
+// decodePEMOrBase64 returns the DER bytes of a PEM block or of a base64 encoded DER value.
+func decodePEMOrBase64(value string) ([]byte, error) {
+	if block, _ := pem.Decode([]byte(value)); block != nil {
+		return block.Bytes, nil
+	}
+
+	return base64.StdEncoding.DecodeString(value)
+}
+
+// certificateBlob returns the certificate as the base64 DER blob that AMT_PublicKeyManagementService expects.
+func certificateBlob(value string) (string, *x509.Certificate, error) {
+	der, err := decodePEMOrBase64(value)
+	if err != nil {
+		return "", nil, err
+	}
+
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return base64.StdEncoding.EncodeToString(der), certificate, nil
+}
+
+// parseRSAKey parses a PKCS #1 or PKCS #8 RSA private key. AMT only stores RSA keys.
+func parseRSAKey(value string) (*rsa.PrivateKey, error) {
+	der, err := decodePEMOrBase64(value)
+	if err != nil {
+		return nil, err
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(der); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, err
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("only RSA keys are supported")
+	}
+
+	return rsaKey, nil
+}
+
+func (m Messages) add8021xCredentials(credentials ieee8021xCredentials, opts []base.HeaderOption) (IEEE8021xResult, error) {
+	var result IEEE8021xResult
+
+	if credentials.key != "" {
+		response, err := m.AMT.PublicKeyManagementService.AddKey(credentials.key, opts...)
+		if err != nil {
+			return result, fmt.Errorf("failed to add the private key: %w", err)
+		}
+
+		if rv := response.Body.AddKey_OUTPUT.ReturnValue; rv != publickey.ReturnValueSuccess {
+			return result, errors.New("AddKey failed with return code " + rv.String())
+		}
+
+		result.KeyHandle = selectorValue(response.Body.AddKey_OUTPUT.CreatedKey.ReferenceParameters.SelectorSet)
+	}
+
+	if credentials.clientCertificate != "" {
+		response, err := m.AMT.PublicKeyManagementService.AddCertificate(credentials.clientCertificate, opts...)
+		if err != nil {
+			return result, fmt.Errorf("failed to add the client certificate: %w", err)
+		}
+
+		result.ClientCertificateHandle = selectorValue(response.Body.AddCertificate_OUTPUT.CreatedCertificate.ReferenceParameters.SelectorSet)
+	}
+
+	if credentials.rootCertificate != "" {
+		response, err := m.AMT.PublicKeyManagementService.AddTrustedRootCertificate(credentials.rootCertificate, opts...)
+		if err != nil {
+			return result, fmt.Errorf("failed to add the CA certificate: %w", err)
+		}
+
+		result.RootCertificateHandle = selectorValue(response.Body.AddTrustedRootCertificate_OUTPUT.CreatedCertificate.ReferenceParameters.SelectorSet)
+	}
+
+	return result, nil
+}
+
+func selectorValue(selectorSet publickey.SelectorSetResponse) string {
+	for _, selector := range selectorSet.Selectors {
+		if selector.Name == "InstanceID" {
+			return selector.Text
+		}
+	}
+
+	return ""
+}
+
+// verify8021xCredentials checks that the bound certificates are referenced by a credential context of the IPS
+// settings or of the AMT profile.
+func (m Messages) verify8021xCredentials(result IEEE8021xResult, opts []base.HeaderOption) error {
+	var bound []string
+
+	err := base.EachItem(m.IPS.IEEE8021xCredentialContext.WSManService, ipsieee8021x.IPS8021xCredentialContext, func(item ipsieee8021x.CredentialContextResponse) error {
+		for _, selector := range item.ElementInContext.ReferenceParameters.SelectorSet.Selectors {
+			bound = append(bound, selector.Text)
+		}
+
+		return nil
+	}, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", ipsieee8021x.IPS8021xCredentialContext, err)
+	}
+
+	err = base.EachItem(m.AMT.IEEE8021xCredentialContext.WSManService, amtieee8021x.AMTIEEE8021xCredentialContext, func(item amtieee8021x.CredentialContextResponse) error {
+		for _, selector := range item.ElementInContext.ReferenceParameters.SelectorSet.Selectors {
+			bound = append(bound, selector.Text)
+		}
+
+		return nil
+	}, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", amtieee8021x.AMTIEEE8021xCredentialContext, err)
+	}
+
+	for _, handle := range []string{result.ClientCertificateHandle, result.RootCertificateHandle} {
+		if handle != "" && !slices.Contains(bound, handle) {
+			return fmt.Errorf("%w: %s", ErrCredentialNotBound, handle)
+		}
+	}
+
+	return nil
+}
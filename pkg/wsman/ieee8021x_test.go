@@ -0,0 +1,275 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package wsman
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/config"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips"
+)
+
+var (
+	ieee8021xResourcePattern = regexp.MustCompile(`<w:ResourceURI>[^<]*/([A-Za-z0-9_]+)</w:ResourceURI>`)
+	ieee8021xProfilePattern  = regexp.MustCompile(`<h:AMT_8021XProfile .*</h:AMT_8021XProfile>`)
+	ieee8021xSelectorPattern = regexp.MustCompile(`<w:Selector Name="InstanceID">([^<]*)</w:Selector>`)
+)
+
+const initialProfile = `<AMT_8021XProfile><ElementName>Intel(r) AMT 802.1x Profile</ElementName><InstanceID>Intel(r) AMT 802.1x Profile 0</InstanceID><Enabled>false</Enabled><ActiveInS0>true</ActiveInS0><AuthenticationProtocol>0</AuthenticationProtocol><ServerCertificateNameComparison>0</ServerCertificateNameComparison></AMT_8021XProfile>`
+
+// ieee8021xClient keeps the certificate store, the 802.1x profile and the credential contexts of a device and answers
+// like AMT would.
+type ieee8021xClient struct {
+	client.WSMan
+
+	calls        []string
+	profile      string
+	bound        []string
+	deleted      []string
+	skipBinding  bool
+	addKeyResult int
+	failCall     string
+}
+
+func (c *ieee8021xClient) Post(msg string) ([]byte, error) {
+	action := hardeningActionPattern.FindStringSubmatch(msg)[1]
+	class := ieee8021xResourcePattern.FindStringSubmatch(msg)[1]
+	c.calls = append(c.calls, class+"."+action)
+
+	if class+"."+action == c.failCall {
+		return nil, fmt.Errorf("%s.%s unavailable", class, action)
+	}
+
+	created := func(output, element string, handle int) string {
+		return fmt.Sprintf(`<%s><%s><Address>default</Address><ReferenceParameters><SelectorSet><Selector Name="InstanceID">Handle %d</Selector></SelectorSet></ReferenceParameters></%s><ReturnValue>0</ReturnValue></%s>`, output, element, handle, element, output)
+	}
+
+	var body string
+
+	switch class + "." + action {
+	case "AMT_PublicKeyManagementService.AddKey":
+		body = fmt.Sprintf(`<AddKey_OUTPUT><CreatedKey><ReferenceParameters><SelectorSet><Selector Name="InstanceID">Handle 0</Selector></SelectorSet></ReferenceParameters></CreatedKey><ReturnValue>%d</ReturnValue></AddKey_OUTPUT>`, c.addKeyResult)
+	case "AMT_PublicKeyManagementService.AddCertificate":
+		body = created("AddCertificate_OUTPUT", "CreatedCertificate", 1)
+	case "AMT_PublicKeyManagementService.AddTrustedRootCertificate":
+		body = created("AddTrustedRootCertificate_OUTPUT", "CreatedCertificate", 2)
+	case "AMT_8021XProfile.Get":
+		body = c.profile
+	case "AMT_8021XProfile.Put":
+		c.profile = ieee8021xProfilePattern.FindString(msg)
+		body = c.profile
+	case "IPS_IEEE8021xSettings.SetCertificates":
+		if !c.skipBinding {
+			for _, selector := range ieee8021xSelectorPattern.FindAllStringSubmatch(msg, -1) {
+				c.bound = append(c.bound, selector[1])
+			}
+		}
+
+		body = `<SetCertificates_OUTPUT><ReturnValue>0</ReturnValue></SetCertificates_OUTPUT>`
+	case "IPS_8021xCredentialContext.Enumerate", "AMT_8021xCredentialContext.Enumerate":
+		body = `<EnumerateResponse><EnumerationContext>context</EnumerationContext></EnumerateResponse>`
+	case "IPS_8021xCredentialContext.Pull":
+		var items strings.Builder
+
+		for _, handle := range c.bound {
+			fmt.Fprintf(&items, `<IPS_8021xCredentialContext><ElementInContext><ReferenceParameters><SelectorSet><Selector Name="InstanceID">%s</Selector></SelectorSet></ReferenceParameters></ElementInContext></IPS_8021xCredentialContext>`, handle)
+		}
+
+		body = `<PullResponse><Items>` + items.String() + `</Items><EndOfSequence></EndOfSequence></PullResponse>`
+	case "AMT_8021xCredentialContext.Pull":
+		body = `<PullResponse><Items></Items><EndOfSequence></EndOfSequence></PullResponse>`
+	case "AMT_PublicKeyCertificate.Delete", "AMT_PublicPrivateKeyPair.Delete":
+		c.deleted = append(c.deleted, ieee8021xSelectorPattern.FindStringSubmatch(msg)[1])
+	}
+
+	return []byte(`<Envelope><Header></Header><Body>` + body + `</Body></Envelope>`), nil
+}
+
+func newIEEE8021xMessages(wsmanClient *ieee8021xClient) Messages {
+	return Messages{Client: wsmanClient, AMT: amt.NewMessages(wsmanClient), IPS: ips.NewMessages(wsmanClient)}
+}
+
+// newTestCertificate returns a self-signed certificate and its PKCS #8 private key, PEM encoded.
+func newTestCertificate(t *testing.T) (certificate, key string) {
+	t.Helper()
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "device"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	require.NoError(t, err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}))
+}
+
+func TestMessages_Configure8021x(t *testing.T) {
+	clientCert, privateKey := newTestCertificate(t)
+	caCert, otherKey := newTestCertificate(t)
+	tls := config.IEEE8021x{Username: "device", AuthenticationProtocol: 0, ClientCert: clientCert, PrivateKey: privateKey, CACert: caCert, PXETimeout: 120}
+	peap := config.IEEE8021x{Username: "device", Password: "P@ssw0rd", AuthenticationProtocol: 2}
+
+	t.Run("installs and binds the EAP-TLS credentials", func(t *testing.T) {
+		wsmanClient := &ieee8021xClient{profile: initialProfile}
+
+		result, err := newIEEE8021xMessages(wsmanClient).Configure8021x(tls)
+		require.NoError(t, err)
+		assert.Equal(t, IEEE8021xResult{KeyHandle: "Handle 0", ClientCertificateHandle: "Handle 1", RootCertificateHandle: "Handle 2"}, result)
+		assert.Equal(t, []string{
+			"AMT_PublicKeyManagementService.AddKey",
+			"AMT_PublicKeyManagementService.AddCertificate",
+			"AMT_PublicKeyManagementService.AddTrustedRootCertificate",
+			"AMT_8021XProfile.Get",
+			"AMT_8021XProfile.Get",
+			"AMT_8021XProfile.Put",
+			"IPS_IEEE8021xSettings.SetCertificates",
+			"IPS_8021xCredentialContext.Enumerate",
+			"IPS_8021xCredentialContext.Pull",
+			"AMT_8021xCredentialContext.Enumerate",
+			"AMT_8021xCredentialContext.Pull",
+		}, wsmanClient.calls)
+		assert.Contains(t, wsmanClient.profile, `<h:InstanceID>Intel(r) AMT 802.1x Profile 0</h:InstanceID><h:Enabled>true</h:Enabled>`)
+		assert.Contains(t, wsmanClient.profile, `<h:Username>device</h:Username>`)
+		assert.Contains(t, wsmanClient.profile, `<h:PxeTimeout>120</h:PxeTimeout>`)
+		assert.ElementsMatch(t, []string{"Handle 1", "Handle 2"}, wsmanClient.bound)
+	})
+
+	t.Run("accepts base64 DER credentials", func(t *testing.T) {
+		cfg := tls
+		block, _ := pem.Decode([]byte(clientCert))
+		cfg.ClientCert = base64.StdEncoding.EncodeToString(block.Bytes)
+		block, _ = pem.Decode([]byte(privateKey))
+		cfg.PrivateKey = base64.StdEncoding.EncodeToString(block.Bytes)
+
+		_, err := newIEEE8021xMessages(&ieee8021xClient{profile: initialProfile}).Configure8021x(cfg)
+		assert.NoError(t, err)
+	})
+
+	t.Run("configures PEAP-MSCHAPv2 without certificates", func(t *testing.T) {
+		wsmanClient := &ieee8021xClient{profile: initialProfile}
+
+		result, err := newIEEE8021xMessages(wsmanClient).Configure8021x(peap)
+		require.NoError(t, err)
+		assert.Equal(t, IEEE8021xResult{}, result)
+		assert.Equal(t, []string{"AMT_8021XProfile.Get", "AMT_8021XProfile.Get", "AMT_8021XProfile.Put"}, wsmanClient.calls)
+		assert.Contains(t, wsmanClient.profile, `<h:AuthenticationProtocol>2</h:AuthenticationProtocol>`)
+		assert.Contains(t, wsmanClient.profile, `<h:Password>P@ssw0rd</h:Password>`)
+	})
+
+	t.Run("binds the CA certificate of PEAP-MSCHAPv2", func(t *testing.T) {
+		cfg := peap
+		cfg.CACert = caCert
+		wsmanClient := &ieee8021xClient{profile: initialProfile}
+
+		result, err := newIEEE8021xMessages(wsmanClient).Configure8021x(cfg)
+		require.NoError(t, err)
+		assert.Equal(t, IEEE8021xResult{RootCertificateHandle: "Handle 2"}, result)
+		assert.Contains(t, wsmanClient.calls, "IPS_IEEE8021xSettings.SetCertificates")
+		assert.Equal(t, []string{"Handle 2"}, wsmanClient.bound)
+	})
+
+	t.Run("reports certificates missing from the credential contexts", func(t *testing.T) {
+		wsmanClient := &ieee8021xClient{profile: initialProfile, skipBinding: true}
+
+		result, err := newIEEE8021xMessages(wsmanClient).Configure8021x(tls)
+		assert.ErrorIs(t, err, ErrCredentialNotBound)
+		// the credentials are removed again, certificates before the key
+		assert.Equal(t, []string{"Handle 1", "Handle 2", "Handle 0"}, wsmanClient.deleted)
+		assert.Equal(t, IEEE8021xResult{}, result)
+	})
+
+	t.Run("removes the credentials added before a failure", func(t *testing.T) {
+		wsmanClient := &ieee8021xClient{profile: initialProfile, failCall: "AMT_PublicKeyManagementService.AddTrustedRootCertificate"}
+
+		result, err := newIEEE8021xMessages(wsmanClient).Configure8021x(tls)
+		assert.ErrorContains(t, err, "failed to add the CA certificate")
+		assert.Equal(t, []string{"Handle 1", "Handle 0"}, wsmanClient.deleted)
+		assert.Equal(t, IEEE8021xResult{}, result)
+		assert.NotContains(t, wsmanClient.calls, "AMT_8021XProfile.Put")
+	})
+
+	t.Run("returns the credentials it could not remove", func(t *testing.T) {
+		wsmanClient := &ieee8021xClient{profile: initialProfile, skipBinding: true, failCall: "AMT_PublicPrivateKeyPair.Delete"}
+
+		result, err := newIEEE8021xMessages(wsmanClient).Configure8021x(tls)
+		assert.ErrorIs(t, err, ErrCredentialNotBound)
+		assert.ErrorContains(t, err, "failed to remove key Handle 0")
+		assert.Equal(t, IEEE8021xResult{KeyHandle: "Handle 0"}, result)
+	})
+
+	t.Run("stops when the key is rejected", func(t *testing.T) {
+		wsmanClient := &ieee8021xClient{profile: initialProfile, addKeyResult: 2062}
+
+		_, err := newIEEE8021xMessages(wsmanClient).Configure8021x(tls)
+		assert.ErrorContains(t, err, "AddKey failed with return code")
+		assert.Len(t, wsmanClient.calls, 1)
+	})
+
+	invalid := map[string]struct {
+		mutate func(*config.IEEE8021x)
+		want   error
+	}{
+		"TLS without key": {func(c *config.IEEE8021x) { c.PrivateKey = "" }, ErrInvalid8021xConfig},
+		"TLS without CA":  {func(c *config.IEEE8021x) { c.CACert = "" }, ErrInvalid8021xConfig},
+		"TLS with a password": {func(c *config.IEEE8021x) {
+			c.Password = "P@ssw0rd"
+		}, ErrInvalid8021xConfig},
+		"key of another certificate": {func(c *config.IEEE8021x) { c.PrivateKey = otherKey }, ErrInvalid8021xConfig},
+		"malformed certificate":      {func(c *config.IEEE8021x) { c.ClientCert = "not a certificate" }, ErrInvalid8021xConfig},
+		"missing username":           {func(c *config.IEEE8021x) { c.Username = "" }, ErrInvalid8021xConfig},
+		"PXE timeout too long":       {func(c *config.IEEE8021x) { c.PXETimeout = 86401 }, ErrInvalid8021xConfig},
+		"PEAP without password": {func(c *config.IEEE8021x) {
+			*c = peap
+			c.Password = ""
+		}, ErrInvalid8021xConfig},
+		"PEAP with a client certificate": {func(c *config.IEEE8021x) {
+			c.Password = "P@ssw0rd"
+			c.AuthenticationProtocol = 2
+		}, ErrInvalid8021xConfig},
+		"EAP-GTC": {func(c *config.IEEE8021x) {
+			*c = peap
+			c.AuthenticationProtocol = 3
+		}, ErrUnsupported8021xProtocol},
+	}
+
+	for name, test := range invalid {
+		t.Run(name, func(t *testing.T) {
+			cfg := tls
+			test.mutate(&cfg)
+			wsmanClient := &ieee8021xClient{profile: initialProfile}
+
+			_, err := newIEEE8021xMessages(wsmanClient).Configure8021x(cfg)
+			assert.ErrorIs(t, err, test.want)
+			assert.Empty(t, wsmanClient.calls)
+		})
+	}
+}
@@ -26,11 +26,11 @@ func NewAlarmClockOccurrenceWithClient(wsmanMessageCreator *message.WSManMessage
 }
 
 // Delete removes a the specified instance.
-func (occurrence Occurrence) Delete(handle string) (response Response, err error) {
+func (occurrence Occurrence) Delete(handle string, opts ...base.HeaderOption) (response Response, err error) {
 	selector := message.Selector{Name: "InstanceID", Value: handle}
 	response = Response{
 		Message: &client.Message{
-			XMLInput: occurrence.Base.Delete(selector),
+			XMLInput: occurrence.Base.Delete(selector, opts...),
 		},
 	}
 
@@ -7,4 +7,59 @@ package hostbootreason
 
 const (
 	IPSHostBootReason string = "IPS_HostBootReason"
+	ValueNotFound     string = "Value not found in map"
 )
+
+// The boot reasons reported by the firmware. Details, such as the remote command, are given in ReasonDetails.
+const (
+	ReasonUnknown Reason = iota
+	ReasonRemoteControl
+	ReasonPowerButton
+	ReasonWakeEvent
+)
+
+// reasonToString is a map of Reason values to their string representation.
+var reasonToString = map[Reason]string{
+	ReasonUnknown:       "Unknown",
+	ReasonRemoteControl: "RemoteControl",
+	ReasonPowerButton:   "PowerButton",
+	ReasonWakeEvent:     "WakeEvent",
+}
+
+// String returns the string representation of the Reason value.
+func (r Reason) String() string {
+	if value, exists := reasonToString[r]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+// The ACPI system states the host was in before it booted. S0 means the host was reset while running.
+const (
+	SxStateS0 SxState = iota
+	SxStateS1
+	SxStateS2
+	SxStateS3
+	SxStateS4
+	SxStateS5
+)
+
+// sxStateToString is a map of SxState values to their string representation.
+var sxStateToString = map[SxState]string{
+	SxStateS0: "S0",
+	SxStateS1: "S1",
+	SxStateS2: "S2",
+	SxStateS3: "S3",
+	SxStateS4: "S4",
+	SxStateS5: "S5",
+}
+
+// String returns the string representation of the SxState value.
+func (s SxState) String() string {
+	if value, exists := sxStateToString[s]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
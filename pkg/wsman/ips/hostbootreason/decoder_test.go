@@ -0,0 +1,25 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package hostbootreason
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReason_String(t *testing.T) {
+	assert.Equal(t, "Unknown", ReasonUnknown.String())
+	assert.Equal(t, "RemoteControl", ReasonRemoteControl.String())
+	assert.Equal(t, "WakeEvent", ReasonWakeEvent.String())
+	assert.Equal(t, ValueNotFound, Reason(99).String())
+}
+
+func TestSxState_String(t *testing.T) {
+	assert.Equal(t, "S0", SxStateS0.String())
+	assert.Equal(t, "S5", SxStateS5.String())
+	assert.Equal(t, ValueNotFound, SxState(99).String())
+}
@@ -33,8 +33,8 @@ type (
 		XMLName         xml.Name `xml:"IPS_HostBootReason"`
 		ElementName     string   `xml:"ElementName,omitempty"`
 		InstanceID      string   `xml:"InstanceID,omitempty"`
-		PreviousSxState int      `xml:"PreviousSxState,omitempty"`
-		Reason          int      `xml:"Reason,omitempty"`
+		PreviousSxState SxState  `xml:"PreviousSxState,omitempty"`
+		Reason          Reason   `xml:"Reason,omitempty"`
 		ReasonDetails   string   `xml:"ReasonDetails,omitempty"`
 	}
 
@@ -43,3 +43,10 @@ type (
 		HostBootReasonItems []HostBootReasonResponse `xml:"Items>IPS_HostBootReason"`
 	}
 )
+
+type (
+	// Reason is why the host booted last.
+	Reason int
+	// SxState is an ACPI system power state.
+	SxState int
+)
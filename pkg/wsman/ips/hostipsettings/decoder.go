@@ -6,5 +6,32 @@
 package hostipsettings
 
 const (
-	IPSHostIPSettings string = "IPS_HostIPSettings"
+	IPSHostIPSettings       string = "IPS_HostIPSettings"
+	SetDHCP                 string = "SetDHCP"
+	SetStaticIPv4Parameters string = "SetStaticIPv4Parameters"
+	ValueNotFound           string = "Value not found in map"
 )
+
+const (
+	ReturnValueSuccess ReturnValue = iota
+	ReturnValueInternalError
+	ReturnValueNotPermitted
+	ReturnValueInvalidParameter
+)
+
+// returnValueToString is a map of ReturnValue values to their string representation.
+var returnValueToString = map[ReturnValue]string{
+	ReturnValueSuccess:          "Success",
+	ReturnValueInternalError:    "InternalError",
+	ReturnValueNotPermitted:     "NotPermitted",
+	ReturnValueInvalidParameter: "InvalidParameter",
+}
+
+// String returns the string representation of the ReturnValue value.
+func (r ReturnValue) String() string {
+	if value, exists := returnValueToString[r]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
@@ -7,9 +7,20 @@
 package hostipsettings
 
 import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/netip"
+
 	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/base"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/methods"
+)
+
+var (
+	ErrInvalidAddress    = errors.New("invalid IPv4 address")
+	ErrInvalidSubnetMask = errors.New("invalid subnet mask")
 )
 
 type Settings struct {
@@ -22,3 +33,141 @@ func NewHostIPSettingsWithClient(wsmanMessageCreator *message.WSManMessageCreato
 		base.NewService[Response](wsmanMessageCreator, IPSHostIPSettings, client),
 	}
 }
+
+// SetDHCP configures the host IPv4 settings to be obtained with DHCP.
+func (settings Settings) SetDHCP(opts ...base.HeaderOption) (response Response, err error) {
+	header := settings.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(IPSHostIPSettings, SetDHCP), IPSHostIPSettings, nil, "", "", opts...)
+	body := settings.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(SetDHCP), IPSHostIPSettings, nil)
+
+	response, err = settings.execute(header, body)
+	if err != nil {
+		return response, err
+	}
+
+	if response.Body.SetDHCP_OUTPUT.ReturnValue != ReturnValueSuccess {
+		err = errors.New("SetDHCP failed with return code " + response.Body.SetDHCP_OUTPUT.ReturnValue.String())
+	}
+
+	return response, err
+}
+
+// SetStaticIPv4Parameters validates parameters and configures them as the static host IPv4 settings.
+func (settings Settings) SetStaticIPv4Parameters(parameters StaticIPv4Parameters, opts ...base.HeaderOption) (response Response, err error) {
+	if err := parameters.Validate(); err != nil {
+		return response, err
+	}
+
+	header := settings.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(IPSHostIPSettings, SetStaticIPv4Parameters), IPSHostIPSettings, nil, "", "", opts...)
+	body := settings.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(SetStaticIPv4Parameters), IPSHostIPSettings, &SetStaticIPv4Parameters_INPUT{
+		H:                     fmt.Sprintf("%s%s", message.IPSSchema, IPSHostIPSettings),
+		IPAddress:             parameters.IPAddress,
+		SubnetMask:            parameters.SubnetMask,
+		DefaultGatewayAddress: parameters.DefaultGatewayAddress,
+		PrimaryDNSAddress:     parameters.PrimaryDNSAddress,
+		SecondaryDNSAddress:   parameters.SecondaryDNSAddress,
+	})
+
+	response, err = settings.execute(header, body)
+	if err != nil {
+		return response, err
+	}
+
+	if response.Body.SetStaticIPv4Parameters_OUTPUT.ReturnValue != ReturnValueSuccess {
+		err = errors.New("SetStaticIPv4Parameters failed with return code " + response.Body.SetStaticIPv4Parameters_OUTPUT.ReturnValue.String())
+	}
+
+	return response, err
+}
+
+func (settings Settings) execute(header, body string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: settings.Base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	err = settings.Base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+
+	return response, err
+}
+
+// Validate checks that the address is an IPv4 unicast address with a contiguous subnet mask and that the optional
+// gateway is on the same subnet.
+func (parameters StaticIPv4Parameters) Validate() error {
+	address, err := parseAddress("IPAddress", parameters.IPAddress)
+	if err != nil {
+		return err
+	}
+
+	if !address.IsValid() {
+		return fmt.Errorf("%w: IPAddress is required", ErrInvalidAddress)
+	}
+
+	bits, err := maskBits(parameters.SubnetMask)
+	if err != nil {
+		return err
+	}
+
+	gateway, err := parseAddress("DefaultGatewayAddress", parameters.DefaultGatewayAddress)
+	if err != nil {
+		return err
+	}
+
+	if gateway.IsValid() {
+		prefix := netip.PrefixFrom(address, bits)
+		if !prefix.Contains(gateway) || gateway == address {
+			return fmt.Errorf("%w: DefaultGatewayAddress %s is not another host of %s", ErrInvalidAddress, gateway, prefix.Masked())
+		}
+	}
+
+	if _, err := parseAddress("PrimaryDNSAddress", parameters.PrimaryDNSAddress); err != nil {
+		return err
+	}
+
+	_, err = parseAddress("SecondaryDNSAddress", parameters.SecondaryDNSAddress)
+
+	return err
+}
+
+// parseAddress parses an optional IPv4 unicast address. The zero Addr is returned for an unset address.
+func parseAddress(property, value string) (netip.Addr, error) {
+	if value == "" {
+		return netip.Addr{}, nil
+	}
+
+	address, err := netip.ParseAddr(value)
+	if err != nil || !address.Is4() || address.IsUnspecified() || address.IsMulticast() || address.IsLoopback() || address == netip.AddrFrom4([4]byte{255, 255, 255, 255}) {
+		return netip.Addr{}, fmt.Errorf("%w: %s %q must be an IPv4 unicast address", ErrInvalidAddress, property, value)
+	}
+
+	return address, nil
+}
+
+// maskBits returns the prefix length of a dotted-decimal subnet mask such as 255.255.255.0.
+func maskBits(mask string) (int, error) {
+	address, err := netip.ParseAddr(mask)
+	if err != nil || !address.Is4() {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidSubnetMask, mask)
+	}
+
+	octets := address.As4()
+	value := uint32(octets[0])<<24 | uint32(octets[1])<<16 | uint32(octets[2])<<8 | uint32(octets[3])
+	bits := 0
+
+	for value&(1<<31) != 0 {
+		bits++
+		value <<= 1
+	}
+
+	// the ones must be contiguous, and a host needs at least one host bit besides the network and broadcast addresses
+	if value != 0 || bits == 0 || bits > 30 {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidSubnetMask, mask)
+	}
+
+	return bits, nil
+}
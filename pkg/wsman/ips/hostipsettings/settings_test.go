@@ -23,7 +23,7 @@ func TestJson(t *testing.T) {
 			PullResponse: PullResponse{},
 		},
 	}
-	expectedResult := "{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"GetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"DHCPEnabled\":false,\"ElementName\":\"\",\"InstanceID\":\"\"},\"PullResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"HostIPSettingsItems\":null},\"EnumerateResponse\":{\"EnumerationContext\":\"\"}}"
+	expectedResult := "{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"GetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"DHCPEnabled\":false,\"ElementName\":\"\",\"InstanceID\":\"\"},\"PullResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"HostIPSettingsItems\":null},\"EnumerateResponse\":{\"EnumerationContext\":\"\"},\"SetDHCP_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0},\"SetStaticIPv4Parameters_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0}}"
 	result := response.JSON()
 	assert.Equal(t, expectedResult, result)
 }
@@ -34,7 +34,7 @@ func TestYaml(t *testing.T) {
 			PullResponse: PullResponse{},
 		},
 	}
-	expectedResult := "xmlname:\n    space: \"\"\n    local: \"\"\ngetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    dhcpenabled: false\n    elementname: \"\"\n    instanceid: \"\"\npullresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    hostipsettingsitems: []\nenumerateresponse:\n    enumerationcontext: \"\"\n"
+	expectedResult := "xmlname:\n    space: \"\"\n    local: \"\"\ngetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    dhcpenabled: false\n    elementname: \"\"\n    instanceid: \"\"\npullresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    hostipsettingsitems: []\nenumerateresponse:\n    enumerationcontext: \"\"\nsetdhcp_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\nsetstaticipv4parameters_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\n"
 	result := response.YAML()
 	assert.Equal(t, expectedResult, result)
 }
@@ -117,6 +117,49 @@ func TestPositiveIPS_HostIPSettings(t *testing.T) {
 					},
 				},
 			},
+			{
+				"should create a valid IPS_HostIPSettings SetDHCP wsman message",
+				IPSHostIPSettings,
+				message.IPSSchema + IPSHostIPSettings + "/" + SetDHCP,
+				`<h:SetDHCP_INPUT xmlns:h="http://intel.com/wbem/wscim/1/ips-schema/1/IPS_HostIPSettings"></h:SetDHCP_INPUT>`,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = SetDHCP
+
+					return elementUnderTest.SetDHCP()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					SetDHCP_OUTPUT: SetDHCP_OUTPUT{
+						XMLName:     xml.Name{Space: fmt.Sprintf("%s%s", message.IPSSchema, IPSHostIPSettings), Local: "SetDHCP_OUTPUT"},
+						ReturnValue: ReturnValueSuccess,
+					},
+				},
+			},
+			{
+				"should create a valid IPS_HostIPSettings SetStaticIPv4Parameters wsman message",
+				IPSHostIPSettings,
+				message.IPSSchema + IPSHostIPSettings + "/" + SetStaticIPv4Parameters,
+				`<h:SetStaticIPv4Parameters_INPUT xmlns:h="http://intel.com/wbem/wscim/1/ips-schema/1/IPS_HostIPSettings"><h:IPAddress>192.168.1.20</h:IPAddress><h:SubnetMask>255.255.255.0</h:SubnetMask><h:DefaultGatewayAddress>192.168.1.1</h:DefaultGatewayAddress><h:PrimaryDNSAddress>192.168.1.2</h:PrimaryDNSAddress></h:SetStaticIPv4Parameters_INPUT>`,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = SetStaticIPv4Parameters
+
+					return elementUnderTest.SetStaticIPv4Parameters(StaticIPv4Parameters{
+						IPAddress:             "192.168.1.20",
+						SubnetMask:            "255.255.255.0",
+						DefaultGatewayAddress: "192.168.1.1",
+						PrimaryDNSAddress:     "192.168.1.2",
+					})
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					SetStaticIPv4Parameters_OUTPUT: SetStaticIPv4Parameters_OUTPUT{
+						XMLName:     xml.Name{Space: fmt.Sprintf("%s%s", message.IPSSchema, IPSHostIPSettings), Local: "SetStaticIPv4Parameters_OUTPUT"},
+						ReturnValue: ReturnValueSuccess,
+					},
+				},
+			},
 		}
 
 		for _, test := range tests {
@@ -131,3 +174,55 @@ func TestPositiveIPS_HostIPSettings(t *testing.T) {
 		}
 	})
 }
+
+func TestNegativeIPS_HostIPSettings(t *testing.T) {
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "ips/hostipsettings",
+		CurrentMessage:   wsmantesting.CurrentMessageError,
+	}
+	elementUnderTest := NewHostIPSettingsWithClient(message.NewWSManMessageCreator(wsmantesting.IPSResourceURIBase), &client)
+
+	_, err := elementUnderTest.SetDHCP()
+	assert.Error(t, err)
+
+	_, err = elementUnderTest.SetStaticIPv4Parameters(StaticIPv4Parameters{IPAddress: "192.168.1.20", SubnetMask: "255.255.255.0"})
+	assert.Error(t, err)
+
+	_, err = elementUnderTest.SetStaticIPv4Parameters(StaticIPv4Parameters{IPAddress: "192.168.1.20", SubnetMask: "255.0.255.0"})
+	assert.ErrorIs(t, err, ErrInvalidSubnetMask)
+}
+
+func TestStaticIPv4ParametersValidate(t *testing.T) {
+	valid := StaticIPv4Parameters{
+		IPAddress:             "10.0.0.10",
+		SubnetMask:            "255.255.252.0",
+		DefaultGatewayAddress: "10.0.3.254",
+		PrimaryDNSAddress:     "10.1.0.2",
+		SecondaryDNSAddress:   "10.1.0.3",
+	}
+	assert.NoError(t, valid.Validate())
+
+	tests := []struct {
+		name     string
+		mutate   func(*StaticIPv4Parameters)
+		expected error
+	}{
+		{"missing address", func(p *StaticIPv4Parameters) { p.IPAddress = "" }, ErrInvalidAddress},
+		{"IPv6 address", func(p *StaticIPv4Parameters) { p.IPAddress = "2001:db8::1" }, ErrInvalidAddress},
+		{"multicast address", func(p *StaticIPv4Parameters) { p.IPAddress = "224.0.0.1" }, ErrInvalidAddress},
+		{"missing mask", func(p *StaticIPv4Parameters) { p.SubnetMask = "" }, ErrInvalidSubnetMask},
+		{"non-contiguous mask", func(p *StaticIPv4Parameters) { p.SubnetMask = "255.255.0.255" }, ErrInvalidSubnetMask},
+		{"host mask", func(p *StaticIPv4Parameters) { p.SubnetMask = "255.255.255.255" }, ErrInvalidSubnetMask},
+		{"gateway on another subnet", func(p *StaticIPv4Parameters) { p.DefaultGatewayAddress = "10.0.4.1" }, ErrInvalidAddress},
+		{"gateway is the host", func(p *StaticIPv4Parameters) { p.DefaultGatewayAddress = p.IPAddress }, ErrInvalidAddress},
+		{"invalid DNS", func(p *StaticIPv4Parameters) { p.SecondaryDNSAddress = "dns.example.com" }, ErrInvalidAddress},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			parameters := valid
+			test.mutate(&parameters)
+			assert.ErrorIs(t, parameters.Validate(), test.expected)
+		})
+	}
+}
@@ -26,6 +26,9 @@ type (
 		GetResponse       HostIPSettings `xml:"IPS_HostIPSettings"`
 		PullResponse      PullResponse
 		EnumerateResponse common.EnumerateResponse
+
+		SetDHCP_OUTPUT                 SetDHCP_OUTPUT
+		SetStaticIPv4Parameters_OUTPUT SetStaticIPv4Parameters_OUTPUT
 	}
 
 	HostIPSettings struct {
@@ -39,4 +42,40 @@ type (
 		XMLName             xml.Name         `xml:"PullResponse"`
 		HostIPSettingsItems []HostIPSettings `xml:"Items>IPS_HostIPSettings"`
 	}
+
+	SetDHCP_OUTPUT struct {
+		XMLName     xml.Name    `xml:"SetDHCP_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+
+	SetStaticIPv4Parameters_OUTPUT struct {
+		XMLName     xml.Name    `xml:"SetStaticIPv4Parameters_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+)
+
+// INPUTS
+// Request Types.
+type (
+	// StaticIPv4Parameters are the host IPv4 settings written by SetStaticIPv4Parameters.
+	StaticIPv4Parameters struct {
+		IPAddress             string
+		SubnetMask            string
+		DefaultGatewayAddress string
+		PrimaryDNSAddress     string
+		SecondaryDNSAddress   string
+	}
+
+	SetStaticIPv4Parameters_INPUT struct {
+		XMLName               xml.Name `xml:"h:SetStaticIPv4Parameters_INPUT"`
+		H                     string   `xml:"xmlns:h,attr"`
+		IPAddress             string   `xml:"h:IPAddress"`
+		SubnetMask            string   `xml:"h:SubnetMask"`
+		DefaultGatewayAddress string   `xml:"h:DefaultGatewayAddress,omitempty"`
+		PrimaryDNSAddress     string   `xml:"h:PrimaryDNSAddress,omitempty"`
+		SecondaryDNSAddress   string   `xml:"h:SecondaryDNSAddress,omitempty"`
+	}
 )
+
+// ReturnValue is the completion status of SetDHCP and SetStaticIPv4Parameters.
+type ReturnValue int
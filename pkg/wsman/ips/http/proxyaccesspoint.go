@@ -66,11 +66,11 @@ func NewHTTPProxyAccessPointServiceWithClient(wsmanMessageCreator *message.WSMan
 }
 
 // Delete removes the specified HTTP proxy access point instance.
-func (service ProxyAccessPointService) Delete(name string) (response ProxyAccessPointResponse, err error) {
+func (service ProxyAccessPointService) Delete(name string, opts ...base.HeaderOption) (response ProxyAccessPointResponse, err error) {
 	selector := message.Selector{Name: "Name", Value: name}
 	response = ProxyAccessPointResponse{
 		Message: &client.Message{
-			XMLInput: service.Base.Delete(selector),
+			XMLInput: service.Base.Delete(selector, opts...),
 		},
 	}
 
@@ -116,8 +116,8 @@ func NewHTTPProxyServiceWithClient(wsmanMessageCreator *message.WSManMessageCrea
 
 // AddProxyAccessPoint adds a Proxy access point that will be used when the Intel AMT firmware
 // needs to open a user-initiated connection.
-func (service ProxyService) AddProxyAccessPoint(accessInfo string, infoFormat InfoFormat, port int, networkDnsSuffix string) (response Response, err error) {
-	header := service.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(IPSHTTPProxyService, AddProxyAccessPoint), IPSHTTPProxyService, nil, "", "")
+func (service ProxyService) AddProxyAccessPoint(accessInfo string, infoFormat InfoFormat, port int, networkDnsSuffix string, opts ...base.HeaderOption) (response Response, err error) {
+	header := service.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(IPSHTTPProxyService, AddProxyAccessPoint), IPSHTTPProxyService, nil, "", "", opts...)
 	body := service.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod("AddProxyAccessPoint"), IPSHTTPProxyService, AddProxyAccessPoint_INPUT{
 		H:                "http://intel.com/wbem/wscim/1/ips-schema/1/IPS_HTTPProxyService",
 		AccessInfo:       accessInfo,
@@ -25,8 +25,11 @@ func NewIEEE8021xSettingsWithClient(wsmanMessageCreator *message.WSManMessageCre
 	}
 }
 
-func (settings Settings) SetCertificates(serverCertificateIssuer, clientCertificate string) (response Response, err error) {
-	header := settings.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(IPSIEEE8021xSettings, SetCertificates), IPSIEEE8021xSettings, nil, "", "")
+// SetCertificates binds the trusted root certificate and the client certificate, both given by their
+// AMT_PublicKeyCertificate InstanceID, to the 802.1x settings. An empty clientCertificate binds the root certificate
+// only, for protocols that don't authenticate the client with a certificate.
+func (settings Settings) SetCertificates(serverCertificateIssuer, clientCertificate string, opts ...base.HeaderOption) (response Response, err error) {
+	header := settings.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(IPSIEEE8021xSettings, SetCertificates), IPSIEEE8021xSettings, nil, "", "", opts...)
 	serverCert := ServerCertificateIssuer{
 		Address: "default",
 		ReferenceParameters: ReferenceParameters{
@@ -51,13 +54,21 @@ func (settings Settings) SetCertificates(serverCertificateIssuer, clientCertific
 			},
 		},
 	}
-	body := settings.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(SetCertificates), IPSIEEE8021xSettings,
-		Certificate{
+
+	var input any = Certificate{
+		H:                       "http://intel.com/wbem/wscim/1/ips-schema/1/IPS_IEEE8021xSettings",
+		ServerCertificateIssuer: serverCert,
+		ClientCertificate:       clientCert,
+	}
+
+	if clientCertificate == "" {
+		input = ServerCertificate{
 			H:                       "http://intel.com/wbem/wscim/1/ips-schema/1/IPS_IEEE8021xSettings",
 			ServerCertificateIssuer: serverCert,
-			ClientCertificate:       clientCert,
-		},
-	)
+		}
+	}
+
+	body := settings.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(SetCertificates), IPSIEEE8021xSettings, input)
 	response = Response{
 		Message: &client.Message{
 			XMLInput: settings.Base.WSManMessageCreator.CreateXML(header, body),
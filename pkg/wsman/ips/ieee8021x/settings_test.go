@@ -128,6 +128,25 @@ func TestPositiveIPS_IEEE8021xSettings(t *testing.T) {
 					},
 				},
 			},
+			{
+				"should create a valid ips_IEEE8021xSettings set certificates wsman message without a client certificate",
+				"IPS_IEEE8021xSettings",
+				wsmantesting.SetCertificates,
+				fmt.Sprintf(`<h:SetCertificates_INPUT xmlns:h="http://intel.com/wbem/wscim/1/ips-schema/1/IPS_IEEE8021xSettings"><h:ServerCertificateIssuer><a:Address>default</a:Address><a:ReferenceParameters><w:ResourceURI>http://intel.com/wbem/wscim/1/amt-schema/1/AMT_PublicKeyCertificate</w:ResourceURI><w:SelectorSet><w:Selector Name="InstanceID">%s</w:Selector></w:SelectorSet></a:ReferenceParameters></h:ServerCertificateIssuer></h:SetCertificates_INPUT>`, wsmantesting.ServerCertificateIssuer),
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = "SetCertificates"
+
+					return elementUnderTest.SetCertificates(wsmantesting.ServerCertificateIssuer, "")
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					SetCertificatesResponse: SetCertificates_OUTPUT{
+						XMLName:     xml.Name{Space: fmt.Sprintf("%s%s", message.IPSSchema, IPSIEEE8021xSettings), Local: "SetCertificates_OUTPUT"},
+						ReturnValue: 0,
+					},
+				},
+			},
 		}
 
 		for _, test := range tests {
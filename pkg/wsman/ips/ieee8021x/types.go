@@ -114,6 +114,12 @@ type (
 		ServerCertificateIssuer ServerCertificateIssuer
 		ClientCertificate       ClientCertificateIssuer
 	}
+	// ServerCertificate is the SetCertificates input of a profile that only trusts a root certificate, such as PEAP.
+	ServerCertificate struct {
+		XMLName                 xml.Name `xml:"h:SetCertificates_INPUT"`
+		H                       string   `xml:"xmlns:h,attr"`
+		ServerCertificateIssuer ServerCertificateIssuer
+	}
 	ServerCertificateIssuer struct {
 		XMLName             xml.Name            `xml:"h:ServerCertificateIssuer"`
 		Address             string              `xml:"a:Address"`
@@ -7,4 +7,40 @@ package ipv6portsettings
 
 const (
 	IPSIPv6PortSettings string = "IPS_IPv6PortSettings"
+	ValueNotFound       string = "Value not found in map"
 )
+
+// WiredInstanceID is the InstanceID of the settings of the wired port. The wireless port, when present, has the next
+// index, as with AMT_EthernetPortSettings.
+const WiredInstanceID = "Intel(r) IPS IPv6 Settings 0"
+
+// ManualInterfaceIDLength is the number of hexadecimal digits of a manual interface ID, the low 64 bits of the address.
+const ManualInterfaceIDLength = 16
+
+const (
+	// InterfaceIDTypeRandomized derives the interface ID from random numbers, see RFC 4941.
+	InterfaceIDTypeRandomized InterfaceIDType = iota
+	// InterfaceIDTypeIntelID derives the interface ID from the Intel vendor ID and the device MAC address.
+	InterfaceIDTypeIntelID
+	// InterfaceIDTypeManual uses ManualInterfaceID.
+	InterfaceIDTypeManual
+	// InterfaceIDTypeInvalid is reported when the setting is not configured.
+	InterfaceIDTypeInvalid
+)
+
+// interfaceIDTypeToString is a map of InterfaceIDType values to their string representation.
+var interfaceIDTypeToString = map[InterfaceIDType]string{
+	InterfaceIDTypeRandomized: "Randomized",
+	InterfaceIDTypeIntelID:    "IntelID",
+	InterfaceIDTypeManual:     "Manual",
+	InterfaceIDTypeInvalid:    "Invalid",
+}
+
+// String returns the string representation of the InterfaceIDType value.
+func (i InterfaceIDType) String() string {
+	if value, exists := interfaceIDTypeToString[i]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
@@ -7,11 +7,25 @@
 package ipv6portsettings
 
 import (
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+
 	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/base"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
 )
 
+var (
+	ErrInvalidAddress           = errors.New("invalid IPv6 address")
+	ErrInvalidInterfaceID       = errors.New("invalid manual interface ID")
+	ErrInvalidInterfaceIDType   = errors.New("invalid interface ID type")
+	ErrManualInterfaceIDMissing = errors.New("manual interface ID type requires a manual interface ID")
+)
+
 type Settings struct {
 	base.WSManService[Response]
 }
@@ -22,3 +36,128 @@ func NewIPv6PortSettingsWithClient(wsmanMessageCreator *message.WSManMessageCrea
 		base.NewService[Response](wsmanMessageCreator, IPSIPv6PortSettings, client),
 	}
 }
+
+// Put validates request and writes it to the instance identified by instanceID. It overrides the generic Put because
+// the wired and wireless ports each have an instance, which the generic Put can't address.
+func (settings Settings) Put(instanceID string, request IPv6PortSettingsRequest, opts ...base.HeaderOption) (response Response, err error) {
+	if err := request.Validate(); err != nil {
+		return response, err
+	}
+
+	request.H = fmt.Sprintf("%s%s", message.IPSSchema, IPSIPv6PortSettings)
+	selector := []message.Selector{{
+		Name:  "InstanceID",
+		Value: instanceID,
+	}}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: settings.Base.Put(request, true, selector, opts...),
+		},
+	}
+
+	err = settings.Base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+
+	return response, err
+}
+
+// ToRequest returns the writable properties of the settings as a request for Put.
+func (settings IPv6PortSettings) ToRequest() IPv6PortSettingsRequest {
+	return IPv6PortSettingsRequest{
+		DefaultRouter:     settings.DefaultRouter,
+		ElementName:       settings.ElementName,
+		IPv6Address:       settings.IPv6Address,
+		InstanceID:        settings.InstanceID,
+		InterfaceIDType:   InterfaceIDType(settings.InterfaceIDType),
+		ManualInterfaceID: settings.ManualInterfaceID,
+		PrimaryDNS:        settings.PrimaryDNS,
+		SecondaryDNS:      settings.SecondaryDNS,
+	}
+}
+
+// Validate checks the addresses and the interface ID of the request. Empty addresses and the unspecified address "::",
+// which the firmware reports for unset properties, are accepted.
+func (request IPv6PortSettingsRequest) Validate() error {
+	switch request.InterfaceIDType {
+	case InterfaceIDTypeRandomized, InterfaceIDTypeIntelID, InterfaceIDTypeManual:
+	case InterfaceIDTypeInvalid:
+		return fmt.Errorf("%w: %s", ErrInvalidInterfaceIDType, request.InterfaceIDType)
+	default:
+		return fmt.Errorf("%w: %d", ErrInvalidInterfaceIDType, request.InterfaceIDType)
+	}
+
+	if err := validateInterfaceID(request.ManualInterfaceID, request.InterfaceIDType == InterfaceIDTypeManual); err != nil {
+		return err
+	}
+
+	// a manual address is a global or unique local address; link-local addresses are always derived by the firmware
+	address, err := parseAddress("IPv6Address", request.IPv6Address)
+	if err != nil {
+		return err
+	}
+
+	if address.IsLinkLocalUnicast() || address.IsLoopback() {
+		return fmt.Errorf("%w: IPv6Address %s must be a global or unique local address", ErrInvalidAddress, address)
+	}
+
+	// routers advertise themselves with their link-local addresses, so any unicast address is a valid default router
+	if _, err := parseAddress("DefaultRouter", request.DefaultRouter); err != nil {
+		return err
+	}
+
+	if _, err := parseAddress("PrimaryDNS", request.PrimaryDNS); err != nil {
+		return err
+	}
+
+	_, err = parseAddress("SecondaryDNS", request.SecondaryDNS)
+
+	return err
+}
+
+// parseAddress parses an optional IPv6 unicast address. The zero Addr is returned for an unset address.
+func parseAddress(property, value string) (netip.Addr, error) {
+	if value == "" {
+		return netip.Addr{}, nil
+	}
+
+	address, err := netip.ParseAddr(value)
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("%w: %s %q", ErrInvalidAddress, property, value)
+	}
+
+	if address.IsUnspecified() && address.Is6() {
+		return netip.Addr{}, nil
+	}
+
+	if !address.Is6() || address.Is4In6() || address.Zone() != "" || address.IsMulticast() {
+		return netip.Addr{}, fmt.Errorf("%w: %s %s must be an IPv6 unicast address", ErrInvalidAddress, property, value)
+	}
+
+	return address, nil
+}
+
+// validateInterfaceID checks that a manual interface ID has 16 hexadecimal digits. The firmware reports all zeros when
+// the interface ID is not manual, so zeros are only rejected when the ID is required.
+func validateInterfaceID(interfaceID string, required bool) error {
+	if interfaceID == "" {
+		if required {
+			return ErrManualInterfaceIDMissing
+		}
+
+		return nil
+	}
+
+	if _, err := hex.DecodeString(interfaceID); err != nil || len(interfaceID) != ManualInterfaceIDLength {
+		return fmt.Errorf("%w: %q must have %d hexadecimal digits", ErrInvalidInterfaceID, interfaceID, ManualInterfaceIDLength)
+	}
+
+	if required && strings.Trim(interfaceID, "0") == "" {
+		return ErrManualInterfaceIDMissing
+	}
+
+	return nil
+}
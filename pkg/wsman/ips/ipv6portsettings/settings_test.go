@@ -133,6 +133,45 @@ func TestPositiveIPS_IPv6PortSettings(t *testing.T) {
 					},
 				},
 			},
+			{
+				"should create a valid IPS_IPv6PortSettings Put wsman message",
+				IPSIPv6PortSettings,
+				wsmantesting.Put,
+				`<h:IPS_IPv6PortSettings xmlns:h="http://intel.com/wbem/wscim/1/ips-schema/1/IPS_IPv6PortSettings"><h:DefaultRouter>fe80::1</h:DefaultRouter><h:ElementName>Intel(r) IPS IPv6 Settings 0</h:ElementName><h:IPv6Address>2001:db8::10</h:IPv6Address><h:InstanceID>Intel(r) IPS IPv6 Settings 0</h:InstanceID><h:InterfaceIDType>2</h:InterfaceIDType><h:ManualInterfaceID>0000000000000010</h:ManualInterfaceID><h:PrimaryDNS>2001:db8::53</h:PrimaryDNS><h:SecondaryDNS>::</h:SecondaryDNS></h:IPS_IPv6PortSettings>`,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) IPS IPv6 Settings 0</w:Selector></w:SelectorSet>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePut
+					request := IPv6PortSettings{
+						DefaultRouter:     "fe80::1",
+						ElementName:       "Intel(r) IPS IPv6 Settings 0",
+						IPv6Address:       "2001:db8::10",
+						InstanceID:        "Intel(r) IPS IPv6 Settings 0",
+						InterfaceIDType:   int(InterfaceIDTypeManual),
+						ManualInterfaceID: "0000000000000010",
+						PrimaryDNS:        "2001:db8::53",
+						SecondaryDNS:      "::",
+					}.ToRequest()
+
+					return elementUnderTest.Put(request.InstanceID, request)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					GetResponse: IPv6PortSettings{
+						XMLName:              xml.Name{Space: fmt.Sprintf("%s%s", message.IPSSchema, IPSIPv6PortSettings), Local: IPSIPv6PortSettings},
+						CurrentDefaultRouter: "::",
+						CurrentPrimaryDNS:    "::",
+						CurrentSecondaryDNS:  "::",
+						DefaultRouter:        "fe80::1",
+						ElementName:          "Intel(r) IPS IPv6 Settings 0",
+						IPv6Address:          "2001:db8::10",
+						InstanceID:           "Intel(r) IPS IPv6 Settings 0",
+						InterfaceIDType:      2,
+						ManualInterfaceID:    "0000000000000010",
+						PrimaryDNS:           "2001:db8::53",
+						SecondaryDNS:         "::",
+					},
+				},
+			},
 		}
 
 		for _, test := range tests {
@@ -147,3 +186,62 @@ func TestPositiveIPS_IPv6PortSettings(t *testing.T) {
 		}
 	})
 }
+
+func TestNegativeIPS_IPv6PortSettingsPut(t *testing.T) {
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "ips/ipv6portsettings",
+		CurrentMessage:   wsmantesting.CurrentMessageError,
+	}
+	elementUnderTest := NewIPv6PortSettingsWithClient(message.NewWSManMessageCreator(wsmantesting.IPSResourceURIBase), &client)
+
+	_, err := elementUnderTest.Put("Intel(r) IPS IPv6 Settings 0", IPv6PortSettingsRequest{IPv6Address: "2001:db8::10"})
+	assert.Error(t, err)
+
+	_, err = elementUnderTest.Put("Intel(r) IPS IPv6 Settings 0", IPv6PortSettingsRequest{IPv6Address: "ff02::1"})
+	assert.ErrorIs(t, err, ErrInvalidAddress)
+}
+
+func TestIPv6PortSettingsRequestValidate(t *testing.T) {
+	valid := IPv6PortSettingsRequest{
+		DefaultRouter:     "fe80::1",
+		IPv6Address:       "2001:db8::10",
+		InterfaceIDType:   InterfaceIDTypeManual,
+		ManualInterfaceID: "0123456789abcdef",
+		PrimaryDNS:        "2001:db8::53",
+		SecondaryDNS:      "::",
+	}
+	assert.NoError(t, valid.Validate())
+	assert.NoError(t, IPv6PortSettings{IPv6Address: "::", ManualInterfaceID: "0000000000000000"}.ToRequest().Validate())
+
+	tests := []struct {
+		name     string
+		mutate   func(*IPv6PortSettingsRequest)
+		expected error
+	}{
+		{"invalid address", func(r *IPv6PortSettingsRequest) { r.IPv6Address = "2001:db8::g" }, ErrInvalidAddress},
+		{"IPv4 address", func(r *IPv6PortSettingsRequest) { r.IPv6Address = "192.168.1.10" }, ErrInvalidAddress},
+		{"IPv4-mapped address", func(r *IPv6PortSettingsRequest) { r.IPv6Address = "::ffff:192.168.1.10" }, ErrInvalidAddress},
+		{"link-local address", func(r *IPv6PortSettingsRequest) { r.IPv6Address = "fe80::10" }, ErrInvalidAddress},
+		{"multicast router", func(r *IPv6PortSettingsRequest) { r.DefaultRouter = "ff02::2" }, ErrInvalidAddress},
+		{"scoped router", func(r *IPv6PortSettingsRequest) { r.DefaultRouter = "fe80::1%eth0" }, ErrInvalidAddress},
+		{"invalid DNS", func(r *IPv6PortSettingsRequest) { r.PrimaryDNS = "dns.example.com" }, ErrInvalidAddress},
+		{"short interface ID", func(r *IPv6PortSettingsRequest) { r.ManualInterfaceID = "0123" }, ErrInvalidInterfaceID},
+		{"non-hexadecimal interface ID", func(r *IPv6PortSettingsRequest) { r.ManualInterfaceID = "0123456789abcdeg" }, ErrInvalidInterfaceID},
+		{"missing interface ID", func(r *IPv6PortSettingsRequest) { r.ManualInterfaceID = "" }, ErrManualInterfaceIDMissing},
+		{"zero interface ID", func(r *IPv6PortSettingsRequest) { r.ManualInterfaceID = "0000000000000000" }, ErrManualInterfaceIDMissing},
+		{"invalid interface ID type", func(r *IPv6PortSettingsRequest) { r.InterfaceIDType = InterfaceIDTypeInvalid }, ErrInvalidInterfaceIDType},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request := valid
+			test.mutate(&request)
+			assert.ErrorIs(t, request.Validate(), test.expected)
+		})
+	}
+}
+
+func TestInterfaceIDTypeString(t *testing.T) {
+	assert.Equal(t, "Manual", InterfaceIDTypeManual.String())
+	assert.Equal(t, ValueNotFound, InterfaceIDType(9).String())
+}
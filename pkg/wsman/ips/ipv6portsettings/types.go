@@ -48,3 +48,24 @@ type (
 		IPv6PortSettingsItems []IPv6PortSettings `xml:"Items>IPS_IPv6PortSettings"`
 	}
 )
+
+// INPUTS
+// Request Types.
+type (
+	// IPv6PortSettingsRequest is the writable part of IPS_IPv6PortSettings. The Current* properties are read only.
+	IPv6PortSettingsRequest struct {
+		XMLName           xml.Name        `xml:"h:IPS_IPv6PortSettings"`
+		H                 string          `xml:"xmlns:h,attr"`
+		DefaultRouter     string          `xml:"h:DefaultRouter,omitempty"`
+		ElementName       string          `xml:"h:ElementName,omitempty"`
+		IPv6Address       string          `xml:"h:IPv6Address,omitempty"`
+		InstanceID        string          `xml:"h:InstanceID,omitempty"`
+		InterfaceIDType   InterfaceIDType `xml:"h:InterfaceIDType"`
+		ManualInterfaceID string          `xml:"h:ManualInterfaceID,omitempty"`
+		PrimaryDNS        string          `xml:"h:PrimaryDNS,omitempty"`
+		SecondaryDNS      string          `xml:"h:SecondaryDNS,omitempty"`
+	}
+)
+
+// InterfaceIDType is how the firmware builds the interface ID of its IPv6 addresses.
+type InterfaceIDType int
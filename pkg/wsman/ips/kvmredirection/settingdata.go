@@ -26,9 +26,9 @@ func NewKVMRedirectionSettingDataWithClient(creator *message.WSManMessageCreator
 	}
 }
 
-func (settings *SettingData) TerminateSession() (response Response, err error) {
+func (settings *SettingData) TerminateSession(opts ...base.HeaderOption) (response Response, err error) {
 	// TerminateSession stops an active KVM session.
-	header := settings.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(IPSKVMRedirectionSettingData, TerminateSession), IPSKVMRedirectionSettingData, nil, "", "")
+	header := settings.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(IPSKVMRedirectionSettingData, TerminateSession), IPSKVMRedirectionSettingData, nil, "", "", opts...)
 	body := settings.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(TerminateSession), IPSKVMRedirectionSettingData, nil)
 
 	response = Response{
@@ -49,3 +49,39 @@ func (settings *SettingData) TerminateSession() (response Response, err error) {
 
 	return response, nil
 }
+
+// ToRequest copies the writable properties of the settings into a Put request. The RFB password is left empty, so it
+// is omitted from the Put and keeps its value on the device.
+func (settings KVMRedirectionSettingsResponse) ToRequest() KVMRedirectionSettingsRequest {
+	return KVMRedirectionSettingsRequest{
+		ElementName:                    settings.ElementName,
+		InstanceID:                     settings.InstanceID,
+		EnabledByMEBx:                  settings.EnabledByMEBx,
+		BackToBackFbMode:               settings.BackToBackFbMode,
+		Is5900PortEnabled:              settings.Is5900PortEnabled,
+		OptInPolicy:                    settings.OptInPolicy,
+		SessionTimeout:                 settings.SessionTimeout,
+		DefaultScreen:                  settings.DefaultScreen,
+		InitialDecimationModeForLowRes: settings.InitialDecimationModeForLowRes,
+		GreyscalePixelFormatSupported:  settings.GreyscalePixelFormatSupported,
+		ZlibControlSupported:           settings.ZlibControlSupported,
+		DoubleBufferMode:               settings.DoubleBufferMode,
+		DoubleBufferState:              settings.DoubleBufferState,
+	}
+}
+
+// settingsConversion declares how IPS_KVMRedirectionSettingData is read back for Update. The RFB password is never
+// returned by Get.
+var settingsConversion = base.Conversion[Response, KVMRedirectionSettingsRequest]{
+	ToRequest: func(response Response) KVMRedirectionSettingsRequest {
+		return response.Body.KVMRedirectionSettingsResponse.ToRequest()
+	},
+	WriteOnly: func(request *KVMRedirectionSettingsRequest) {
+		request.RFBPassword = ""
+	},
+}
+
+// Update reads the settings, applies mutate and writes the whole instance back. See base.Update.
+func (settings SettingData) Update(mutate func(*KVMRedirectionSettingsRequest), opts ...base.UpdateOption) (Response, error) {
+	return base.Update(settings.WSManService, settingsConversion, mutate, opts...)
+}
@@ -149,3 +149,42 @@ func TestPositiveIPS_KVMRedirectionSettingData(t *testing.T) {
 		}
 	})
 }
+
+func TestIPS_KVMRedirectionSettingData_Update(t *testing.T) {
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "ips/kvmredirection/settings",
+		CurrentMessage:   wsmantesting.CurrentMessageGet,
+	}
+	elementUnderTest := NewKVMRedirectionSettingDataWithClient(message.NewWSManMessageCreator(wsmantesting.IPSResourceURIBase), &client)
+
+	response, err := elementUnderTest.Update(func(request *KVMRedirectionSettingsRequest) {
+		request.RFBPassword = "P@ssw0rd"
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, response.XMLInput, "<w:ResourceURI>http://intel.com/wbem/wscim/1/ips-schema/1/IPS_KVMRedirectionSettingData</w:ResourceURI>")
+
+	// the instance does not change between the two reads, so the change is written
+	response, err = elementUnderTest.Update(func(request *KVMRedirectionSettingsRequest) {
+		request.Is5900PortEnabled = true
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, response.XMLInput, "<h:Is5900PortEnabled>true</h:Is5900PortEnabled>")
+
+	client.CurrentMessage = wsmantesting.CurrentMessageError
+	_, err = elementUnderTest.Update(func(*KVMRedirectionSettingsRequest) {})
+	assert.Error(t, err)
+}
+
+func TestKVMRedirectionSettingsResponse_ToRequest(t *testing.T) {
+	request := KVMRedirectionSettingsResponse{InstanceID: "Intel(r) KVM Redirection Settings", RFBPassword: "ignored"}.ToRequest()
+	assert.Empty(t, request.RFBPassword)
+
+	data, err := xml.Marshal(request)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "RFBPassword")
+
+	request.RFBPassword = "P@ssw0rd"
+	data, err = xml.Marshal(request)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), "<h:RFBPassword>P@ssw0rd</h:RFBPassword>")
+}
@@ -73,7 +73,7 @@ type (
 		Is5900PortEnabled              bool     `xml:"h:Is5900PortEnabled"`
 		OptInPolicy                    bool     `xml:"h:OptInPolicy"`
 		SessionTimeout                 uint16   `xml:"h:SessionTimeout"`
-		RFBPassword                    string   `xml:"h:RFBPassword"`
+		RFBPassword                    string   `xml:"h:RFBPassword,omitempty"`
 		DefaultScreen                  uint8    `xml:"h:DefaultScreen"`
 		InitialDecimationModeForLowRes uint8    `xml:"h:InitialDecimationModeForLowRes"`
 		GreyscalePixelFormatSupported  bool     `xml:"h:GreyscalePixelFormatSupported"`
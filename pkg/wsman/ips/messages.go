@@ -39,6 +39,7 @@ type Messages struct {
 	IEEE8021xSettings           ieee8021x.Settings
 	PowerManagementService      power.ManagementService
 	ScreenSettingData           screensetting.Data
+	ScreenConfigurationService  screensetting.ConfigurationService
 	SecIOService                secio.Service
 	KVMRedirectionSettingData   kvmredirection.SettingData
 	HTTPProxyService            http.ProxyService
@@ -63,6 +64,7 @@ func NewMessages(client client.WSMan) Messages {
 	m.IEEE8021xSettings = ieee8021x.NewIEEE8021xSettingsWithClient(wsmanMessageCreator, client)
 	m.PowerManagementService = power.NewPowerManagementServiceWithClient(wsmanMessageCreator, client)
 	m.ScreenSettingData = screensetting.NewScreenSettingDataWithClient(wsmanMessageCreator, client)
+	m.ScreenConfigurationService = screensetting.NewScreenConfigurationServiceWithClient(wsmanMessageCreator, client)
 	m.SecIOService = secio.NewSecIOServiceWithClient(wsmanMessageCreator, client)
 	m.KVMRedirectionSettingData = kvmredirection.NewKVMRedirectionSettingDataWithClient(wsmanMessageCreator, client)
 	m.HTTPProxyService = http.NewHTTPProxyServiceWithClient(wsmanMessageCreator, client)
@@ -20,6 +20,7 @@ import (
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/optin"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/power"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/provisioningrecordlog"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/screensetting"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
 )
 
@@ -75,6 +76,10 @@ func TestNewMessages(t *testing.T) {
 		t.Error("PowerManagementService is not initialized")
 	}
 
+	if reflect.DeepEqual(m.ScreenConfigurationService, screensetting.ConfigurationService{}) {
+		t.Error("ScreenConfigurationService is not initialized")
+	}
+
 	if reflect.DeepEqual(m.HTTPProxyService, http.ProxyService{}) {
 		t.Error("HTTPProxyService is not initialized")
 	}
@@ -30,8 +30,8 @@ func NewOptInServiceWithClient(wsmanMessageCreator *message.WSManMessageCreator,
 }
 
 // Send the opt-in code to Intel® AMT.
-func (service Service) SendOptInCode(optInCode int) (response Response, err error) {
-	header := service.Base.WSManMessageCreator.CreateHeader(string(actions.SendOptInCode), IPSOptInService, nil, "", "")
+func (service Service) SendOptInCode(optInCode int, opts ...base.HeaderOption) (response Response, err error) {
+	header := service.Base.WSManMessageCreator.CreateHeader(string(actions.SendOptInCode), IPSOptInService, nil, "", "", opts...)
 	body := service.Base.WSManMessageCreator.CreateBody("SendOptInCode_INPUT", IPSOptInService, OptInCode{
 		H:         "http://intel.com/wbem/wscim/1/ips-schema/1/IPS_OptInService",
 		OptInCode: optInCode,
@@ -56,8 +56,8 @@ func (service Service) SendOptInCode(optInCode int) (response Response, err erro
 }
 
 // Request an opt-in code.
-func (service Service) StartOptIn() (response Response, err error) {
-	header := service.Base.WSManMessageCreator.CreateHeader(string(actions.StartOptIn), IPSOptInService, nil, "", "")
+func (service Service) StartOptIn(opts ...base.HeaderOption) (response Response, err error) {
+	header := service.Base.WSManMessageCreator.CreateHeader(string(actions.StartOptIn), IPSOptInService, nil, "", "", opts...)
 	body := service.Base.WSManMessageCreator.CreateBody("StartOptIn_INPUT", IPSOptInService, nil)
 	response = Response{
 		Message: &client.Message{
@@ -79,8 +79,8 @@ func (service Service) StartOptIn() (response Response, err error) {
 }
 
 // Cancel a previous opt-in code request.
-func (service Service) CancelOptIn() (response Response, err error) {
-	header := service.Base.WSManMessageCreator.CreateHeader(string(actions.CancelOptIn), IPSOptInService, nil, "", "")
+func (service Service) CancelOptIn(opts ...base.HeaderOption) (response Response, err error) {
+	header := service.Base.WSManMessageCreator.CreateHeader(string(actions.CancelOptIn), IPSOptInService, nil, "", "", opts...)
 	body := service.Base.WSManMessageCreator.CreateBody("CancelOptIn_INPUT", IPSOptInService, nil)
 	response = Response{
 		Message: &client.Message{
@@ -102,11 +102,11 @@ func (service Service) CancelOptIn() (response Response, err error) {
 }
 
 // Put will change properties of the selected instance.
-func (service Service) Put(request OptInServiceRequest) (response Response, err error) {
+func (service Service) Put(request OptInServiceRequest, opts ...base.HeaderOption) (response Response, err error) {
 	request.H = fmt.Sprintf("%s%s", message.IPSSchema, IPSOptInService)
 	response = Response{
 		Message: &client.Message{
-			XMLInput: service.Base.Put(request, false, nil),
+			XMLInput: service.Base.Put(request, false, nil, opts...),
 		},
 	}
 
@@ -30,8 +30,8 @@ func NewPowerManagementServiceWithClient(wsmanMessageCreator *message.WSManMessa
 }
 
 // RequestOSPowerSavingStateChange defines the desired OS powersaving state of the managed element, and when the element should be put into that state.
-func (managementService ManagementService) RequestOSPowerSavingStateChange(osPowerSavingState OSPowerSavingState) (response Response, err error) {
-	header := managementService.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(IPSPowerManagementService, RequestOSPowerSavingStateChange), IPSPowerManagementService, nil, "", "")
+func (managementService ManagementService) RequestOSPowerSavingStateChange(osPowerSavingState OSPowerSavingState, opts ...base.HeaderOption) (response Response, err error) {
+	header := managementService.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(IPSPowerManagementService, RequestOSPowerSavingStateChange), IPSPowerManagementService, nil, "", "", opts...)
 
 	body := fmt.Sprintf(`<Body><h:RequestOSPowerSavingStateChange_INPUT xmlns:h="http://intel.com/wbem/wscim/1/ips-schema/1/IPS_PowerManagementService"><h:OSPowerSavingState>%d</h:OSPowerSavingState><h:ManagedElement><Address xmlns="http://schemas.xmlsoap.org/ws/2004/08/addressing">http://schemas.xmlsoap.org/ws/2004/08/addressing</Address><ReferenceParameters xmlns="http://schemas.xmlsoap.org/ws/2004/08/addressing"><ResourceURI xmlns="http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd">http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_ComputerSystem</ResourceURI><SelectorSet xmlns="http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd"><Selector Name="CreationClassName">CIM_ComputerSystem</Selector><Selector Name="Name">ManagedSystem</Selector></SelectorSet></ReferenceParameters></h:ManagedElement></h:RequestOSPowerSavingStateChange_INPUT></Body>`, osPowerSavingState)
 	response = Response{
@@ -6,5 +6,116 @@
 package provisioningrecordlog
 
 const (
-	IPSProvisioningRecordLog string = "IPS_ProvisioningRecordLog"
+	IPSProvisioningRecordLog   string = "IPS_ProvisioningRecordLog"
+	IPSAdminProvisioningRecord string = "IPS_AdminProvisioningRecord"
+	IPSHostBasedSetupRecord    string = "IPS_HostBasedSetupRecord"
+	IPSTLSProvisioningRecord   string = "IPS_TLSProvisioningRecord"
+	IPSProvisioningAuditRecord string = "IPS_ProvisioningAuditRecord"
+	ValueNotFound              string = "Value not found in map"
 )
+
+const (
+	ProvisioningTLSModeNone ProvisioningTLSMode = 0 // No TLS was used, as in host based setup
+	ProvisioningTLSModePKI  ProvisioningTLSMode = 1 // Remote configuration with a provisioning certificate
+	ProvisioningTLSModePSK  ProvisioningTLSMode = 2 // Remote configuration with a pre-shared key
+)
+
+// provisioningTLSModeToString is a map of ProvisioningTLSMode values to their string representation.
+var provisioningTLSModeToString = map[ProvisioningTLSMode]string{
+	ProvisioningTLSModeNone: "None",
+	ProvisioningTLSModePKI:  "PKI",
+	ProvisioningTLSModePSK:  "PSK",
+}
+
+// String returns the string representation of the ProvisioningTLSMode value.
+func (m ProvisioningTLSMode) String() string {
+	if value, exists := provisioningTLSModeToString[m]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+const (
+	HashTypeSHA1   HashType = 0
+	HashTypeSHA256 HashType = 1
+	HashTypeSHA384 HashType = 2
+	HashTypeSHA512 HashType = 3
+)
+
+// hashTypeToString is a map of HashType values to their string representation.
+var hashTypeToString = map[HashType]string{
+	HashTypeSHA1:   "SHA1",
+	HashTypeSHA256: "SHA256",
+	HashTypeSHA384: "SHA384",
+	HashTypeSHA512: "SHA512",
+}
+
+// String returns the string representation of the HashType value.
+func (h HashType) String() string {
+	if value, exists := hashTypeToString[h]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+// hashTypeToSize is a map of HashType values to the length of their digest in bytes.
+var hashTypeToSize = map[HashType]int{
+	HashTypeSHA1:   20,
+	HashTypeSHA256: 32,
+	HashTypeSHA384: 48,
+	HashTypeSHA512: 64,
+}
+
+const (
+	ValidationResultSuccess       ValidationResult = 0
+	ValidationResultUntrustedRoot ValidationResult = 1 // The root certificate hash is not in the trusted hash list
+	ValidationResultExpired       ValidationResult = 2
+	ValidationResultInvalidFQDN   ValidationResult = 3 // The certificate does not match the provisioning server FQDN or DNS suffix
+	ValidationResultInvalidUsage  ValidationResult = 4 // The certificate lacks the AMT provisioning extended key usage
+)
+
+// validationResultToString is a map of ValidationResult values to their string representation.
+var validationResultToString = map[ValidationResult]string{
+	ValidationResultSuccess:       "Success",
+	ValidationResultUntrustedRoot: "UntrustedRoot",
+	ValidationResultExpired:       "Expired",
+	ValidationResultInvalidFQDN:   "InvalidFQDN",
+	ValidationResultInvalidUsage:  "InvalidUsage",
+}
+
+// String returns the string representation of the ValidationResult value.
+func (v ValidationResult) String() string {
+	if value, exists := validationResultToString[v]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+const (
+	ActivationMethodUnknown   ActivationMethod = iota
+	ActivationMethodManual                     // Admin control mode configured locally, e.g. through MEBx
+	ActivationMethodHostBased                  // Client control mode configured through host based setup
+	ActivationMethodRemotePKI                  // Remote configuration authenticated with a provisioning certificate
+	ActivationMethodRemotePSK                  // Remote configuration authenticated with a pre-shared key
+)
+
+// activationMethodToString is a map of ActivationMethod values to their string representation.
+var activationMethodToString = map[ActivationMethod]string{
+	ActivationMethodUnknown:   "Unknown",
+	ActivationMethodManual:    "Manual",
+	ActivationMethodHostBased: "HostBased",
+	ActivationMethodRemotePKI: "RemotePKI",
+	ActivationMethodRemotePSK: "RemotePSK",
+}
+
+// String returns the string representation of the ActivationMethod value.
+func (a ActivationMethod) String() string {
+	if value, exists := activationMethodToString[a]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
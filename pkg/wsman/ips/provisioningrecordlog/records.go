@@ -0,0 +1,300 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package provisioningrecordlog
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"time"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/base"
+)
+
+var (
+	// ErrNoRecords is returned when a log holds no provisioning records to summarise.
+	ErrNoRecords = errors.New("no provisioning records")
+	// ErrInvalidRecord is returned when a record holds a hash, certificate or time stamp that cannot be decoded.
+	ErrInvalidRecord = errors.New("invalid provisioning record")
+)
+
+// Records holds the provisioning records referenced by a provisioning record log, grouped by class.
+type Records struct {
+	Admin          []AdminProvisioningRecord
+	HostBasedSetup []HostBasedSetupRecord
+	TLS            []TLSProvisioningRecord
+	Audit          []ProvisioningAuditRecord
+}
+
+// ActivationSummary describes how a device was activated, decoded from its provisioning records.
+type ActivationSummary struct {
+	Method           ActivationMethod
+	CreatedAt        time.Time
+	ServerFQDN       string
+	ServerIP         string
+	SecureDNS        bool
+	HostInitiated    bool
+	HashType         HashType
+	CertificateHash  string              // Lower case hex hash of the trusted root certificate
+	IsOemDefault     bool                // The root hash was one of the hashes shipped with the firmware
+	IsTimeValid      bool                // The firmware clock was trusted during validation
+	Certificates     []*x509.Certificate // Chain presented by the provisioning server, leaf first
+	HashVerified     bool                // The last certificate of the chain hashes to CertificateHash
+	ValidationResult ValidationResult
+}
+
+// Records follows the associations of the log identified by instanceID to the provisioning records it holds.
+func (log Log) Records(instanceID string, opts ...base.HeaderOption) (records Records, err error) {
+	selector := message.Selector{Name: "InstanceID", Value: instanceID}
+
+	err = eachRecord(log, IPSAdminProvisioningRecord, selector, func(record AdminProvisioningRecord) error {
+		records.Admin = append(records.Admin, record)
+
+		return nil
+	}, opts)
+	if err != nil {
+		return records, err
+	}
+
+	err = eachRecord(log, IPSHostBasedSetupRecord, selector, func(record HostBasedSetupRecord) error {
+		records.HostBasedSetup = append(records.HostBasedSetup, record)
+
+		return nil
+	}, opts)
+	if err != nil {
+		return records, err
+	}
+
+	err = eachRecord(log, IPSTLSProvisioningRecord, selector, func(record TLSProvisioningRecord) error {
+		records.TLS = append(records.TLS, record)
+
+		return nil
+	}, opts)
+	if err != nil {
+		return records, err
+	}
+
+	err = eachRecord(log, IPSProvisioningAuditRecord, selector, func(record ProvisioningAuditRecord) error {
+		records.Audit = append(records.Audit, record)
+
+		return nil
+	}, opts)
+
+	return records, err
+}
+
+// eachRecord streams the instances of class associated with the log to fn.
+func eachRecord[I any](log Log, class string, selector message.Selector, fn func(I) error, opts []base.HeaderOption) error {
+	service := log.WSManService
+	service.Base.ClassName = class
+
+	return base.EachAssociatedItem(service, IPSProvisioningRecordLog, selector, "", class, fn, opts...)
+}
+
+// Summarize decodes the most recent activation in records. The activation method comes from the class of the newest
+// record; the certificate chain and validation result come from the newest TLS provisioning record, if any.
+func Summarize(records Records) (ActivationSummary, error) {
+	var (
+		summary ActivationSummary
+		newest  *ProvisioningRecord
+		found   bool
+	)
+
+	consider := func(record *ProvisioningRecord, method ActivationMethod) error {
+		createdAt, err := record.CreationTimeStamp.Time()
+		if err != nil {
+			return err
+		}
+
+		if found && createdAt.Before(summary.CreatedAt) {
+			return nil
+		}
+
+		found = true
+		newest = record
+		summary.Method = method
+		summary.CreatedAt = createdAt
+
+		return nil
+	}
+
+	for i := range records.Admin {
+		if err := consider(&records.Admin[i].ProvisioningRecord, ActivationMethodManual); err != nil {
+			return summary, err
+		}
+	}
+
+	for i := range records.HostBasedSetup {
+		if err := consider(&records.HostBasedSetup[i].ProvisioningRecord, ActivationMethodHostBased); err != nil {
+			return summary, err
+		}
+	}
+
+	for i := range records.TLS {
+		if err := consider(&records.TLS[i].ProvisioningRecord, remoteMethod(records.TLS[i].ProvisioningTLSMode)); err != nil {
+			return summary, err
+		}
+	}
+
+	for i := range records.Audit {
+		if err := consider(&records.Audit[i].ProvisioningRecord, remoteMethod(records.Audit[i].ProvisioningTLSMode)); err != nil {
+			return summary, err
+		}
+	}
+
+	if !found {
+		return summary, ErrNoRecords
+	}
+
+	summary.ServerFQDN = newest.ProvServerFQDN
+	summary.ServerIP = newest.ProvServerIP
+	summary.SecureDNS = newest.SecureDNS
+	summary.HostInitiated = newest.HostInitiated
+	summary.HashType = newest.SelectedHashType
+	summary.IsOemDefault = newest.IsOemDefault
+	summary.IsTimeValid = newest.IsTimeValid
+
+	hashData, err := newest.Hash()
+	if err != nil {
+		return summary, err
+	}
+
+	summary.CertificateHash = hex.EncodeToString(hashData)
+
+	tls, err := newestTLSRecord(records.TLS)
+	if err != nil || tls == nil {
+		return summary, err
+	}
+
+	summary.ValidationResult = tls.ValidationResult
+
+	summary.Certificates, err = tls.Certificates()
+	if err != nil {
+		return summary, err
+	}
+
+	if len(summary.Certificates) > 0 && len(hashData) > 0 {
+		root := summary.Certificates[len(summary.Certificates)-1]
+		summary.HashVerified = bytes.Equal(certificateHash(summary.HashType, root.Raw), hashData)
+	}
+
+	return summary, nil
+}
+
+func remoteMethod(mode ProvisioningTLSMode) ActivationMethod {
+	switch mode {
+	case ProvisioningTLSModePKI:
+		return ActivationMethodRemotePKI
+	case ProvisioningTLSModePSK:
+		return ActivationMethodRemotePSK
+	case ProvisioningTLSModeNone:
+		return ActivationMethodUnknown
+	}
+
+	return ActivationMethodUnknown
+}
+
+func newestTLSRecord(records []TLSProvisioningRecord) (*TLSProvisioningRecord, error) {
+	var (
+		newest   *TLSProvisioningRecord
+		newestAt time.Time
+	)
+
+	for i := range records {
+		createdAt, err := records[i].CreationTimeStamp.Time()
+		if err != nil {
+			return nil, err
+		}
+
+		if newest == nil || !createdAt.Before(newestAt) {
+			newest, newestAt = &records[i], createdAt
+		}
+	}
+
+	return newest, nil
+}
+
+// Time parses the CIM date time, returning the zero time when it is not set.
+func (d Datetime) Time() (time.Time, error) {
+	if d.Datetime == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, d.Datetime)
+	if err != nil {
+		return t, fmt.Errorf("%w: creation time stamp %q", ErrInvalidRecord, d.Datetime)
+	}
+
+	return t, nil
+}
+
+// Hash decodes SelectedHashData and checks its length against SelectedHashType.
+func (record ProvisioningRecord) Hash() ([]byte, error) {
+	if record.SelectedHashData == "" {
+		return nil, nil
+	}
+
+	data, err := base64.StdEncoding.DecodeString(record.SelectedHashData)
+	if err != nil {
+		return nil, fmt.Errorf("%w: hash data: %w", ErrInvalidRecord, err)
+	}
+
+	if size, ok := hashTypeToSize[record.SelectedHashType]; !ok || size != len(data) {
+		return nil, fmt.Errorf("%w: %d byte hash does not match hash type %s", ErrInvalidRecord, len(data), record.SelectedHashType)
+	}
+
+	return data, nil
+}
+
+// Certificates decodes the certificate chain presented by the provisioning server.
+func (record TLSProvisioningRecord) Certificates() ([]*x509.Certificate, error) {
+	certificates := make([]*x509.Certificate, 0, len(record.CertificateChain))
+
+	for i, encoded := range record.CertificateChain {
+		der, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("%w: certificate %d: %w", ErrInvalidRecord, i, err)
+		}
+
+		certificate, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, fmt.Errorf("%w: certificate %d: %w", ErrInvalidRecord, i, err)
+		}
+
+		certificates = append(certificates, certificate)
+	}
+
+	return certificates, nil
+}
+
+func certificateHash(hashType HashType, der []byte) []byte {
+	var h hash.Hash
+
+	switch hashType {
+	case HashTypeSHA1:
+		h = sha1.New()
+	case HashTypeSHA256:
+		h = sha256.New()
+	case HashTypeSHA384:
+		h = sha512.New384()
+	case HashTypeSHA512:
+		h = sha512.New()
+	default:
+		return nil
+	}
+
+	h.Write(der)
+
+	return h.Sum(nil)
+}
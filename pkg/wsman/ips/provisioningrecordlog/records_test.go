@@ -0,0 +1,161 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package provisioningrecordlog
+
+import (
+	"encoding/base64"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+var resourceClass = regexp.MustCompile(`<w:ResourceURI>[^<]*/([A-Za-z_]+)</w:ResourceURI>`)
+
+// recordClient answers association enumerations with the records fixture of the requested class.
+type recordClient struct {
+	wsmantesting.MockClient
+	requests []string
+}
+
+func (c *recordClient) Post(msg string) ([]byte, error) {
+	c.requests = append(c.requests, msg)
+	c.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+	if strings.Contains(msg, message.BaseActionsPull) {
+		c.CurrentMessage = "empty"
+
+		class := strings.ToLower(resourceClass.FindStringSubmatch(msg)[1])
+		if _, err := os.Stat("../../wsmantesting/responses/" + c.PackageUnderTest + "/" + class + ".xml"); err == nil {
+			c.CurrentMessage = class
+		}
+	}
+
+	return c.MockClient.Post(msg)
+}
+
+func TestLogRecords(t *testing.T) {
+	client := &recordClient{MockClient: wsmantesting.MockClient{PackageUnderTest: "ips/provisioningrecordlog/records"}}
+	elementUnderTest := NewProvisioningRecordLogWithClient(message.NewWSManMessageCreator(wsmantesting.IPSResourceURIBase), client)
+
+	records, err := elementUnderTest.Records("Intel(r) AMT: RecordLog 1")
+	require.NoError(t, err)
+	assert.Empty(t, records.Admin)
+	assert.Empty(t, records.HostBasedSetup)
+	require.Len(t, records.TLS, 1)
+	require.Len(t, records.Audit, 1)
+	assert.Equal(t, "provisioning.example.com", records.TLS[0].ProvServerFQDN)
+	assert.Len(t, records.TLS[0].CertificateChain, 2)
+	assert.Equal(t, []string{"01"}, records.Audit[0].CaCertSerials)
+	assert.Equal(t, "2026-03-14T09:26:48Z", records.Audit[0].TLSStartTime.Datetime)
+
+	// one enumeration and one pull for each record class
+	require.Len(t, client.requests, 8)
+	assert.Contains(t, client.requests[0], `<w:ResourceURI>http://intel.com/wbem/wscim/1/ips-schema/1/IPS_ProvisioningRecordLog</w:ResourceURI><w:SelectorSet><w:Selector Name="InstanceID">Intel(r) AMT: RecordLog 1</w:Selector></w:SelectorSet>`)
+	assert.Contains(t, client.requests[0], `<b:ResultClassName>IPS_AdminProvisioningRecord</b:ResultClassName>`)
+	assert.Contains(t, client.requests[6], `<b:ResultClassName>IPS_ProvisioningAuditRecord</b:ResultClassName>`)
+
+	summary, err := Summarize(records)
+	require.NoError(t, err)
+	assert.Equal(t, ActivationMethodRemotePKI, summary.Method)
+	assert.Equal(t, time.Date(2026, 3, 14, 9, 26, 53, 0, time.UTC), summary.CreatedAt)
+	assert.Equal(t, "provisioning.example.com", summary.ServerFQDN)
+	assert.Equal(t, "192.168.0.10", summary.ServerIP)
+	assert.True(t, summary.SecureDNS)
+	assert.False(t, summary.HostInitiated)
+	assert.Equal(t, HashTypeSHA256, summary.HashType)
+	assert.Equal(t, "837133e82924c1e0aa31f9ecb614911fe19cd35299d76681edd75a6de3fad56a", summary.CertificateHash)
+	assert.True(t, summary.IsOemDefault)
+	assert.True(t, summary.IsTimeValid)
+	require.Len(t, summary.Certificates, 2)
+	assert.Equal(t, "provisioning.example.com", summary.Certificates[0].Subject.CommonName)
+	assert.True(t, summary.HashVerified)
+	assert.Equal(t, ValidationResultSuccess, summary.ValidationResult)
+}
+
+func TestLogRecordsError(t *testing.T) {
+	client := wsmantesting.MockClient{PackageUnderTest: "ips/provisioningrecordlog/records", CurrentMessage: "missing"}
+	elementUnderTest := NewProvisioningRecordLogWithClient(message.NewWSManMessageCreator(wsmantesting.IPSResourceURIBase), &client)
+
+	_, err := elementUnderTest.Records("Intel(r) AMT: RecordLog 1")
+	assert.Error(t, err)
+}
+
+func record(created string, hashType HashType, hashData []byte) ProvisioningRecord {
+	return ProvisioningRecord{
+		CreationTimeStamp: Datetime{Datetime: created},
+		SelectedHashType:  hashType,
+		SelectedHashData:  base64.StdEncoding.EncodeToString(hashData),
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	t.Run("newest record decides the method", func(t *testing.T) {
+		records := Records{
+			Admin:          []AdminProvisioningRecord{{ProvisioningRecord: record("2025-01-01T00:00:00Z", HashTypeSHA1, make([]byte, 20))}},
+			HostBasedSetup: []HostBasedSetupRecord{{ProvisioningRecord: record("2026-01-01T00:00:00Z", HashTypeSHA384, make([]byte, 48))}},
+		}
+
+		summary, err := Summarize(records)
+		require.NoError(t, err)
+		assert.Equal(t, ActivationMethodHostBased, summary.Method)
+		assert.Equal(t, HashTypeSHA384, summary.HashType)
+		assert.Empty(t, summary.Certificates)
+		assert.False(t, summary.HashVerified)
+	})
+
+	t.Run("PSK audit record", func(t *testing.T) {
+		audit := ProvisioningAuditRecord{ProvisioningRecord: ProvisioningRecord{ProvisioningTLSMode: ProvisioningTLSModePSK}}
+
+		summary, err := Summarize(Records{Audit: []ProvisioningAuditRecord{audit}})
+		require.NoError(t, err)
+		assert.Equal(t, ActivationMethodRemotePSK, summary.Method)
+		assert.True(t, summary.CreatedAt.IsZero())
+		assert.Empty(t, summary.CertificateHash)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := Summarize(Records{})
+		assert.ErrorIs(t, err, ErrNoRecords)
+
+		_, err = Summarize(Records{Admin: []AdminProvisioningRecord{{ProvisioningRecord: record("yesterday", HashTypeSHA256, make([]byte, 32))}}})
+		assert.ErrorIs(t, err, ErrInvalidRecord)
+
+		_, err = Summarize(Records{Admin: []AdminProvisioningRecord{{ProvisioningRecord: record("2026-01-01T00:00:00Z", HashTypeSHA256, make([]byte, 20))}}})
+		assert.ErrorIs(t, err, ErrInvalidRecord)
+
+		invalidHash := record("2026-01-01T00:00:00Z", HashTypeSHA256, nil)
+		invalidHash.SelectedHashData = "not base64"
+		_, err = Summarize(Records{Admin: []AdminProvisioningRecord{{ProvisioningRecord: invalidHash}}})
+		assert.ErrorIs(t, err, ErrInvalidRecord)
+
+		tls := TLSProvisioningRecord{ProvisioningRecord: record("2026-01-01T00:00:00Z", HashTypeSHA512, make([]byte, 64)), CertificateChain: []string{"AAAA"}}
+		_, err = Summarize(Records{TLS: []TLSProvisioningRecord{tls}})
+		assert.ErrorIs(t, err, ErrInvalidRecord)
+
+		tls.CertificateChain = []string{"not base64"}
+		_, err = Summarize(Records{TLS: []TLSProvisioningRecord{tls}})
+		assert.ErrorIs(t, err, ErrInvalidRecord)
+	})
+}
+
+func TestRecordEnumsString(t *testing.T) {
+	assert.Equal(t, "PKI", ProvisioningTLSModePKI.String())
+	assert.Equal(t, ValueNotFound, ProvisioningTLSMode(9).String())
+	assert.Equal(t, "SHA256", HashTypeSHA256.String())
+	assert.Equal(t, ValueNotFound, HashType(9).String())
+	assert.Equal(t, "UntrustedRoot", ValidationResultUntrustedRoot.String())
+	assert.Equal(t, ValueNotFound, ValidationResult(9).String())
+	assert.Equal(t, "HostBased", ActivationMethodHostBased.String())
+	assert.Equal(t, ValueNotFound, ActivationMethod(9).String())
+}
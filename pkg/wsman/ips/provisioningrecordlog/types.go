@@ -46,4 +46,61 @@ type (
 		XMLName                    xml.Name                `xml:"PullResponse"`
 		ProvisioningRecordLogItems []ProvisioningRecordLog `xml:"Items>IPS_ProvisioningRecordLog"`
 	}
+
+	// ProvisioningRecord holds the properties shared by all provisioning record classes.
+	ProvisioningRecord struct {
+		InstanceID          string              `xml:"InstanceID,omitempty"`
+		ElementName         string              `xml:"ElementName,omitempty"`
+		CreationTimeStamp   Datetime            `xml:"CreationTimeStamp"`          // Time the device was provisioned
+		ProvisioningTLSMode ProvisioningTLSMode `xml:"ProvisioningTLSMode"`        // How the provisioning session was authenticated
+		SecureDNS           bool                `xml:"SecureDNS"`                  // The provisioning server FQDN was resolved with a DNS suffix match
+		HostInitiated       bool                `xml:"HostInitiated"`              // The provisioning was started from the host rather than by the server
+		ProvServerFQDN      string              `xml:"ProvServerFQDN,omitempty"`   // FQDN of the provisioning server
+		ProvServerIP        string              `xml:"ProvServerIP,omitempty"`     // IP address of the provisioning server
+		SelectedHashType    HashType            `xml:"SelectedHashType"`           // Algorithm of SelectedHashData
+		SelectedHashData    string              `xml:"SelectedHashData,omitempty"` // Base64 hash of the trusted root certificate that was matched
+		IsOemDefault        bool                `xml:"IsOemDefault"`               // The matched hash was one of the hashes shipped with the firmware
+		IsTimeValid         bool                `xml:"IsTimeValid"`                // The firmware clock was trusted when the certificates were checked
+	}
+
+	AdminProvisioningRecord struct {
+		XMLName xml.Name `xml:"IPS_AdminProvisioningRecord"`
+		ProvisioningRecord
+	}
+
+	HostBasedSetupRecord struct {
+		XMLName xml.Name `xml:"IPS_HostBasedSetupRecord"`
+		ProvisioningRecord
+	}
+
+	TLSProvisioningRecord struct {
+		XMLName xml.Name `xml:"IPS_TLSProvisioningRecord"`
+		ProvisioningRecord
+		CertificateChain []string         `xml:"CertificateChain,omitempty"` // Base64 DER certificates presented by the server, leaf first
+		ValidationResult ValidationResult `xml:"ValidationResult"`           // Outcome of the certificate chain validation
+	}
+
+	ProvisioningAuditRecord struct {
+		XMLName xml.Name `xml:"IPS_ProvisioningAuditRecord"`
+		ProvisioningRecord
+		CaCertSerials          []string `xml:"CaCertSerials,omitempty"` // Serial numbers of the intermediate certificates in the chain
+		AdditionalCaSerialNums bool     `xml:"AdditionalCaSerialNums"`  // More intermediate certificates were used than CaCertSerials holds
+		TLSStartTime           Datetime `xml:"TlsStartTime"`            // Time the TLS session with the provisioning server started
+	}
+
+	Datetime struct {
+		Datetime string `xml:"Datetime,omitempty"`
+	}
+
+	// ProvisioningTLSMode is the authentication used by the provisioning session.
+	ProvisioningTLSMode int
+
+	// HashType is the algorithm of a certificate hash.
+	HashType int
+
+	// ValidationResult is the outcome of the provisioning certificate chain validation.
+	ValidationResult int
+
+	// ActivationMethod summarises how a device was activated.
+	ActivationMethod int
 )
@@ -0,0 +1,104 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package screensetting
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/base"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/methods"
+)
+
+// ConfigurationService controls the KVM user consent screen and the session-state overlay drawn by the firmware.
+type ConfigurationService struct {
+	base.WSManService[Response]
+	screens Data
+}
+
+// NewScreenConfigurationServiceWithClient instantiates a new ConfigurationService.
+func NewScreenConfigurationServiceWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) ConfigurationService {
+	return ConfigurationService{
+		WSManService: base.NewService[Response](wsmanMessageCreator, IPSScreenConfigurationService, client),
+		screens:      NewScreenSettingDataWithClient(wsmanMessageCreator, client),
+	}
+}
+
+// SetSessionState tells the firmware which screen to draw: the consent screen while waiting for the user consent
+// code, the session-state overlay once a session is running, or nothing.
+func (service ConfigurationService) SetSessionState(sessionState SessionState, opts ...base.HeaderOption) (response Response, err error) {
+	header := service.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(IPSScreenConfigurationService, SetSessionState), IPSScreenConfigurationService, nil, "", "", opts...)
+	body := service.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(SetSessionState), IPSScreenConfigurationService, &SetSessionState_INPUT{
+		H:            fmt.Sprintf("%s%s", message.IPSSchema, IPSScreenConfigurationService),
+		SessionState: sessionState,
+	})
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.Base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	err = service.Base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	if response.Body.SetSessionState_OUTPUT.ReturnValue != ReturnValueSuccess {
+		err = errors.New("SetSessionState failed with return code " + response.Body.SetSessionState_OUTPUT.ReturnValue.String())
+	}
+
+	return response, err
+}
+
+// RequestStateChange enables or disables the screen configuration service.
+func (service ConfigurationService) RequestStateChange(requestedState RequestedState, opts ...base.HeaderOption) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.Base.RequestStateChange(methods.GenerateAction(IPSScreenConfigurationService, RequestStateChange), int(requestedState), opts...),
+		},
+	}
+
+	err = service.Base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	if response.Body.RequestStateChange_OUTPUT.ReturnValue != ReturnValueSuccess {
+		err = errors.New("RequestStateChange failed with return code " + response.Body.RequestStateChange_OUTPUT.ReturnValue.String())
+	}
+
+	return response, err
+}
+
+// SelectScreen makes screen, a one-based display index, the default screen streamed by KVM. It reads the current
+// IPS_ScreenSettingData and writes it back with the new primary index.
+func (service ConfigurationService) SelectScreen(screen uint8, opts ...base.HeaderOption) (response Response, err error) {
+	response, err = service.screens.Get(opts...)
+	if err != nil {
+		return response, err
+	}
+
+	request, err := SelectScreenRequest(response.Body.ScreenSettingDataResponse, screen)
+	if err != nil {
+		return response, err
+	}
+
+	return service.screens.Put(&request, opts...)
+}
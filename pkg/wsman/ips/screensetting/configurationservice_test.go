@@ -0,0 +1,217 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package screensetting
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+func TestPositiveIPS_ScreenConfigurationService(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.IPSResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "ips/screensetting/configuration",
+	}
+	elementUnderTest := NewScreenConfigurationServiceWithClient(wsmanMessageCreator, &client)
+
+	expected := ConfigurationServiceResponse{
+		XMLName:                 xml.Name{Space: message.IPSSchema + IPSScreenConfigurationService, Local: IPSScreenConfigurationService},
+		CreationClassName:       IPSScreenConfigurationService,
+		ElementName:             "Intel(r) AMT Screen Configuration Service",
+		Name:                    "Intel(r) AMT Screen Configuration Service",
+		SystemCreationClassName: "CIM_ComputerSystem",
+		SystemName:              "Intel(r) AMT",
+		EnabledState:            EnabledStateEnabled,
+		SessionState:            SessionStateConsentPending,
+	}
+
+	t.Run("IPS_ScreenConfigurationService Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			action       string
+			body         string
+			responseFunc func() (Response, error)
+			check        func(t *testing.T, body Body)
+		}{
+			{
+				"should create a valid IPS_ScreenConfigurationService Get wsman message",
+				wsmantesting.Get,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get()
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, expected, body.ConfigurationServiceResponse)
+				},
+			},
+			{
+				"should create a valid IPS_ScreenConfigurationService Enumerate wsman message",
+				wsmantesting.Enumerate,
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, "DA000000-0000-0000-0000-000000000000", body.EnumerateResponse.EnumerationContext)
+				},
+			},
+			{
+				"should create a valid IPS_ScreenConfigurationService Pull wsman message",
+				wsmantesting.Pull,
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, []ConfigurationServiceResponse{expected}, body.PullResponse.ConfigurationServiceItems)
+				},
+			},
+			{
+				"should create a valid IPS_ScreenConfigurationService SetSessionState wsman message",
+				message.IPSSchema + IPSScreenConfigurationService + "/" + SetSessionState,
+				`<h:SetSessionState_INPUT xmlns:h="http://intel.com/wbem/wscim/1/ips-schema/1/IPS_ScreenConfigurationService"><h:SessionState>2</h:SessionState></h:SetSessionState_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = SetSessionState
+
+					return elementUnderTest.SetSessionState(SessionStateActive)
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, ReturnValueSuccess, body.SetSessionState_OUTPUT.ReturnValue)
+				},
+			},
+			{
+				"should create a valid IPS_ScreenConfigurationService RequestStateChange wsman message",
+				message.IPSSchema + IPSScreenConfigurationService + "/" + RequestStateChange,
+				`<h:RequestStateChange_INPUT xmlns:h="http://intel.com/wbem/wscim/1/ips-schema/1/IPS_ScreenConfigurationService"><h:RequestedState>3</h:RequestedState></h:RequestStateChange_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = RequestStateChange
+
+					return elementUnderTest.RequestStateChange(RequestedStateDisabled)
+				},
+				func(t *testing.T, body Body) {
+					t.Helper()
+					assert.Equal(t, ReturnValueSuccess, body.RequestStateChange_OUTPUT.ReturnValue)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, IPSScreenConfigurationService, test.action, "", test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				test.check(t, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeIPS_ScreenConfigurationService(t *testing.T) {
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "ips/screensetting/configuration",
+		CurrentMessage:   wsmantesting.CurrentMessageError,
+	}
+	elementUnderTest := NewScreenConfigurationServiceWithClient(message.NewWSManMessageCreator(wsmantesting.IPSResourceURIBase), &client)
+
+	_, err := elementUnderTest.SetSessionState(SessionStateNone)
+	assert.Error(t, err)
+
+	_, err = elementUnderTest.RequestStateChange(RequestedStateEnabled)
+	assert.Error(t, err)
+
+	_, err = elementUnderTest.SelectScreen(1)
+	assert.Error(t, err)
+}
+
+func TestSelectScreen(t *testing.T) {
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "ips/screensetting",
+		CurrentMessage:   wsmantesting.CurrentMessageGet,
+	}
+	elementUnderTest := NewScreenConfigurationServiceWithClient(message.NewWSManMessageCreator(wsmantesting.IPSResourceURIBase), &client)
+
+	response, err := elementUnderTest.SelectScreen(2)
+	require.NoError(t, err)
+	assert.Contains(t, response.XMLInput, `<h:IPS_ScreenSettingData xmlns:h="http://intel.com/wbem/wscim/1/ips-schema/1/IPS_ScreenSettingData">`)
+	assert.Contains(t, response.XMLInput, `<h:PrimaryIndex>2</h:PrimaryIndex><h:SecondaryIndex>1</h:SecondaryIndex><h:TertiaryIndex>3</h:TertiaryIndex><h:QuadraryIndex>4</h:QuadraryIndex>`)
+
+	_, err = elementUnderTest.SelectScreen(3)
+	assert.ErrorIs(t, err, ErrScreenNotActive)
+}
+
+func TestSelectScreenRequest(t *testing.T) {
+	settings := ScreenSettingDataResponse{
+		ElementName:    "test",
+		InstanceID:     "Intel(r) Screen Settings",
+		PrimaryIndex:   1,
+		SecondaryIndex: 2,
+		TertiaryIndex:  3,
+		QuadraryIndex:  4,
+		IsActive:       []bool{true, true, true, false},
+		UpperLeftX:     []int{0, 1920, -1920, -1},
+		UpperLeftY:     []int{0, 0, 0, -1},
+		ResolutionX:    []int{1920, 1920, 1920, 0},
+		ResolutionY:    []int{1080, 1080, 1080, 0},
+	}
+
+	request, err := SelectScreenRequest(settings, 3)
+	require.NoError(t, err)
+	assert.Equal(t, ScreenSettingDataRequest{
+		ElementName:    "test",
+		InstanceID:     "Intel(r) Screen Settings",
+		PrimaryIndex:   3,
+		SecondaryIndex: 2,
+		TertiaryIndex:  1,
+		QuadraryIndex:  4,
+		IsActive:       []bool{true, true, true, false},
+		UpperLeftX:     []int32{0, 1920, -1920, -1},
+		UpperLeftY:     []int32{0, 0, 0, -1},
+		ResolutionX:    []uint32{1920, 1920, 1920, 0},
+		ResolutionY:    []uint32{1080, 1080, 1080, 0},
+	}, request)
+
+	request, err = SelectScreenRequest(settings, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []uint8{1, 2, 3, 4}, []uint8{request.PrimaryIndex, request.SecondaryIndex, request.TertiaryIndex, request.QuadraryIndex})
+
+	_, err = SelectScreenRequest(settings, 0)
+	assert.ErrorIs(t, err, ErrInvalidScreen)
+
+	_, err = SelectScreenRequest(settings, 5)
+	assert.ErrorIs(t, err, ErrInvalidScreen)
+
+	_, err = SelectScreenRequest(settings, 4)
+	assert.ErrorIs(t, err, ErrScreenNotActive)
+}
+
+func TestSessionStateString(t *testing.T) {
+	assert.Equal(t, "ConsentPending", SessionStateConsentPending.String())
+	assert.Equal(t, ValueNotFound, SessionState(9).String())
+	assert.Equal(t, "Enabled", EnabledStateEnabled.String())
+	assert.Equal(t, ValueNotFound, EnabledState(9).String())
+	assert.Equal(t, "InvalidParameter", ReturnValueInvalidParameter.String())
+	assert.Equal(t, ValueNotFound, ReturnValue(9).String())
+}
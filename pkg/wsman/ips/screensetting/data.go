@@ -8,6 +8,8 @@ package screensetting
 
 import (
 	"encoding/xml"
+	"errors"
+	"fmt"
 
 	"github.com/device-management-toolkit/go-wsman-messages/v2/internal/message"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/base"
@@ -15,6 +17,13 @@ import (
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/methods"
 )
 
+var (
+	// ErrInvalidScreen is returned when a screen index is outside 1 through MaxScreens.
+	ErrInvalidScreen = errors.New("invalid screen index")
+	// ErrScreenNotActive is returned when the selected screen has no display attached.
+	ErrScreenNotActive = errors.New("screen is not active")
+)
+
 type Data struct {
 	base.WSManService[Response]
 }
@@ -27,8 +36,8 @@ func NewScreenSettingDataWithClient(wsmanMessageCreator *message.WSManMessageCre
 }
 
 // ResetToDefault resets the screen settings to default.
-func (settings Data) ResetToDefault() (response Response, err error) {
-	header := settings.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(IPSScreenSettingData, ResetToDefault), IPSScreenSettingData, nil, "", "")
+func (settings Data) ResetToDefault(opts ...base.HeaderOption) (response Response, err error) {
+	header := settings.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(IPSScreenSettingData, ResetToDefault), IPSScreenSettingData, nil, "", "", opts...)
 	body := settings.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(ResetToDefault), IPSScreenSettingData, nil)
 
 	response = Response{
@@ -49,3 +58,72 @@ func (settings Data) ResetToDefault() (response Response, err error) {
 
 	return response, nil
 }
+
+// SelectScreenRequest builds the Put request that makes screen, a one-based display index, the primary screen streamed
+// by KVM. The screen that was primary takes the slot previously held by screen, so the ordering of the others is kept.
+func SelectScreenRequest(settings ScreenSettingDataResponse, screen uint8) (ScreenSettingDataRequest, error) {
+	if screen < 1 || int(screen) > MaxScreens {
+		return ScreenSettingDataRequest{}, fmt.Errorf("%w: %d", ErrInvalidScreen, screen)
+	}
+
+	if int(screen) > len(settings.IsActive) || !settings.IsActive[screen-1] {
+		return ScreenSettingDataRequest{}, fmt.Errorf("%w: %d", ErrScreenNotActive, screen)
+	}
+
+	request := settings.ToRequest()
+
+	for _, index := range []*uint8{&request.SecondaryIndex, &request.TertiaryIndex, &request.QuadraryIndex} {
+		if *index == screen {
+			*index = request.PrimaryIndex
+
+			break
+		}
+	}
+
+	request.PrimaryIndex = screen
+
+	return request, nil
+}
+
+// ToRequest copies the writable properties of the settings into a Put request.
+func (settings ScreenSettingDataResponse) ToRequest() ScreenSettingDataRequest {
+	request := ScreenSettingDataRequest{
+		ElementName:    settings.ElementName,
+		InstanceID:     settings.InstanceID,
+		PrimaryIndex:   uint8(settings.PrimaryIndex),
+		SecondaryIndex: uint8(settings.SecondaryIndex),
+		TertiaryIndex:  uint8(settings.TertiaryIndex),
+		QuadraryIndex:  uint8(settings.QuadraryIndex),
+		IsActive:       settings.IsActive,
+	}
+
+	for i := range settings.UpperLeftX {
+		request.UpperLeftX = append(request.UpperLeftX, int32(settings.UpperLeftX[i]))
+	}
+
+	for i := range settings.UpperLeftY {
+		request.UpperLeftY = append(request.UpperLeftY, int32(settings.UpperLeftY[i]))
+	}
+
+	for i := range settings.ResolutionX {
+		request.ResolutionX = append(request.ResolutionX, uint32(settings.ResolutionX[i]))
+	}
+
+	for i := range settings.ResolutionY {
+		request.ResolutionY = append(request.ResolutionY, uint32(settings.ResolutionY[i]))
+	}
+
+	return request
+}
+
+// dataConversion declares how IPS_ScreenSettingData is read back for Update.
+var dataConversion = base.Conversion[Response, ScreenSettingDataRequest]{
+	ToRequest: func(response Response) ScreenSettingDataRequest {
+		return response.Body.ScreenSettingDataResponse.ToRequest()
+	},
+}
+
+// Update reads the screen settings, applies mutate and writes the whole instance back. See base.Update.
+func (settings Data) Update(mutate func(*ScreenSettingDataRequest), opts ...base.UpdateOption) (Response, error) {
+	return base.Update(settings.WSManService, dataConversion, mutate, opts...)
+}
@@ -135,3 +135,24 @@ func TestPositiveIPS_ScreenSettingData(t *testing.T) {
 		}
 	})
 }
+
+func TestIPS_ScreenSettingData_Update(t *testing.T) {
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "ips/screensetting",
+		CurrentMessage:   wsmantesting.CurrentMessageGet,
+	}
+	elementUnderTest := NewScreenSettingDataWithClient(message.NewWSManMessageCreator(wsmantesting.IPSResourceURIBase), &client)
+
+	_, err := elementUnderTest.Update(func(request *ScreenSettingDataRequest) {
+		assert.Equal(t, []int32{0, 1920, -1, -1}, request.UpperLeftX)
+		assert.Equal(t, []uint32{1920, 1920, 0, 0}, request.ResolutionX)
+	})
+	assert.NoError(t, err)
+
+	// the instance does not change between the two reads, so the change is written
+	response, err := elementUnderTest.Update(func(request *ScreenSettingDataRequest) {
+		request.PrimaryIndex = 2
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, response.XMLInput, "<h:PrimaryIndex>2</h:PrimaryIndex>")
+}
@@ -6,7 +6,84 @@
 package screensetting
 
 const (
-	IPSScreenSettingData string = "IPS_ScreenSettingData"
-	ResetToDefault       string = "ResetToDefault"
-	ValueNotFound        string = "Value not found in map"
+	IPSScreenSettingData          string = "IPS_ScreenSettingData"
+	IPSScreenConfigurationService string = "IPS_ScreenConfigurationService"
+	ResetToDefault                string = "ResetToDefault"
+	SetSessionState               string = "SetSessionState"
+	RequestStateChange            string = "RequestStateChange"
+	ValueNotFound                 string = "Value not found in map"
+	MaxScreens                    int    = 4
 )
+
+const (
+	SessionStateNone           SessionState = 0 // No redirection session, nothing is drawn on screen
+	SessionStateConsentPending SessionState = 1 // The user consent screen is displayed while waiting for the code
+	SessionStateActive         SessionState = 2 // A session is in progress and the session-state overlay is drawn
+)
+
+// sessionStateToString is a map of SessionState values to their string representation.
+var sessionStateToString = map[SessionState]string{
+	SessionStateNone:           "None",
+	SessionStateConsentPending: "ConsentPending",
+	SessionStateActive:         "Active",
+}
+
+// String returns the string representation of the SessionState value.
+func (s SessionState) String() string {
+	if value, exists := sessionStateToString[s]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+const (
+	EnabledStateEnabled  EnabledState = 2 // The firmware draws the consent screen and session overlay
+	EnabledStateDisabled EnabledState = 3 // Screen configuration is turned off
+)
+
+// enabledStateToString is a map of EnabledState values to their string representation.
+var enabledStateToString = map[EnabledState]string{
+	EnabledStateEnabled:  "Enabled",
+	EnabledStateDisabled: "Disabled",
+}
+
+// String returns the string representation of the EnabledState value.
+func (e EnabledState) String() string {
+	if value, exists := enabledStateToString[e]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+const (
+	RequestedStateEnabled  RequestedState = 2
+	RequestedStateDisabled RequestedState = 3
+)
+
+const (
+	ReturnValueSuccess          ReturnValue = 0
+	ReturnValueInternalError    ReturnValue = 1
+	ReturnValueNotSupported     ReturnValue = 2
+	ReturnValueInvalidParameter ReturnValue = 36
+	ReturnValueInvalidState     ReturnValue = 2082
+)
+
+// returnValueToString is a map of ReturnValue values to their string representation.
+var returnValueToString = map[ReturnValue]string{
+	ReturnValueSuccess:          "Success",
+	ReturnValueInternalError:    "InternalError",
+	ReturnValueNotSupported:     "NotSupported",
+	ReturnValueInvalidParameter: "InvalidParameter",
+	ReturnValueInvalidState:     "InvalidState",
+}
+
+// String returns the string representation of the ReturnValue value.
+func (r ReturnValue) String() string {
+	if value, exists := returnValueToString[r]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
@@ -23,10 +23,13 @@ type (
 	}
 
 	Body struct {
-		XMLName                   xml.Name `xml:"Body"`
-		PullResponse              PullResponse
-		EnumerateResponse         common.EnumerateResponse
-		ScreenSettingDataResponse ScreenSettingDataResponse
+		XMLName                      xml.Name `xml:"Body"`
+		PullResponse                 PullResponse
+		EnumerateResponse            common.EnumerateResponse
+		ScreenSettingDataResponse    ScreenSettingDataResponse
+		ConfigurationServiceResponse ConfigurationServiceResponse
+		SetSessionState_OUTPUT       SetSessionState_OUTPUT
+		RequestStateChange_OUTPUT    RequestStateChange_OUTPUT
 	}
 
 	ScreenSettingDataResponse struct {
@@ -44,10 +47,44 @@ type (
 		ResolutionY    []int    `xml:"ResolutionY"`
 	}
 
+	ConfigurationServiceResponse struct {
+		XMLName                 xml.Name     `xml:"IPS_ScreenConfigurationService"`
+		CreationClassName       string       `xml:"CreationClassName,omitempty"`
+		ElementName             string       `xml:"ElementName,omitempty"`
+		Name                    string       `xml:"Name,omitempty"`
+		SystemCreationClassName string       `xml:"SystemCreationClassName,omitempty"`
+		SystemName              string       `xml:"SystemName,omitempty"`
+		EnabledState            EnabledState `xml:"EnabledState,omitempty"`
+		SessionState            SessionState `xml:"SessionState"`
+	}
+
 	PullResponse struct {
-		XMLName                xml.Name                    `xml:"PullResponse"`
-		ScreenSettingDataItems []ScreenSettingDataResponse `xml:"Items>IPS_ScreenSettingData"`
+		XMLName                   xml.Name                       `xml:"PullResponse"`
+		ScreenSettingDataItems    []ScreenSettingDataResponse    `xml:"Items>IPS_ScreenSettingData"`
+		ConfigurationServiceItems []ConfigurationServiceResponse `xml:"Items>IPS_ScreenConfigurationService"`
+	}
+
+	SetSessionState_OUTPUT struct {
+		XMLName     xml.Name    `xml:"SetSessionState_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+
+	RequestStateChange_OUTPUT struct {
+		XMLName     xml.Name    `xml:"RequestStateChange_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
 	}
+
+	// SessionState is the state of the consent screen and session overlay drawn by the firmware.
+	SessionState int
+
+	// EnabledState is the current enabled state of the screen configuration service.
+	EnabledState int
+
+	// RequestedState is the state requested through RequestStateChange.
+	RequestedState int
+
+	// ReturnValue is the status code returned by the screen configuration service methods.
+	ReturnValue int
 )
 
 // INPUT.
@@ -67,4 +104,10 @@ type (
 		ResolutionX    []uint32 `xml:"h:ResolutionX,omitempty"`
 		ResolutionY    []uint32 `xml:"h:ResolutionY,omitempty"`
 	}
+
+	SetSessionState_INPUT struct {
+		XMLName      xml.Name     `xml:"h:SetSessionState_INPUT"`
+		H            string       `xml:"xmlns:h,attr"`
+		SessionState SessionState `xml:"h:SessionState"`
+	}
 )
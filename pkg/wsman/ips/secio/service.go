@@ -27,8 +27,8 @@ func NewSecIOServiceWithClient(creator *message.WSManMessageCreator, wsclient cl
 }
 
 // RequestStateChange changes the operational state of SecIO.
-func (settings Service) RequestStateChange(requestedState uint16) (response Response, err error) {
-	header := settings.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(IPSSecIOService, RequestStateChange), IPSSecIOService, nil, "", "")
+func (settings Service) RequestStateChange(requestedState uint16, opts ...base.HeaderOption) (response Response, err error) {
+	header := settings.Base.WSManMessageCreator.CreateHeader(methods.GenerateAction(IPSSecIOService, RequestStateChange), IPSSecIOService, nil, "", "", opts...)
 	body := settings.Base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(RequestStateChange), IPSSecIOService,
 		struct {
 			RequestedState uint16 `xml:"h:RequestedState"`
@@ -0,0 +1,34 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package wsman
+
+import (
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/config"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/base"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/ipv6portsettings"
+)
+
+// ConfigureWiredIPv6 writes the IPv6 section of a wired network configuration to the IPS_IPv6PortSettings instance of
+// the wired port. The configuration is validated before anything is written; see
+// ipv6portsettings.IPv6PortSettingsRequest.Validate.
+func (m Messages) ConfigureWiredIPv6(cfg config.IPv6, opts ...base.HeaderOption) (ipv6portsettings.Response, error) {
+	return m.IPS.IPv6PortSettings.Put(ipv6portsettings.WiredInstanceID, NewWiredIPv6Request(cfg), opts...)
+}
+
+// NewWiredIPv6Request maps the IPv6 section of a network configuration to a request for the wired port. An omitted
+// interfaceIDType is 0, ipv6portsettings.InterfaceIDTypeRandomized.
+func NewWiredIPv6Request(cfg config.IPv6) ipv6portsettings.IPv6PortSettingsRequest {
+	return ipv6portsettings.IPv6PortSettingsRequest{
+		ElementName:       ipv6portsettings.WiredInstanceID,
+		InstanceID:        ipv6portsettings.WiredInstanceID,
+		InterfaceIDType:   ipv6portsettings.InterfaceIDType(cfg.InterfaceIDType),
+		ManualInterfaceID: cfg.ManualInterfaceID,
+		IPv6Address:       cfg.IPAddress,
+		DefaultRouter:     cfg.DefaultRouter,
+		PrimaryDNS:        cfg.PrimaryDNS,
+		SecondaryDNS:      cfg.SecondaryDNS,
+	}
+}
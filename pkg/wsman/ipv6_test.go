@@ -0,0 +1,77 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2026
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package wsman
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/config"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/ips/ipv6portsettings"
+)
+
+// ipv6Client records the requests it is sent and answers with an empty envelope.
+type ipv6Client struct {
+	client.WSMan
+
+	requests []string
+}
+
+func (c *ipv6Client) Post(msg string) ([]byte, error) {
+	c.requests = append(c.requests, msg)
+
+	return []byte(`<Envelope><Header></Header><Body></Body></Envelope>`), nil
+}
+
+func TestMessages_ConfigureWiredIPv6(t *testing.T) {
+	cfg := config.IPv6{
+		InterfaceIDType:   int(ipv6portsettings.InterfaceIDTypeManual),
+		ManualInterfaceID: "0000000000000abc",
+		IPAddress:         "2001:db8::10",
+		DefaultRouter:     "fe80::1",
+		PrimaryDNS:        "2001:db8::53",
+	}
+
+	t.Run("writes the wired port", func(t *testing.T) {
+		wsmanClient := &ipv6Client{}
+
+		_, err := Messages{IPS: ips.NewMessages(wsmanClient)}.ConfigureWiredIPv6(cfg)
+		require.NoError(t, err)
+		require.Len(t, wsmanClient.requests, 1)
+		assert.Contains(t, wsmanClient.requests[0], `<w:Selector Name="InstanceID">Intel(r) IPS IPv6 Settings 0</w:Selector>`)
+		assert.Contains(t, wsmanClient.requests[0], `<h:IPv6Address>2001:db8::10</h:IPv6Address>`)
+		assert.Contains(t, wsmanClient.requests[0], `<h:InterfaceIDType>2</h:InterfaceIDType><h:ManualInterfaceID>0000000000000abc</h:ManualInterfaceID>`)
+		assert.NotContains(t, wsmanClient.requests[0], "SecondaryDNS")
+	})
+
+	t.Run("validates before writing", func(t *testing.T) {
+		invalid := cfg
+		invalid.IPAddress = "fe80::10"
+		wsmanClient := &ipv6Client{}
+
+		_, err := Messages{IPS: ips.NewMessages(wsmanClient)}.ConfigureWiredIPv6(invalid)
+		assert.ErrorIs(t, err, ipv6portsettings.ErrInvalidAddress)
+		assert.Empty(t, wsmanClient.requests)
+
+		invalid = cfg
+		invalid.ManualInterfaceID = ""
+
+		_, err = Messages{IPS: ips.NewMessages(wsmanClient)}.ConfigureWiredIPv6(invalid)
+		assert.ErrorIs(t, err, ipv6portsettings.ErrManualInterfaceIDMissing)
+		assert.Empty(t, wsmanClient.requests)
+	})
+}
+
+func TestNewWiredIPv6Request(t *testing.T) {
+	// an omitted interface ID type is Randomized
+	request := NewWiredIPv6Request(config.IPv6{IPAddress: "2001:db8::10"})
+	assert.Equal(t, ipv6portsettings.InterfaceIDTypeRandomized, request.InterfaceIDType)
+	assert.Equal(t, ipv6portsettings.WiredInstanceID, request.InstanceID)
+}
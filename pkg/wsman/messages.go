@@ -30,13 +30,24 @@ func NewMessages(cp client.Parameters) Messages {
 		client1 = client.NewWsman(cp)
 	}
 
+	var wsmanClient client.WSMan = client1
+
+	if cp.Cache != nil && !cp.IsRedirection {
+		options := *cp.Cache
+		if options.Scope == "" {
+			options.Scope = cp.Target
+		}
+
+		wsmanClient = client.NewCachingClient(client1, options)
+	}
+
 	m := Messages{
-		Client: client1,
+		Client: wsmanClient,
 	}
 
-	m.AMT = amt.NewMessages(client1)
-	m.CIM = cim.NewMessages(client1)
-	m.IPS = ips.NewMessages(client1)
+	m.AMT = amt.NewMessages(wsmanClient)
+	m.CIM = cim.NewMessages(wsmanClient)
+	m.IPS = ips.NewMessages(wsmanClient)
 
 	return m
 }
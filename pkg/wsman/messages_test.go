@@ -8,6 +8,7 @@ package wsman
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim"
@@ -45,3 +46,26 @@ func TestNewMessages(t *testing.T) {
 		t.Error("IPS is not initialized")
 	}
 }
+
+func TestNewMessagesWithCache(t *testing.T) {
+	t.Parallel()
+
+	m := NewMessages(client.Parameters{
+		Target: "test",
+		Cache:  &client.CacheOptions{DefaultTTL: time.Minute},
+	})
+
+	if _, ok := m.Client.(*client.CachingClient); !ok {
+		t.Errorf("client is %T, want *client.CachingClient", m.Client)
+	}
+
+	m = NewMessages(client.Parameters{
+		Target:        "test",
+		IsRedirection: true,
+		Cache:         &client.CacheOptions{DefaultTTL: time.Minute},
+	})
+
+	if _, ok := m.Client.(*client.Target); !ok {
+		t.Errorf("redirection client is %T, want *client.Target", m.Client)
+	}
+}